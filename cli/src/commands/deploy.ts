@@ -11,7 +11,7 @@ export function createDeployCommand(): Command {
     .argument('<chaincode>', 'Chaincode name')
     .argument('[path]', 'Path to chaincode directory')
     .option('-v, --version <version>', 'Chaincode version', '1.0')
-    .option('-l, --language <lang>', 'Chaincode language (golang, node, java)', 'golang')
+    .option('-l, --language <lang>', 'Chaincode language (golang, node, typescript, java; detected when omitted)')
     .option('-n, --network <id>', 'Network ID (uses last network if not provided)')
     .option('-e, --endorsement <orgs>', 'Endorsement policy organizations (comma-separated)')
     .action(async (chaincode, pathArg, options) => {
@@ -52,7 +52,9 @@ export function createDeployCommand(): Command {
         console.log(chalk.gray('  Chaincode ID:'), chalk.white(result.chaincodeId));
         console.log(chalk.gray('  Name:'), chalk.white(chaincode));
         console.log(chalk.gray('  Version:'), chalk.white(options.version));
-        console.log(chalk.gray('  Language:'), chalk.white(options.language));
+        if (options.language) {
+          console.log(chalk.gray('  Language:'), chalk.white(options.language));
+        }
         console.log(chalk.gray('  Path:'), chalk.white(pathArg || `./${chaincode}`));
 
         console.log(chalk.green('\n✓ Chaincode ready for transactions\n'));
@@ -2,154 +2,120 @@
 import { Command } from 'commander';
 import chalk from 'chalk';
 import ora from 'ora';
-import { existsSync, mkdirSync, copyFileSync, readdirSync, statSync } from 'fs';
-import { join } from 'path';
-
-interface TemplateInfo {
-  name: string;
-  description: string;
-  languages: string[];
-  path: string;
-}
-
-const templates: Record<string, TemplateInfo> = {
-  'asset-transfer': {
-    name: 'Asset Transfer',
-    description: 'Basic CRUD operations for assets with ownership tracking',
-    languages: ['go', 'typescript'],
-    path: 'asset-transfer',
-  },
-  erc20: {
-    name: 'ERC-20 Token',
-    description: 'Fungible token standard with mint, transfer, and allowance',
-    languages: ['go', 'typescript'],
-    path: 'erc20',
-  },
-  escrow: {
-    name: 'Escrow',
-    description: 'Multi-party escrow with time locks and arbitration',
-    languages: ['go', 'typescript'],
-    path: 'escrow',
-  },
-  'supply-chain': {
-    name: 'Supply Chain',
-    description: 'Track products through supply chain with provenance',
-    languages: ['go', 'typescript'],
-    path: 'supply-chain',
-  },
-};
+import { existsSync, readdirSync } from 'fs';
+import { loadConfig, createFabricX, handleError } from '../helpers';
 
 export function createScaffoldCommand(): Command {
   const scaffold = new Command('scaffold');
 
   scaffold
-    .description('Scaffold a new chaincode from a template')
+    .description('Scaffold a new chaincode from a template embedded in the runtime')
     .argument('[template]', 'Template name (asset-transfer, erc20, escrow, supply-chain)')
     .argument('[path]', 'Target directory for the new chaincode')
     .option('-l, --lang <language>', 'Language (go, typescript)', 'go')
+    .option('-m, --module <path>', 'Go module path')
+    .option('-c, --contract <name>', 'Contract type or class name')
+    .option('-p, --package <name>', 'Go package of the contract, or npm package name')
     .option('--list', 'List all available templates')
     .action(async (templateName, targetPath, options) => {
-      // List templates
-      if (options.list || !templateName) {
-        console.log(chalk.cyan('\n📋 Available Templates:\n'));
+      const fabricx = createFabricX(loadConfig());
 
-        Object.entries(templates).forEach(([key, template]) => {
-          console.log(chalk.white(`  ${key}`));
-          console.log(chalk.gray(`    ${template.description}`));
-          console.log(chalk.gray(`    Languages: ${template.languages.join(', ')}\n`));
-        });
+      try {
+        // List templates
+        if (options.list || !templateName) {
+          const templates = await fabricx.listTemplates();
+          console.log(chalk.cyan('\n📋 Available Templates:\n'));
+
+          templates.forEach((template) => {
+            console.log(chalk.white(`  ${template.name}`));
+            console.log(chalk.gray(`    ${template.description}`));
+            console.log(chalk.gray(`    Languages: ${template.languages.join(', ')}`));
+            console.log(
+              chalk.gray(`    Functions: ${template.functions.map((fn) => fn.name).join(', ')}`)
+            );
+            console.log(
+              chalk.gray(`    Events: ${template.events.map((event) => event.name).join(', ')}\n`)
+            );
+          });
+
+          if (!templateName) {
+            console.log(chalk.yellow('💡 Usage:'));
+            console.log(chalk.white('  npx fabricx scaffold <template> <path> --lang <language>\n'));
+            console.log(chalk.yellow('💡 Example:'));
+            console.log(
+              chalk.white(
+                '  npx fabricx scaffold erc20 ./my-token --lang go --module github.com/acme/my-token\n'
+              )
+            );
+          }
+
+          await fabricx.close();
+          return;
+        }
 
-        if (!templateName) {
+        // Validate target path
+        if (!targetPath) {
+          console.error(chalk.red('\n✗ Target path is required\n'));
           console.log(chalk.yellow('💡 Usage:'));
-          console.log(chalk.white('  npx fabricx scaffold <template> <path> --lang <language>\n'));
-          console.log(chalk.yellow('💡 Example:'));
-          console.log(chalk.white('  npx fabricx scaffold erc20 ./my-token --lang go\n'));
+          console.log(
+            chalk.white(`  npx fabricx scaffold ${templateName} <path> --lang ${options.lang}\n`)
+          );
+          process.exit(1);
         }
 
-        return;
-      }
-
-      // Validate template
-      const template = templates[templateName];
-      if (!template) {
-        console.error(chalk.red(`\n✗ Template "${templateName}" not found`));
-        console.log(
-          chalk.yellow('\n💡 Run'),
-          chalk.white('npx fabricx scaffold --list'),
-          chalk.yellow('to see available templates\n')
-        );
-        process.exit(1);
-      }
-
-      // Validate language
-      if (!template.languages.includes(options.lang)) {
-        console.error(
-          chalk.red(`\n✗ Language "${options.lang}" not available for ${templateName}`)
-        );
-        console.log(chalk.yellow(`   Available languages: ${template.languages.join(', ')}\n`));
-        process.exit(1);
-      }
-
-      // Validate target path
-      if (!targetPath) {
-        console.error(chalk.red('\n✗ Target path is required\n'));
-        console.log(chalk.yellow('💡 Usage:'));
-        console.log(
-          chalk.white(`  npx fabricx scaffold ${templateName} <path> --lang ${options.lang}\n`)
-        );
-        process.exit(1);
-      }
-
-      // Check if target exists
-      if (existsSync(targetPath)) {
-        console.error(chalk.red(`\n✗ Directory already exists: ${targetPath}\n`));
-        process.exit(1);
-      }
-
-      const spinner = ora(`Scaffolding ${template.name} (${options.lang})...`).start();
-
-      try {
-        // Determine template source path
-        // In production, this would be in node_modules/@fabricx/cli/templates
-        // For development, it's relative to the CLI package
-        const templateBasePath = join(__dirname, '../../../templates');
-        const templateSourcePath = join(
-          templateBasePath,
-          template.path,
-          options.lang === 'typescript' ? 'typescript' : 'go'
-        );
-
-        if (!existsSync(templateSourcePath)) {
-          spinner.fail(chalk.red('Template source not found'));
-          console.error(chalk.gray(`  Expected at: ${templateSourcePath}\n`));
+        // Check if target exists
+        if (existsSync(targetPath) && readdirSync(targetPath).length > 0) {
+          console.error(chalk.red(`\n✗ Directory is not empty: ${targetPath}\n`));
           process.exit(1);
         }
 
-        // Create target directory
-        mkdirSync(targetPath, { recursive: true });
-
-        // Copy template files
-        copyDirectory(templateSourcePath, targetPath);
+        const spinner = ora(`Scaffolding ${templateName} (${options.lang})...`).start();
+
+        let result;
+        try {
+          result = await fabricx.scaffoldChaincode(templateName, {
+            language: options.lang,
+            modulePath: options.module,
+            contractName: options.contract,
+            packageName: options.package,
+            targetDir: targetPath,
+          });
+        } catch (error) {
+          spinner.fail(chalk.red('Failed to scaffold chaincode'));
+          if ((error as Error).message.includes('not found')) {
+            console.log(
+              chalk.yellow('\n💡 Run'),
+              chalk.white('npx fabricx scaffold --list'),
+              chalk.yellow('to see available templates\n')
+            );
+          }
+          throw error;
+        }
 
         spinner.succeed(chalk.green('Chaincode scaffolded successfully!'));
 
         console.log(chalk.cyan('\n📦 Chaincode Details:'));
-        console.log(chalk.gray('  Template:'), chalk.white(template.name));
-        console.log(chalk.gray('  Language:'), chalk.white(options.lang));
+        console.log(chalk.gray('  Template:'), chalk.white(templateName));
+        console.log(chalk.gray('  Language:'), chalk.white(result.language));
+        console.log(chalk.gray('  Contract:'), chalk.white(result.contractName));
+        console.log(chalk.gray('  Package:'), chalk.white(result.packageName));
+        if (result.language === 'go') {
+          console.log(chalk.gray('  Module:'), chalk.white(result.modulePath));
+        }
         console.log(chalk.gray('  Location:'), chalk.white(targetPath));
 
         console.log(chalk.cyan('\n🚀 Next Steps:\n'));
 
-        if (options.lang === 'go') {
+        if (result.language === 'go') {
           console.log(chalk.white('  1. Review the chaincode:'));
           console.log(chalk.gray(`     cd ${targetPath}`));
-          console.log(chalk.gray('     cat main.go\n'));
+          console.log(chalk.gray(`     cat ${result.packageName}/contract.go\n`));
 
           console.log(chalk.white('  2. Install dependencies:'));
           console.log(chalk.gray('     go mod tidy\n'));
 
           console.log(chalk.white('  3. Run tests:'));
-          console.log(chalk.gray('     go test -v\n'));
+          console.log(chalk.gray('     go test ./...\n'));
 
           console.log(chalk.white('  4. Deploy to network:'));
           console.log(chalk.gray(`     npx fabricx deploy my-chaincode ${targetPath}\n`));
@@ -172,28 +138,13 @@ export function createScaffoldCommand(): Command {
           chalk.yellow('💡 Tip:'),
           chalk.white('Check the README.md for template-specific instructions\n')
         );
+
+        await fabricx.close();
       } catch (error) {
-        spinner.fail(chalk.red('Failed to scaffold chaincode'));
-        console.error(chalk.gray(`  ${(error as Error).message}\n`));
-        process.exit(1);
+        await fabricx.close();
+        handleError(error);
       }
     });
 
   return scaffold;
 }
-
-function copyDirectory(src: string, dest: string): void {
-  const entries = readdirSync(src);
-
-  for (const entry of entries) {
-    const srcPath = join(src, entry);
-    const destPath = join(dest, entry);
-
-    if (statSync(srcPath).isDirectory()) {
-      mkdirSync(destPath, { recursive: true });
-      copyDirectory(srcPath, destPath);
-    } else {
-      copyFileSync(srcPath, destPath);
-    }
-  }
-}
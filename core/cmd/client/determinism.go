@@ -0,0 +1,150 @@
+// cmd/client/determinism.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	pb "github.com/temmyjay001/core/pkg/grpcserver"
+)
+
+func vetChaincode(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 2 {
+		log.Fatal("Usage: fabricx-client vet <network-id> <chaincode-path> [--upload | --git [--ref r] [--name chaincode]] [--lang go]")
+	}
+
+	networkID := args[0]
+	chaincodePath := args[1]
+	language := ""
+	name := ""
+	git := false
+	gitRef := ""
+	upload := false
+
+	// Parse optional flags
+	for i := 2; i < len(args); i++ {
+		if args[i] == "--lang" && i+1 < len(args) {
+			language = args[i+1]
+			i++
+		} else if args[i] == "--name" && i+1 < len(args) {
+			name = args[i+1]
+			i++
+		} else if args[i] == "--git" {
+			git = true
+		} else if args[i] == "--ref" && i+1 < len(args) {
+			gitRef = args[i+1]
+			i++
+		} else if args[i] == "--upload" {
+			upload = true
+		}
+	}
+	if git && name == "" {
+		name = "vet"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	source := resolveSource(ctx, client, networkID, chaincodePath, git, upload, gitRef)
+
+	fmt.Printf("🔍 Checking %s for non-determinism...\n", chaincodePath)
+
+	resp, err := client.CheckDeterminism(ctx, &pb.CheckDeterminismRequest{
+		NetworkId:     networkID,
+		ChaincodeName: name,
+		ChaincodePath: source.path,
+		UploadId:      source.uploadID,
+		GitUrl:        source.gitURL,
+		GitRef:        source.gitRef,
+		Language:      language,
+	})
+	if err != nil {
+		log.Fatalf("❌ Failed to check chaincode: %v", err)
+	}
+	if !resp.Success {
+		log.Fatalf("❌ Check failed: %s", resp.Message)
+	}
+
+	if len(resp.Diagnostics) == 0 {
+		fmt.Printf("\n✅ No non-deterministic code found\n")
+		return
+	}
+	printDiagnostics(resp.Diagnostics)
+	fmt.Printf("\n%d errors, %d warnings\n", resp.Errors, resp.Warnings)
+
+	// Fail scripts and CI on errors, like go vet
+	if resp.Errors > 0 {
+		os.Exit(1)
+	}
+}
+
+// printDiagnostics lists the findings of a determinism check
+func printDiagnostics(diagnostics []*pb.DeterminismDiagnostic) {
+	if len(diagnostics) == 0 {
+		return
+	}
+
+	fmt.Printf("\n🎲 Determinism check:\n")
+	for _, d := range diagnostics {
+		icon := "❌"
+		if d.Severity == "warning" {
+			icon = "⚠️ "
+		}
+		fmt.Printf("  %s %s:%d:%d %s\n", icon, d.File, d.Line, d.Column, d.Function)
+		fmt.Printf("     %s (%s)\n", d.Message, d.Check)
+	}
+}
+
+// printDeterminismReport lists what each endorser answered and where they
+// disagree, exiting with status 1 on disagreement
+func printDeterminismReport(message string, report *pb.DeterminismReport) {
+	fmt.Printf("\n🎲 %s\n", message)
+
+	fmt.Printf("\n   Endorsers:\n")
+	for _, e := range report.Endorsers {
+		if e.Error != "" {
+			fmt.Printf("   ⚠️  %s (%s): unreachable: %s\n", e.Peer, e.Org, e.Error)
+			continue
+		}
+		fmt.Printf("   • %s (%s): status %d", e.Peer, e.Org, e.Status)
+		if e.Message != "" {
+			fmt.Printf(" %s", e.Message)
+		}
+		fmt.Println()
+	}
+
+	if report.Consistent {
+		fmt.Printf("\n✅ Every endorser produced the same response\n")
+		return
+	}
+
+	fmt.Printf("\n❌ Divergences:\n")
+	for _, d := range report.Divergences {
+		where := d.Kind
+		if d.Namespace != "" {
+			where += " " + d.Namespace
+		}
+		if d.Collection != "" {
+			where += "/" + d.Collection
+		}
+		if d.Key != "" {
+			where += " " + d.Key
+		}
+		fmt.Printf("   %s\n", where)
+
+		peers := make([]string, 0, len(d.Values))
+		for peer := range d.Values {
+			peers = append(peers, peer)
+		}
+		sort.Strings(peers)
+		for _, peer := range peers {
+			fmt.Printf("      %s: %s\n", peer, d.Values[peer])
+		}
+	}
+	os.Exit(1)
+}
@@ -0,0 +1,146 @@
+// cmd/client/endorse.go
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"log"
+	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/temmyjay001/core/pkg/grpcserver"
+)
+
+func endorseTransaction(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 3 {
+		log.Fatal("Usage: fabricx-client endorse <network-id> <chaincode> <function> [args...] [--org org] [--as identity] [--transient key=value]... [--collection name] [--endorse-org org]... [--endorse-peer peer]... [--auto-endorse]")
+	}
+
+	networkID := args[0]
+	chaincodeName := args[1]
+	functionName := args[2]
+	txArgs, opts := parseInvokeFlags(args[3:])
+
+	fmt.Printf("🧪 Endorsing transaction without submitting...\n")
+	fmt.Printf("   Network: %s\n", networkID)
+	fmt.Printf("   Chaincode: %s\n", chaincodeName)
+	fmt.Printf("   Function: %s\n", functionName)
+	fmt.Printf("   Args: %v\n", txArgs)
+	printSigner(opts.org, opts.identity)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := client.EndorseTransaction(ctx, &pb.EndorseTransactionRequest{
+		NetworkId:      networkID,
+		ChaincodeName:  chaincodeName,
+		FunctionName:   functionName,
+		Args:           txArgs,
+		Org:            opts.org,
+		Identity:       opts.identity,
+		Transient:      opts.transient,
+		Collection:     opts.collection,
+		EndorsingOrgs:  opts.endorsingOrgs,
+		EndorsingPeers: opts.endorsingPeers,
+		AutoEndorse:    opts.autoEndorse,
+	})
+	if err != nil {
+		log.Fatalf("❌ Failed to endorse transaction: %v", err)
+	}
+	if !resp.Success {
+		log.Fatalf("❌ Endorsement failed: %s", resp.Message)
+	}
+
+	fmt.Printf("\n✅ %s\n", resp.Message)
+	fmt.Printf("   Transaction ID: %s\n", resp.TransactionId)
+	for _, e := range resp.Endorsers {
+		printEndorserResponse(e)
+	}
+}
+
+// printEndorserResponse shows what one endorser answered and the read-write
+// set it would commit
+func printEndorserResponse(e *pb.EndorserResponse) {
+	fmt.Printf("\n📨 %s (%s)\n", e.Peer, e.Org)
+	if e.Error != "" {
+		fmt.Printf("   ⚠️  Unreachable: %s\n", e.Error)
+		return
+	}
+	fmt.Printf("   Status: %d", e.Status)
+	if e.Message != "" {
+		fmt.Printf(" %s", e.Message)
+	}
+	fmt.Println()
+	if len(e.Payload) > 0 {
+		fmt.Printf("   Payload: %s\n", formatBytes(e.Payload))
+	}
+	if e.Event != nil {
+		fmt.Printf("   Event: %s %s\n", e.Event.Name, formatBytes(e.Event.Payload))
+	}
+
+	for _, ns := range e.Rwsets {
+		fmt.Printf("   Namespace %s:\n", ns.Namespace)
+		for _, r := range ns.Reads {
+			fmt.Printf("      read  %s @ %s\n", r.Key, formatVersion(r.Version))
+		}
+		for _, w := range ns.Writes {
+			if w.IsDelete {
+				fmt.Printf("      delete %s\n", w.Key)
+			} else {
+				fmt.Printf("      write %s = %s\n", w.Key, formatBytes(w.Value))
+			}
+		}
+		for _, q := range ns.RangeQueries {
+			fmt.Printf("      range [%s, %s)", q.StartKey, q.EndKey)
+			if !q.Exhausted {
+				fmt.Printf(" (not exhausted)")
+			}
+			fmt.Println()
+			for _, r := range q.Reads {
+				fmt.Printf("         %s @ %s\n", r.Key, formatVersion(r.Version))
+			}
+			for _, hash := range q.MerkleHashes {
+				fmt.Printf("         merkle %s\n", hex.EncodeToString(hash))
+			}
+		}
+		for _, c := range ns.Collections {
+			fmt.Printf("      collection %s:\n", c.Collection)
+			for _, r := range c.Reads {
+				fmt.Printf("         read  %s @ %s\n", hex.EncodeToString(r.KeyHash), formatVersion(r.Version))
+			}
+			for _, w := range c.Writes {
+				switch {
+				case w.IsPurge:
+					fmt.Printf("         purge %s\n", hex.EncodeToString(w.KeyHash))
+				case w.IsDelete:
+					fmt.Printf("         delete %s\n", hex.EncodeToString(w.KeyHash))
+				default:
+					fmt.Printf("         write %s = %s\n", hex.EncodeToString(w.KeyHash), hex.EncodeToString(w.ValueHash))
+				}
+			}
+		}
+	}
+}
+
+func formatVersion(v *pb.KeyVersion) string {
+	if v == nil {
+		return "not in state"
+	}
+	return fmt.Sprintf("%d:%d", v.BlockNum, v.TxNum)
+}
+
+// formatBytes shows printable values as text and anything else as hex
+func formatBytes(b []byte) string {
+	if !utf8.Valid(b) {
+		return "0x" + hex.EncodeToString(b)
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return "0x" + hex.EncodeToString(b)
+		}
+	}
+	return string(b)
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -48,14 +50,36 @@ func main() {
 		getStatus(client)
 	case "deploy":
 		deployChaincode(client)
+	case "upgrade":
+		upgradeChaincode(client)
 	case "invoke":
 		invokeTransaction(client)
+	case "endorse":
+		endorseTransaction(client)
 	case "query":
 		queryLedger(client)
 	case "logs":
 		streamLogs(client)
+	case "watch":
+		watchChaincode(client)
 	case "stop":
 		stopNetwork(client)
+	case "topology":
+		exportTopology(client)
+	case "identity":
+		manageIdentity(client)
+	case "collections":
+		getCollections(client)
+	case "chaincodes":
+		listChaincodes(client)
+	case "lifecycle":
+		manageLifecycle(client)
+	case "templates":
+		listTemplates(client)
+	case "scaffold":
+		scaffoldChaincode(client)
+	case "vet":
+		vetChaincode(client)
 	default:
 		fmt.Printf("Unknown command: %s\n", command)
 		printUsage()
@@ -73,27 +97,108 @@ func printUsage() {
 	fmt.Println("\nCommands:")
 	fmt.Println("  init              Initialize a new Fabric network")
 	fmt.Println("  status <net-id>   Get network status")
-	fmt.Println("  deploy <net-id> <chaincode-name> <path> Deploy chaincode")
+	fmt.Println("  deploy <net-id> <chaincode-name> <path> Deploy chaincode from a folder, package, upload or git repository")
+	fmt.Println("  upgrade <net-id> <chaincode-name> <path> Upgrade chaincode to the next sequence")
 	fmt.Println("  invoke <net-id> <chaincode> <function> <args...> Invoke transaction")
+	fmt.Println("  endorse <net-id> <chaincode> <function> <args...> Show what endorsers would commit, without submitting")
 	fmt.Println("  query <net-id> <chaincode> <function> <args...>  Query ledger")
+	fmt.Println("  watch <net-id> <chaincode-name> <path>  Redeploy chaincode whenever its source changes")
 	fmt.Println("  logs <net-id> [container]  Stream container logs")
 	fmt.Println("  stop <net-id>     Stop and cleanup network")
+	fmt.Println("  topology <net-id> [--format yaml|json]  Export network topology")
+	fmt.Println("  identity register|enroll|revoke|list <net-id> <org> ...  Manage CA identities")
+	fmt.Println("  collections <net-id> [chaincode]  Show which peers hold which private data collections")
+	fmt.Println("  chaincodes <net-id> [chaincode]  Show installed, approved and committed chaincodes")
+	fmt.Println("  lifecycle approve|reject|readiness|commit <net-id> <chaincode> [org]  Approve a staged definition org by org")
+	fmt.Println("  templates         List the chaincode templates with their functions and events")
+	fmt.Println("  scaffold <template> <target>  Start a new chaincode from a template")
+	fmt.Println("  vet <net-id> <path>  Check Go chaincode for code endorsers can execute differently")
 	fmt.Println("\nExamples:")
 	fmt.Println("  # Initialize network")
 	fmt.Println("  fabricx-client init")
 	fmt.Println("")
+	fmt.Println("  # Initialize network from a topology file")
+	fmt.Println("  fabricx-client init --topology ./network.yaml")
+	fmt.Println("")
+	fmt.Println("  # Initialize network with identities enrolled through Fabric CA")
+	fmt.Println("  fabricx-client init --crypto ca --tls-ca")
+	fmt.Println("")
 	fmt.Println("  # Get status")
 	fmt.Println("  fabricx-client status abc123")
 	fmt.Println("")
 	fmt.Println("  # Deploy chaincode")
 	fmt.Println("  fabricx-client deploy abc123 mycc ./chaincode")
 	fmt.Println("")
+	fmt.Println("  # Deploy from a machine other than the runtime host")
+	fmt.Println("  fabricx-client -server runtime:50051 deploy abc123 mycc ./chaincode --upload")
+	fmt.Println("")
+	fmt.Println("  # Deploy a lifecycle package built in CI, checking its package ID")
+	fmt.Println("  fabricx-client deploy abc123 mycc ./mycc.tar.gz --upload --package-id mycc_1.0:3f2a...")
+	fmt.Println("")
+	fmt.Println("  # Deploy a tag of a git repository")
+	fmt.Println("  fabricx-client deploy abc123 mycc https://github.com/org/mycc.git --git --ref v1.2.0")
+	fmt.Println("")
+	fmt.Println("  # Deploy chaincode that needs both orgs to endorse")
+	fmt.Println("  fabricx-client deploy abc123 mycc ./chaincode --policy \"AND('Org1MSP.peer','Org2MSP.peer')\"")
+	fmt.Println("")
+	fmt.Println("  # Upgrade chaincode to a new version")
+	fmt.Println("  fabricx-client upgrade abc123 mycc ./chaincode --version 2.0")
+	fmt.Println("")
+	fmt.Println("  # Run chaincode as a service; redeploys only restart it")
+	fmt.Println("  fabricx-client deploy abc123 mycc ./chaincode --ccaas")
+	fmt.Println("")
+	fmt.Println("  # Debug Go chaincode with Delve on port 2345")
+	fmt.Println("  fabricx-client deploy abc123 mycc ./chaincode --debug --debug-port 2345")
+	fmt.Println("")
+	fmt.Println("  # Redeploy on every save")
+	fmt.Println("  fabricx-client watch abc123 mycc ./chaincode --ccaas")
+	fmt.Println("")
+	fmt.Println("  # Refuse to deploy chaincode that reads the clock or iterates maps into the ledger")
+	fmt.Println("  fabricx-client vet abc123 ./chaincode --upload")
+	fmt.Println("  fabricx-client deploy abc123 mycc ./chaincode --determinism enforce")
+	fmt.Println("")
+	fmt.Println("  # Deploy chaincode with private data collections")
+	fmt.Println("  fabricx-client deploy abc123 private ./chaincode --collections collections_config.json")
+	fmt.Println("")
 	fmt.Println("  # Invoke transaction")
 	fmt.Println("  fabricx-client invoke abc123 mycc createAsset asset1 owner1 100")
 	fmt.Println("")
+	fmt.Println("  # Invoke as another identity")
+	fmt.Println("  fabricx-client invoke abc123 token Transfer bob 10 --as User1@org2.example.com")
+	fmt.Println("")
+	fmt.Println("  # Invoke with private data")
+	fmt.Println("  fabricx-client invoke abc123 private CreateSecret --transient secret=s3cr3t --collection Org1Private")
+	fmt.Println("")
+	fmt.Println("  # Invoke on the fewest peers the endorsement policy accepts")
+	fmt.Println("  fabricx-client invoke abc123 mycc createAsset asset2 owner1 100 --auto-endorse")
+	fmt.Println("")
+	fmt.Println("  # Compare what every peer would endorse, without submitting")
+	fmt.Println("  fabricx-client invoke abc123 mycc createAsset asset3 owner1 100 --check-determinism")
+	fmt.Println("")
+	fmt.Println("  # Show the read-write set each peer would commit, without submitting")
+	fmt.Println("  fabricx-client endorse abc123 mycc transferAsset asset1 owner2 --endorse-org Org1 --endorse-org Org2")
+	fmt.Println("")
 	fmt.Println("  # Query")
 	fmt.Println("  fabricx-client query abc123 mycc getAsset asset1")
 	fmt.Println("")
+	fmt.Println("  # See which orgs approved the next definition of a chaincode")
+	fmt.Println("  fabricx-client chaincodes abc123 mycc")
+	fmt.Println("")
+	fmt.Println("  # Let each org approve a definition before committing it")
+	fmt.Println("  fabricx-client deploy abc123 mycc ./chaincode --stage")
+	fmt.Println("  fabricx-client lifecycle approve abc123 mycc Org1")
+	fmt.Println("  fabricx-client lifecycle reject abc123 mycc Org2 --reason \"needs an audit\"")
+	fmt.Println("  fabricx-client lifecycle readiness abc123 mycc")
+	fmt.Println("  fabricx-client lifecycle commit abc123 mycc")
+	fmt.Println("")
+	fmt.Println("  # Register and enroll an identity with a custom attribute")
+	fmt.Println("  fabricx-client identity register abc123 Org1 arbiter1 --attr role=arbiter")
+	fmt.Println("  fabricx-client identity enroll abc123 Org1 arbiter1 <secret>")
+	fmt.Println("")
+	fmt.Println("  # Start a token contract from the ERC-20 template and deploy it")
+	fmt.Println("  fabricx-client scaffold erc20 ./points --module github.com/acme/points --contract LoyaltyPoints --package points")
+	fmt.Println("  fabricx-client deploy abc123 points ./points --upload")
+	fmt.Println("")
 	fmt.Println("  # Stop network")
 	fmt.Println("  fabricx-client stop abc123")
 }
@@ -104,6 +209,9 @@ func initNetwork(client pb.FabricXServiceClient) {
 	networkName := "fabricx-network"
 	numOrgs := int32(2)
 	channelName := "mychannel"
+	topologyPath := ""
+	cryptoProvider := ""
+	separateTLSCA := false
 
 	// Parse optional arguments
 	for i := 0; i < len(args); i++ {
@@ -118,21 +226,48 @@ func initNetwork(client pb.FabricXServiceClient) {
 		} else if args[i] == "--channel" && i+1 < len(args) {
 			channelName = args[i+1]
 			i++
+		} else if args[i] == "--topology" && i+1 < len(args) {
+			topologyPath = args[i+1]
+			i++
+		} else if args[i] == "--crypto" && i+1 < len(args) {
+			cryptoProvider = args[i+1]
+			i++
+		} else if args[i] == "--tls-ca" {
+			separateTLSCA = true
 		}
 	}
 
+	topology := ""
+	if topologyPath != "" {
+		data, err := os.ReadFile(topologyPath)
+		if err != nil {
+			log.Fatalf("❌ Failed to read topology file: %v", err)
+		}
+		topology = string(data)
+	}
+
 	fmt.Printf("🚀 Initializing Fabric network...\n")
 	fmt.Printf("   Name: %s\n", networkName)
-	fmt.Printf("   Organizations: %d\n", numOrgs)
-	fmt.Printf("   Channel: %s\n", channelName)
+	if topologyPath != "" {
+		fmt.Printf("   Topology: %s\n", topologyPath)
+	} else {
+		fmt.Printf("   Organizations: %d\n", numOrgs)
+		fmt.Printf("   Channel: %s\n", channelName)
+	}
+	if cryptoProvider != "" {
+		fmt.Printf("   Crypto: %s\n", cryptoProvider)
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
 	defer cancel()
 
 	resp, err := client.InitNetwork(ctx, &pb.InitNetworkRequest{
-		NetworkName: networkName,
-		NumOrgs:     numOrgs,
-		ChannelName: channelName,
+		NetworkName:    networkName,
+		NumOrgs:        numOrgs,
+		ChannelName:    channelName,
+		Topology:       topology,
+		CryptoProvider: cryptoProvider,
+		SeparateTlsCa:  separateTLSCA,
 	})
 
 	if err != nil {
@@ -193,7 +328,7 @@ func getStatus(client pb.FabricXServiceClient) {
 func deployChaincode(client pb.FabricXServiceClient) {
 	args := flag.Args()[1:]
 	if len(args) < 3 {
-		log.Fatal("Usage: fabricx-client deploy <network-id> <chaincode-name> <chaincode-path> [--version v] [--lang go|node]")
+		log.Fatal("Usage: fabricx-client deploy <network-id> <chaincode-name> <chaincode-path> [--upload | --git [--ref r]] [--package-id id] [--version v] [--lang go|node|typescript|java] [--collections file|json] [--policy expr] [--ccaas] [--debug [--debug-port n]] [--stage] [--vendor] [--determinism warn|enforce]")
 	}
 
 	networkID := args[0]
@@ -201,7 +336,19 @@ func deployChaincode(client pb.FabricXServiceClient) {
 	chaincodePath := args[2]
 
 	version := "1.0"
-	language := "golang"
+	language := "" // Detected by the server
+	collectionsConfig := ""
+	policy := ""
+	mode := ""
+	debug := false
+	debugPort := 0
+	stage := false
+	vendor := false
+	git := false
+	gitRef := ""
+	upload := false
+	packageID := ""
+	determinismCheck := ""
 
 	// Parse optional flags
 	for i := 3; i < len(args); i++ {
@@ -211,6 +358,41 @@ func deployChaincode(client pb.FabricXServiceClient) {
 		} else if args[i] == "--lang" && i+1 < len(args) {
 			language = args[i+1]
 			i++
+		} else if args[i] == "--collections" && i+1 < len(args) {
+			collectionsConfig = args[i+1]
+			i++
+		} else if args[i] == "--policy" && i+1 < len(args) {
+			policy = args[i+1]
+			i++
+		} else if args[i] == "--ccaas" {
+			mode = "ccaas"
+		} else if args[i] == "--debug" {
+			debug = true
+		} else if args[i] == "--debug-port" && i+1 < len(args) {
+			port, err := strconv.Atoi(args[i+1])
+			if err != nil {
+				log.Fatalf("Invalid debug port: %s", args[i+1])
+			}
+			debugPort = port
+			debug = true
+			i++
+		} else if args[i] == "--stage" {
+			stage = true
+		} else if args[i] == "--vendor" {
+			vendor = true
+		} else if args[i] == "--git" {
+			git = true
+		} else if args[i] == "--ref" && i+1 < len(args) {
+			gitRef = args[i+1]
+			i++
+		} else if args[i] == "--upload" {
+			upload = true
+		} else if args[i] == "--package-id" && i+1 < len(args) {
+			packageID = args[i+1]
+			i++
+		} else if args[i] == "--determinism" && i+1 < len(args) {
+			determinismCheck = args[i+1]
+			i++
 		}
 	}
 
@@ -219,17 +401,50 @@ func deployChaincode(client pb.FabricXServiceClient) {
 	fmt.Printf("   Chaincode: %s\n", chaincodeName)
 	fmt.Printf("   Path: %s\n", chaincodePath)
 	fmt.Printf("   Version: %s\n", version)
-	fmt.Printf("   Language: %s\n", language)
+	if language != "" {
+		fmt.Printf("   Language: %s\n", language)
+	}
+	if collectionsConfig != "" {
+		fmt.Printf("   Collections: %s\n", collectionsConfig)
+	}
+	if policy != "" {
+		fmt.Printf("   Endorsement Policy: %s\n", policy)
+	}
+	if debug {
+		fmt.Printf("   Mode: chaincode as a service under Delve\n")
+	} else if mode != "" {
+		fmt.Printf("   Mode: chaincode as a service\n")
+	}
+	if vendor {
+		fmt.Printf("   Vendoring Go modules\n")
+	}
+	if determinismCheck != "" {
+		fmt.Printf("   Determinism check: %s\n", determinismCheck)
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
 	defer cancel()
 
+	source := resolveSource(ctx, client, networkID, chaincodePath, git, upload, gitRef)
+
 	resp, err := client.DeployChaincode(ctx, &pb.DeployChaincodeRequest{
-		NetworkId:     networkID,
-		ChaincodeName: chaincodeName,
-		ChaincodePath: chaincodePath,
-		Version:       version,
-		Language:      language,
+		NetworkId:         networkID,
+		ChaincodeName:     chaincodeName,
+		ChaincodePath:     source.path,
+		UploadId:          source.uploadID,
+		GitUrl:            source.gitURL,
+		GitRef:            source.gitRef,
+		PackageId:         packageID,
+		Version:           version,
+		Language:          language,
+		CollectionsConfig: collectionsConfig,
+		EndorsementPolicy: policy,
+		Mode:              mode,
+		Debug:             debug,
+		DebugPort:         int32(debugPort),
+		Stage:             stage,
+		Vendor:            vendor,
+		DeterminismCheck:  determinismCheck,
 	})
 
 	if err != nil {
@@ -240,35 +455,228 @@ func deployChaincode(client pb.FabricXServiceClient) {
 		log.Fatalf("❌ Deployment failed: %s", resp.Message)
 	}
 
+	printDiagnostics(resp.Diagnostics)
+
+	if resp.Staged {
+		printStaged(networkID, chaincodeName)
+		return
+	}
+
 	fmt.Printf("\n✅ Chaincode deployed successfully!\n")
 	fmt.Printf("   Chaincode ID: %s\n", resp.ChaincodeId)
+	if resp.PackageId != "" {
+		fmt.Printf("   Package ID: %s\n", resp.PackageId)
+	}
+	printDebugPort(resp.DebugPort)
+}
+
+// printDebugPort tells the user how to attach a debugger
+func printDebugPort(port int32) {
+	if port == 0 {
+		return
+	}
+	fmt.Printf("\n🐞 Delve listening on localhost:%d\n", port)
+	fmt.Printf("   Attach with: dlv connect localhost:%d\n", port)
+	fmt.Printf("   Raise -timeout on invoke and query while sitting on breakpoints\n")
+}
+
+// printStaged tells the user how each org approves a staged definition
+func printStaged(networkID, chaincodeName string) {
+	fmt.Printf("\n📋 Chaincode definition staged for approval\n")
+	fmt.Printf("   Approve with: fabricx-client lifecycle approve %s %s <org>\n", networkID, chaincodeName)
+	fmt.Printf("   Commit with:  fabricx-client lifecycle commit %s %s\n", networkID, chaincodeName)
+}
+
+func upgradeChaincode(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 3 {
+		log.Fatal("Usage: fabricx-client upgrade <network-id> <chaincode-name> <chaincode-path> [--upload | --git [--ref r]] [--package-id id] [--version v] [--lang go|node|typescript|java] [--collections file|json] [--policy expr] [--ccaas] [--debug [--debug-port n]] [--stage] [--vendor] [--determinism warn|enforce]")
+	}
+
+	networkID := args[0]
+	chaincodeName := args[1]
+	chaincodePath := args[2]
+
+	version := ""
+	language := "" // Detected by the server
+	collectionsConfig := ""
+	policy := ""
+	mode := ""
+	debug := false
+	debugPort := 0
+	stage := false
+	vendor := false
+	git := false
+	gitRef := ""
+	upload := false
+	packageID := ""
+	determinismCheck := ""
+
+	// Parse optional flags
+	for i := 3; i < len(args); i++ {
+		if args[i] == "--version" && i+1 < len(args) {
+			version = args[i+1]
+			i++
+		} else if args[i] == "--lang" && i+1 < len(args) {
+			language = args[i+1]
+			i++
+		} else if args[i] == "--collections" && i+1 < len(args) {
+			collectionsConfig = args[i+1]
+			i++
+		} else if args[i] == "--policy" && i+1 < len(args) {
+			policy = args[i+1]
+			i++
+		} else if args[i] == "--ccaas" {
+			mode = "ccaas"
+		} else if args[i] == "--debug" {
+			debug = true
+		} else if args[i] == "--debug-port" && i+1 < len(args) {
+			port, err := strconv.Atoi(args[i+1])
+			if err != nil {
+				log.Fatalf("Invalid debug port: %s", args[i+1])
+			}
+			debugPort = port
+			debug = true
+			i++
+		} else if args[i] == "--stage" {
+			stage = true
+		} else if args[i] == "--vendor" {
+			vendor = true
+		} else if args[i] == "--git" {
+			git = true
+		} else if args[i] == "--ref" && i+1 < len(args) {
+			gitRef = args[i+1]
+			i++
+		} else if args[i] == "--upload" {
+			upload = true
+		} else if args[i] == "--package-id" && i+1 < len(args) {
+			packageID = args[i+1]
+			i++
+		} else if args[i] == "--determinism" && i+1 < len(args) {
+			determinismCheck = args[i+1]
+			i++
+		}
+	}
+
+	fmt.Printf("⬆️  Upgrading chaincode...\n")
+	fmt.Printf("   Network: %s\n", networkID)
+	fmt.Printf("   Chaincode: %s\n", chaincodeName)
+	fmt.Printf("   Path: %s\n", chaincodePath)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	source := resolveSource(ctx, client, networkID, chaincodePath, git, upload, gitRef)
+
+	resp, err := client.UpgradeChaincode(ctx, &pb.UpgradeChaincodeRequest{
+		NetworkId:         networkID,
+		ChaincodeName:     chaincodeName,
+		ChaincodePath:     source.path,
+		UploadId:          source.uploadID,
+		GitUrl:            source.gitURL,
+		GitRef:            source.gitRef,
+		PackageId:         packageID,
+		Version:           version,
+		Language:          language,
+		CollectionsConfig: collectionsConfig,
+		EndorsementPolicy: policy,
+		Mode:              mode,
+		Debug:             debug,
+		DebugPort:         int32(debugPort),
+		Stage:             stage,
+		Vendor:            vendor,
+		DeterminismCheck:  determinismCheck,
+	})
+
+	if err != nil {
+		log.Fatalf("❌ Failed to upgrade chaincode: %v", err)
+	}
+
+	if !resp.Success {
+		log.Fatalf("❌ Upgrade failed: %s", resp.Message)
+	}
+
+	printDiagnostics(resp.Diagnostics)
+
+	if resp.Staged {
+		printStaged(networkID, chaincodeName)
+		return
+	}
+
+	if resp.Restarted {
+		fmt.Printf("\n✅ Chaincode service restarted!\n")
+		fmt.Printf("   Sequence: %d (definition unchanged)\n", resp.NewSequence)
+		printDebugPort(resp.DebugPort)
+		return
+	}
+
+	fmt.Printf("\n✅ Chaincode upgraded successfully!\n")
+	fmt.Printf("   Version: %s -> %s\n", resp.OldVersion, resp.NewVersion)
+	fmt.Printf("   Sequence: %d -> %d\n", resp.OldSequence, resp.NewSequence)
+	if !resp.Reinstalled {
+		fmt.Printf("   Package: unchanged, reused installed package\n")
+	} else if resp.PackageId != "" {
+		fmt.Printf("   Package ID: %s\n", resp.PackageId)
+	}
+	printDebugPort(resp.DebugPort)
 }
 
 func invokeTransaction(client pb.FabricXServiceClient) {
 	args := flag.Args()[1:]
 	if len(args) < 3 {
-		log.Fatal("Usage: fabricx-client invoke <network-id> <chaincode> <function> [args...]")
+		log.Fatal("Usage: fabricx-client invoke <network-id> <chaincode> <function> [args...] [--org org] [--as identity] [--transient key=value]... [--collection name] [--endorse-org org]... [--endorse-peer peer]... [--auto-endorse] [--check-determinism]")
 	}
 
 	networkID := args[0]
 	chaincodeName := args[1]
 	functionName := args[2]
-	txArgs := args[3:]
+	txArgs, opts := parseInvokeFlags(args[3:])
 
 	fmt.Printf("📝 Invoking transaction...\n")
 	fmt.Printf("   Network: %s\n", networkID)
 	fmt.Printf("   Chaincode: %s\n", chaincodeName)
 	fmt.Printf("   Function: %s\n", functionName)
 	fmt.Printf("   Args: %v\n", txArgs)
+	printSigner(opts.org, opts.identity)
+	if len(opts.transient) > 0 {
+		keys := make([]string, 0, len(opts.transient))
+		for key := range opts.transient {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fmt.Printf("   Transient: %v\n", keys)
+	}
+	if opts.collection != "" {
+		fmt.Printf("   Collection: %s\n", opts.collection)
+	}
+	switch {
+	case len(opts.endorsingPeers) > 0:
+		fmt.Printf("   Endorsing peers: %v\n", opts.endorsingPeers)
+	case len(opts.endorsingOrgs) > 0:
+		fmt.Printf("   Endorsing orgs: %v\n", opts.endorsingOrgs)
+	case opts.autoEndorse:
+		fmt.Printf("   Endorsing peers: chosen from the endorsement policy\n")
+	}
+	if opts.checkDeterminism {
+		fmt.Printf("   Determinism check: endorse on every peer, do not submit\n")
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
 	defer cancel()
 
 	resp, err := client.InvokeTransaction(ctx, &pb.InvokeTransactionRequest{
-		NetworkId:     networkID,
-		ChaincodeName: chaincodeName,
-		FunctionName:  functionName,
-		Args:          txArgs,
+		NetworkId:        networkID,
+		ChaincodeName:    chaincodeName,
+		FunctionName:     functionName,
+		Args:             txArgs,
+		Org:              opts.org,
+		Identity:         opts.identity,
+		Transient:        opts.transient,
+		Collection:       opts.collection,
+		EndorsingOrgs:    opts.endorsingOrgs,
+		EndorsingPeers:   opts.endorsingPeers,
+		AutoEndorse:      opts.autoEndorse,
+		CheckDeterminism: opts.checkDeterminism,
 	})
 
 	if err != nil {
@@ -276,33 +684,147 @@ func invokeTransaction(client pb.FabricXServiceClient) {
 	}
 
 	if !resp.Success {
-		log.Fatalf("❌ Transaction failed: %s", resp.Message)
+		printInvokeFailure(resp)
+	}
+
+	if resp.Determinism != nil {
+		printDeterminismReport(resp.Message, resp.Determinism)
+		return
 	}
 
 	fmt.Printf("\n✅ Transaction invoked successfully!\n")
 	fmt.Printf("   Transaction ID: %s\n", resp.TransactionId)
+	fmt.Printf("   Block: %d\n", resp.BlockNumber)
+	fmt.Printf("   Validation code: %s\n", resp.ValidationCode)
+	fmt.Printf("   Endorsed by: %s\n", strings.Join(resp.EndorsingPeers, ", "))
+	fmt.Printf("   Chaincode response: %s\n", formatChaincodeResponse(resp))
 
 	if len(resp.Payload) > 0 {
-		fmt.Printf("   Payload: %s\n", string(resp.Payload))
+		fmt.Printf("   Payload: %s\n", formatBytes(resp.Payload))
+	}
+}
+
+// printInvokeFailure tells whether the transaction was rejected by its
+// endorsers, endorsed differently by them or invalidated after ordering,
+// and whether to retry it
+func printInvokeFailure(resp *pb.InvokeTransactionResponse) {
+	switch resp.Reason {
+	case "endorsement_rejected":
+		fmt.Printf("\n🚫 Rejected at endorsement, the transaction was not ordered\n")
+		fmt.Printf("   Transaction ID: %s\n", resp.TransactionId)
+		fmt.Printf("   Chaincode response: %s\n", formatChaincodeResponse(resp))
+	case "endorsement_mismatch":
+		fmt.Printf("\n🚫 Endorsers returned different results, the transaction was not ordered\n")
+		fmt.Printf("   Transaction ID: %s\n", resp.TransactionId)
+		fmt.Printf("   💡 Run it with --check-determinism to see where they differ\n")
+	case "invalidated":
+		fmt.Printf("\n🚫 Invalidated in block %d: %s\n", resp.BlockNumber, resp.ValidationCode)
+		fmt.Printf("   Transaction ID: %s\n", resp.TransactionId)
+		if resp.Retryable {
+			fmt.Printf("   💡 Keys it read changed before it committed; invoking it again may succeed\n")
+		}
+	}
+	log.Fatalf("❌ Transaction failed: %s", resp.Message)
+}
+
+func formatChaincodeResponse(resp *pb.InvokeTransactionResponse) string {
+	if resp.ChaincodeMessage == "" {
+		return fmt.Sprintf("%d", resp.ChaincodeStatus)
+	}
+	return fmt.Sprintf("%d %s", resp.ChaincodeStatus, resp.ChaincodeMessage)
+}
+
+type invokeOptions struct {
+	org        string
+	identity   string
+	collection string
+	transient  map[string][]byte
+
+	endorsingOrgs    []string
+	endorsingPeers   []string
+	autoEndorse      bool
+	checkDeterminism bool
+}
+
+// parseInvokeFlags pulls --org, --as, --transient, --collection and the
+// endorsement flags out of the chaincode arguments
+func parseInvokeFlags(args []string) ([]string, *invokeOptions) {
+	rest := []string{}
+	opts := &invokeOptions{}
+	for i := 0; i < len(args); i++ {
+		if args[i] == "--auto-endorse" {
+			opts.autoEndorse = true
+			continue
+		}
+		if args[i] == "--check-determinism" {
+			opts.checkDeterminism = true
+			continue
+		}
+		if i+1 >= len(args) {
+			rest = append(rest, args[i])
+			continue
+		}
+
+		switch args[i] {
+		case "--org":
+			opts.org = args[i+1]
+		case "--as":
+			opts.identity = args[i+1]
+		case "--collection":
+			opts.collection = args[i+1]
+		case "--endorse-org":
+			opts.endorsingOrgs = append(opts.endorsingOrgs, args[i+1])
+		case "--endorse-peer":
+			opts.endorsingPeers = append(opts.endorsingPeers, args[i+1])
+		case "--transient":
+			key, value, ok := strings.Cut(args[i+1], "=")
+			if !ok {
+				log.Fatalf("❌ Invalid transient value %q, expected key=value", args[i+1])
+			}
+			if opts.transient == nil {
+				opts.transient = make(map[string][]byte)
+			}
+			opts.transient[key] = []byte(value)
+		default:
+			rest = append(rest, args[i])
+			continue
+		}
+		i++
+	}
+	return rest, opts
+}
+
+func printSigner(org, identity string) {
+	if org == "" && identity == "" {
+		return
+	}
+	if identity == "" {
+		identity = "Admin"
+	}
+	if org != "" {
+		fmt.Printf("   Signer: %s (%s)\n", identity, org)
+	} else {
+		fmt.Printf("   Signer: %s\n", identity)
 	}
 }
 
 func queryLedger(client pb.FabricXServiceClient) {
 	args := flag.Args()[1:]
 	if len(args) < 3 {
-		log.Fatal("Usage: fabricx-client query <network-id> <chaincode> <function> [args...]")
+		log.Fatal("Usage: fabricx-client query <network-id> <chaincode> <function> [args...] [--org org] [--as identity]")
 	}
 
 	networkID := args[0]
 	chaincodeName := args[1]
 	functionName := args[2]
-	queryArgs := args[3:]
+	queryArgs, opts := parseInvokeFlags(args[3:])
 
 	fmt.Printf("🔍 Querying ledger...\n")
 	fmt.Printf("   Network: %s\n", networkID)
 	fmt.Printf("   Chaincode: %s\n", chaincodeName)
 	fmt.Printf("   Function: %s\n", functionName)
 	fmt.Printf("   Args: %v\n", queryArgs)
+	printSigner(opts.org, opts.identity)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -312,6 +834,8 @@ func queryLedger(client pb.FabricXServiceClient) {
 		ChaincodeName: chaincodeName,
 		FunctionName:  functionName,
 		Args:          queryArgs,
+		Org:           opts.org,
+		Identity:      opts.identity,
 	})
 
 	if err != nil {
@@ -377,6 +901,77 @@ func streamLogs(client pb.FabricXServiceClient) {
 	}
 }
 
+func watchChaincode(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 3 {
+		log.Fatal("Usage: fabricx-client watch <network-id> <chaincode-name> <chaincode-path> [--lang go|node|typescript|java] [--collections file|json] [--policy expr] [--ccaas] [--debounce 1s] [--skip-checks] [--vendor]")
+	}
+
+	req := &pb.WatchChaincodeRequest{
+		NetworkId:     args[0],
+		ChaincodeName: args[1],
+		ChaincodePath: args[2],
+	}
+
+	// Parse optional flags
+	for i := 3; i < len(args); i++ {
+		if args[i] == "--lang" && i+1 < len(args) {
+			req.Language = args[i+1]
+			i++
+		} else if args[i] == "--collections" && i+1 < len(args) {
+			req.CollectionsConfig = args[i+1]
+			i++
+		} else if args[i] == "--policy" && i+1 < len(args) {
+			req.EndorsementPolicy = args[i+1]
+			i++
+		} else if args[i] == "--debounce" && i+1 < len(args) {
+			debounce, err := time.ParseDuration(args[i+1])
+			if err != nil {
+				log.Fatalf("❌ Invalid debounce %q: %v", args[i+1], err)
+			}
+			req.DebounceMs = debounce.Milliseconds()
+			i++
+		} else if args[i] == "--ccaas" {
+			req.Mode = "ccaas"
+		} else if args[i] == "--skip-checks" {
+			req.SkipChecks = true
+		} else if args[i] == "--vendor" {
+			req.Vendor = true
+		}
+	}
+
+	fmt.Printf("👀 Watching chaincode %s in %s\n", req.ChaincodeName, req.ChaincodePath)
+	fmt.Println("   Press Ctrl+C to stop")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.WatchChaincode(ctx, req)
+	if err != nil {
+		log.Fatalf("❌ Failed to start watching: %v", err)
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			log.Printf("Watch ended: %v", err)
+			break
+		}
+
+		switch event.Type {
+		case "deployed":
+			fmt.Printf("[%s] ✅ %s\n", event.Timestamp, event.Message)
+		case "check_failed", "deploy_failed":
+			fmt.Printf("[%s] ❌ %s\n", event.Timestamp, event.Message)
+			if event.Output != "" {
+				fmt.Println(event.Output)
+			}
+		default:
+			fmt.Printf("[%s] %s\n", event.Timestamp, event.Message)
+		}
+	}
+}
+
 func stopNetwork(client pb.FabricXServiceClient) {
 	args := flag.Args()[1:]
 	if len(args) < 1 {
@@ -419,3 +1014,488 @@ func stopNetwork(client pb.FabricXServiceClient) {
 		fmt.Printf("   All containers and volumes removed\n")
 	}
 }
+
+func exportTopology(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 1 {
+		log.Fatal("Usage: fabricx-client topology <network-id> [--format yaml|json]")
+	}
+
+	networkID := args[0]
+	format := "yaml"
+
+	for i := 1; i < len(args); i++ {
+		if args[i] == "--format" && i+1 < len(args) {
+			format = args[i+1]
+			i++
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.ExportTopology(ctx, &pb.ExportTopologyRequest{
+		NetworkId: networkID,
+		Format:    format,
+	})
+
+	if err != nil {
+		log.Fatalf("❌ Failed to export topology: %v", err)
+	}
+
+	if !resp.Success {
+		log.Fatalf("❌ Export failed: %s", resp.Message)
+	}
+
+	fmt.Print(resp.Topology)
+}
+
+func manageIdentity(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 3 {
+		log.Fatal("Usage: fabricx-client identity register|enroll|revoke|list <network-id> <org> ...")
+	}
+
+	action, networkID, org := args[0], args[1], args[2]
+	rest := args[3:]
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	switch action {
+	case "register":
+		if len(rest) < 1 {
+			log.Fatal("Usage: fabricx-client identity register <network-id> <org> <name> [--secret s] [--type client|admin|peer|orderer] [--affiliation a] [--attr key=value]... [--max-enrollments n]")
+		}
+
+		req := &pb.RegisterIdentityRequest{
+			NetworkId:  networkID,
+			Org:        org,
+			Name:       rest[0],
+			Attributes: map[string]string{},
+		}
+		for i := 1; i < len(rest); i++ {
+			if rest[i] == "--secret" && i+1 < len(rest) {
+				req.Secret = rest[i+1]
+				i++
+			} else if rest[i] == "--type" && i+1 < len(rest) {
+				req.Type = rest[i+1]
+				i++
+			} else if rest[i] == "--affiliation" && i+1 < len(rest) {
+				req.Affiliation = rest[i+1]
+				i++
+			} else if rest[i] == "--attr" && i+1 < len(rest) {
+				key, value, ok := strings.Cut(rest[i+1], "=")
+				if !ok {
+					log.Fatalf("❌ Invalid attribute %q, expected key=value", rest[i+1])
+				}
+				req.Attributes[key] = value
+				i++
+			} else if rest[i] == "--max-enrollments" && i+1 < len(rest) {
+				if _, err := fmt.Sscanf(rest[i+1], "%d", &req.MaxEnrollments); err != nil {
+					log.Printf("Warning: could not parse max enrollments: %v", err)
+				}
+				i++
+			}
+		}
+
+		resp, err := client.RegisterIdentity(ctx, req)
+		if err != nil {
+			log.Fatalf("❌ Failed to register identity: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Registration failed: %s", resp.Message)
+		}
+
+		fmt.Printf("✅ %s\n", resp.Message)
+		fmt.Printf("   Secret: %s\n", resp.Secret)
+
+	case "enroll":
+		if len(rest) < 2 {
+			log.Fatal("Usage: fabricx-client identity enroll <network-id> <org> <name> <secret> [--attr name]...")
+		}
+
+		req := &pb.EnrollIdentityRequest{
+			NetworkId: networkID,
+			Org:       org,
+			Name:      rest[0],
+			Secret:    rest[1],
+		}
+		for i := 2; i < len(rest); i++ {
+			if rest[i] == "--attr" && i+1 < len(rest) {
+				req.Attributes = append(req.Attributes, rest[i+1])
+				i++
+			}
+		}
+
+		resp, err := client.EnrollIdentity(ctx, req)
+		if err != nil {
+			log.Fatalf("❌ Failed to enroll identity: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Enrollment failed: %s", resp.Message)
+		}
+
+		fmt.Printf("✅ %s\n", resp.Message)
+		fmt.Printf("   MSP: %s\n", resp.MspPath)
+
+	case "revoke":
+		if len(rest) < 1 {
+			log.Fatal("Usage: fabricx-client identity revoke <network-id> <org> <name> [--reason r]")
+		}
+
+		req := &pb.RevokeIdentityRequest{
+			NetworkId: networkID,
+			Org:       org,
+			Name:      rest[0],
+		}
+		for i := 1; i < len(rest); i++ {
+			if rest[i] == "--reason" && i+1 < len(rest) {
+				req.Reason = rest[i+1]
+				i++
+			}
+		}
+
+		resp, err := client.RevokeIdentity(ctx, req)
+		if err != nil {
+			log.Fatalf("❌ Failed to revoke identity: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Revocation failed: %s", resp.Message)
+		}
+
+		fmt.Printf("✅ %s\n", resp.Message)
+
+	case "list":
+		resp, err := client.ListIdentities(ctx, &pb.ListIdentitiesRequest{
+			NetworkId: networkID,
+			Org:       org,
+		})
+		if err != nil {
+			log.Fatalf("❌ Failed to list identities: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Listing failed: %s", resp.Message)
+		}
+
+		fmt.Printf("Identities in %s:\n", org)
+		for _, id := range resp.Identities {
+			fmt.Printf("  - %s (%s)\n", id.Name, id.Type)
+			if id.Affiliation != "" {
+				fmt.Printf("    Affiliation: %s\n", id.Affiliation)
+			}
+			for key, value := range id.Attributes {
+				fmt.Printf("    %s=%s\n", key, value)
+			}
+		}
+
+	default:
+		log.Fatalf("Unknown identity action: %s", action)
+	}
+}
+
+func getCollections(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 1 {
+		log.Fatal("Usage: fabricx-client collections <network-id> [chaincode]")
+	}
+
+	networkID := args[0]
+	chaincodeName := ""
+	if len(args) > 1 {
+		chaincodeName = args[1]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := client.GetCollections(ctx, &pb.GetCollectionsRequest{
+		NetworkId:     networkID,
+		ChaincodeName: chaincodeName,
+	})
+	if err != nil {
+		log.Fatalf("❌ Failed to get collections: %v", err)
+	}
+	if !resp.Success {
+		log.Fatalf("❌ Query failed: %s", resp.Message)
+	}
+
+	if len(resp.Collections) == 0 {
+		fmt.Println("No private data collections defined")
+		return
+	}
+
+	fmt.Printf("🔒 Private Data Collections:\n")
+	for _, c := range resp.Collections {
+		fmt.Printf("\n  %s/%s\n", c.ChaincodeName, c.Name)
+		fmt.Printf("    Policy: %s\n", c.Policy)
+		fmt.Printf("    Members: %s\n", strings.Join(c.MemberOrgs, ", "))
+		fmt.Printf("    Peers: %s\n", strings.Join(c.Peers, ", "))
+		fmt.Printf("    Required/Max Peers: %d/%d\n", c.RequiredPeerCount, c.MaxPeerCount)
+		if c.BlockToLive > 0 {
+			fmt.Printf("    Block To Live: %d\n", c.BlockToLive)
+		}
+	}
+}
+
+func listChaincodes(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 1 {
+		log.Fatal("Usage: fabricx-client chaincodes <network-id> [chaincode]")
+	}
+
+	networkID := args[0]
+	if len(args) > 1 {
+		getChaincodeDefinition(client, networkID, args[1])
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := client.ListChaincodes(ctx, &pb.ListChaincodesRequest{NetworkId: networkID})
+	if err != nil {
+		log.Fatalf("❌ Failed to list chaincodes: %v", err)
+	}
+	if !resp.Success {
+		log.Fatalf("❌ Query failed: %s", resp.Message)
+	}
+
+	for _, ch := range resp.Channels {
+		fmt.Printf("📜 Committed on %s:\n", ch.Channel)
+		if len(ch.Committed) == 0 {
+			fmt.Println("  (none)")
+		}
+		for _, def := range ch.Committed {
+			printDefinition(def)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("📦 Installed:\n")
+	printPeerPackages(resp.Installed)
+}
+
+func getChaincodeDefinition(client pb.FabricXServiceClient, networkID, chaincodeName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := client.GetChaincodeDefinition(ctx, &pb.GetChaincodeDefinitionRequest{
+		NetworkId:     networkID,
+		ChaincodeName: chaincodeName,
+	})
+	if err != nil {
+		log.Fatalf("❌ Failed to get chaincode definition: %v", err)
+	}
+	if !resp.Success {
+		log.Fatalf("❌ Query failed: %s", resp.Message)
+	}
+
+	if len(resp.Channels) == 0 {
+		fmt.Printf("📜 Not defined on any channel\n\n")
+	}
+	for _, ch := range resp.Channels {
+		fmt.Printf("📜 Committed on %s:\n", ch.Channel)
+		if ch.Committed == nil {
+			fmt.Println("  (not committed)")
+		} else {
+			printDefinition(ch.Committed)
+		}
+
+		if ch.Pending != nil {
+			fmt.Printf("\n⏳ Pending approval on %s:\n", ch.Channel)
+			printDefinition(ch.Pending)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("📦 Installed:\n")
+	printPeerPackages(resp.Installed)
+}
+
+// printDefinition prints a chaincode definition with its approvals
+func printDefinition(def *pb.ChaincodeDefinition) {
+	fmt.Printf("\n  %s (version %s, sequence %d)\n", def.Name, def.Version, def.Sequence)
+	fmt.Printf("    Endorsement Policy: %s\n", def.EndorsementPolicy)
+	if def.InitRequired {
+		fmt.Printf("    Init Required: yes\n")
+	}
+	for _, c := range def.Collections {
+		fmt.Printf("    Collection %s: %s\n", c.Name, c.Policy)
+	}
+
+	if len(def.Approvals) > 0 {
+		mspIDs := make([]string, 0, len(def.Approvals))
+		for mspID := range def.Approvals {
+			mspIDs = append(mspIDs, mspID)
+		}
+		sort.Strings(mspIDs)
+
+		fmt.Printf("    Approvals:\n")
+		for _, mspID := range mspIDs {
+			mark := "✅"
+			if !def.Approvals[mspID] {
+				mark = "❌"
+			}
+			fmt.Printf("      %s %s\n", mark, mspID)
+		}
+	}
+}
+
+// printPeerPackages prints the packages installed on each peer
+func printPeerPackages(peers []*pb.PeerPackages) {
+	if len(peers) == 0 {
+		fmt.Println("  (none)")
+	}
+	for _, peer := range peers {
+		fmt.Printf("\n  %s (%s)\n", peer.Peer, peer.Org)
+		for _, pkg := range peer.Packages {
+			fmt.Printf("    %s", pkg.PackageId)
+			if len(pkg.References) > 0 {
+				fmt.Printf(" -> %s", strings.Join(pkg.References, ", "))
+			}
+			fmt.Println()
+		}
+	}
+}
+
+func manageLifecycle(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 3 {
+		log.Fatal("Usage: fabricx-client lifecycle approve|reject|readiness|commit <network-id> <chaincode> ...")
+	}
+
+	action, networkID, chaincodeName := args[0], args[1], args[2]
+	rest := args[3:]
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	switch action {
+	case "approve":
+		if len(rest) < 1 {
+			log.Fatal("Usage: fabricx-client lifecycle approve <network-id> <chaincode> <org>")
+		}
+
+		resp, err := client.ApproveChaincode(ctx, &pb.ApproveChaincodeRequest{
+			NetworkId:     networkID,
+			ChaincodeName: chaincodeName,
+			Org:           rest[0],
+		})
+		if err != nil {
+			log.Fatalf("❌ Failed to approve chaincode: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Approval failed: %s", resp.Message)
+		}
+
+		fmt.Printf("✅ %s\n", resp.Message)
+
+	case "reject":
+		if len(rest) < 1 {
+			log.Fatal("Usage: fabricx-client lifecycle reject <network-id> <chaincode> <org> [--reason r]")
+		}
+
+		req := &pb.RejectChaincodeRequest{
+			NetworkId:     networkID,
+			ChaincodeName: chaincodeName,
+			Org:           rest[0],
+		}
+		for i := 1; i < len(rest); i++ {
+			if rest[i] == "--reason" && i+1 < len(rest) {
+				req.Reason = rest[i+1]
+				i++
+			}
+		}
+
+		resp, err := client.RejectChaincode(ctx, req)
+		if err != nil {
+			log.Fatalf("❌ Failed to reject chaincode: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Rejection failed: %s", resp.Message)
+		}
+
+		fmt.Printf("🚫 %s\n", resp.Message)
+
+	case "readiness":
+		resp, err := client.CheckCommitReadiness(ctx, &pb.CheckCommitReadinessRequest{
+			NetworkId:     networkID,
+			ChaincodeName: chaincodeName,
+		})
+		if err != nil {
+			log.Fatalf("❌ Failed to check commit readiness: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Readiness check failed: %s", resp.Message)
+		}
+
+		printReadiness(chaincodeName, resp)
+
+	case "commit":
+		resp, err := client.CommitChaincode(ctx, &pb.CommitChaincodeRequest{
+			NetworkId:     networkID,
+			ChaincodeName: chaincodeName,
+		})
+		if err != nil {
+			log.Fatalf("❌ Failed to commit chaincode: %v", err)
+		}
+		if !resp.Success {
+			switch resp.Reason {
+			case "rejected":
+				log.Fatalf("🚫 Definition rejected: %s", resp.Message)
+			case "mismatch":
+				log.Fatalf("⚠️  Orgs approved a different definition: %s", resp.Message)
+			case "missing_approvals":
+				log.Fatalf("⏳ Waiting for approvals: %s", resp.Message)
+			}
+			log.Fatalf("❌ Commit failed: %s", resp.Message)
+		}
+
+		fmt.Printf("✅ Chaincode committed successfully!\n")
+		fmt.Printf("   Chaincode ID: %s\n", resp.ChaincodeId)
+		fmt.Printf("   Version: %s\n", resp.Version)
+		fmt.Printf("   Sequence: %d\n", resp.Sequence)
+
+	default:
+		log.Fatalf("Unknown lifecycle action: %s", action)
+	}
+}
+
+// printReadiness prints which orgs approved a staged definition
+func printReadiness(chaincodeName string, resp *pb.CheckCommitReadinessResponse) {
+	fmt.Printf("📋 %s (version %s, sequence %d)\n", chaincodeName, resp.Version, resp.Sequence)
+	fmt.Printf("   Lifecycle Policy: %s\n", resp.LifecyclePolicy)
+
+	mspIDs := make([]string, 0, len(resp.Approvals))
+	for mspID := range resp.Approvals {
+		mspIDs = append(mspIDs, mspID)
+	}
+	sort.Strings(mspIDs)
+
+	mismatched := map[string]bool{}
+	for _, mspID := range resp.Mismatched {
+		mismatched[mspID] = true
+	}
+
+	fmt.Printf("   Approvals:\n")
+	for _, mspID := range mspIDs {
+		reason, rejected := resp.Rejections[mspID]
+		switch {
+		case resp.Approvals[mspID]:
+			fmt.Printf("     ✅ %s\n", mspID)
+		case mismatched[mspID]:
+			fmt.Printf("     ⚠️  %s (approved a different definition)\n", mspID)
+		case rejected:
+			fmt.Printf("     🚫 %s (rejected: %s)\n", mspID, reason)
+		default:
+			fmt.Printf("     ⏳ %s\n", mspID)
+		}
+	}
+
+	if resp.Ready {
+		fmt.Printf("\n✅ Ready to commit\n")
+	} else {
+		fmt.Printf("\n⏳ Not ready to commit\n")
+	}
+}
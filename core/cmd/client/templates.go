@@ -0,0 +1,171 @@
+// cmd/client/templates.go
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	pb "github.com/temmyjay001/core/pkg/grpcserver"
+)
+
+func listTemplates(client pb.FabricXServiceClient) {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := client.ListTemplates(ctx, &pb.ListTemplatesRequest{})
+	if err != nil {
+		log.Fatalf("❌ Failed to list templates: %v", err)
+	}
+	if !resp.Success {
+		log.Fatalf("❌ Query failed: %s", resp.Message)
+	}
+
+	fmt.Println("📋 Chaincode templates:")
+	for _, t := range resp.Templates {
+		fmt.Printf("\n  %s - %s\n", t.Name, t.Title)
+		fmt.Printf("    %s\n", t.Description)
+		fmt.Printf("    Languages: %s\n", strings.Join(t.Languages, ", "))
+		fmt.Printf("    Contract: %s (package %s, module %s)\n", t.ContractName, t.PackageName, t.ModulePath)
+
+		fmt.Printf("    Functions:\n")
+		for _, fn := range t.Functions {
+			kind := "invoke"
+			if fn.Query {
+				kind = "query"
+			}
+			fmt.Printf("      %-28s %-6s %s\n", fmt.Sprintf("%s(%s)", fn.Name, strings.Join(fn.Args, ", ")), kind, fn.Description)
+		}
+
+		fmt.Printf("    Events:\n")
+		for _, event := range t.Events {
+			fmt.Printf("      %-28s %s\n", event.Name, event.Description)
+		}
+	}
+}
+
+func scaffoldChaincode(client pb.FabricXServiceClient) {
+	args := flag.Args()[1:]
+	if len(args) < 2 {
+		log.Fatal("Usage: fabricx-client scaffold <template> <target> [--lang go|typescript] [--module path] [--contract Name] [--package name] [--archive] [--on-runtime]")
+	}
+
+	req := &pb.ScaffoldChaincodeRequest{Template: args[0]}
+	target := args[1]
+	archive := false
+	onRuntime := false
+
+	// Parse optional flags
+	for i := 2; i < len(args); i++ {
+		if args[i] == "--lang" && i+1 < len(args) {
+			req.Language = args[i+1]
+			i++
+		} else if args[i] == "--module" && i+1 < len(args) {
+			req.ModulePath = args[i+1]
+			i++
+		} else if args[i] == "--contract" && i+1 < len(args) {
+			req.ContractName = args[i+1]
+			i++
+		} else if args[i] == "--package" && i+1 < len(args) {
+			req.PackageName = args[i+1]
+			i++
+		} else if args[i] == "--archive" {
+			archive = true
+		} else if args[i] == "--on-runtime" {
+			onRuntime = true
+		}
+	}
+
+	// The runtime writes the folder itself only when asked to; otherwise it
+	// returns an archive, so scaffolding works against a remote runtime
+	if onRuntime {
+		req.TargetDir = target
+	} else if !archive {
+		if entries, err := os.ReadDir(target); err == nil && len(entries) > 0 {
+			log.Fatalf("❌ %s is not empty", target)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	fmt.Printf("🧩 Scaffolding %s...\n", req.Template)
+
+	resp, err := client.ScaffoldChaincode(ctx, req)
+	if err != nil {
+		log.Fatalf("❌ Failed to scaffold chaincode: %v", err)
+	}
+	if !resp.Success {
+		log.Fatalf("❌ Scaffold failed: %s", resp.Message)
+	}
+
+	switch {
+	case onRuntime:
+	case archive:
+		if err := os.WriteFile(target, resp.Archive, 0644); err != nil {
+			log.Fatalf("❌ Failed to write archive: %v", err)
+		}
+	default:
+		if err := extractScaffold(resp.Archive, target); err != nil {
+			log.Fatalf("❌ Failed to write chaincode: %v", err)
+		}
+	}
+
+	fmt.Printf("\n✅ Chaincode scaffolded!\n")
+	fmt.Printf("   Location: %s\n", target)
+	fmt.Printf("   Language: %s\n", resp.Language)
+	fmt.Printf("   Contract: %s\n", resp.ContractName)
+	fmt.Printf("   Package: %s\n", resp.PackageName)
+	if resp.Language == "go" {
+		fmt.Printf("   Module: %s\n", resp.ModulePath)
+	}
+	fmt.Printf("   Files:\n")
+	for _, f := range resp.Files {
+		fmt.Printf("     %s\n", f)
+	}
+}
+
+// extractScaffold writes the files of a scaffold archive into dir
+func extractScaffold(archive []byte, dir string) error {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(hdr.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(filepath.Separator)) {
+			return fmt.Errorf("archive entry %q is outside %s", hdr.Name, dir)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(target, data, 0644); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,216 @@
+// cmd/client/upload.go
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+
+	pb "github.com/temmyjay001/core/pkg/grpcserver"
+)
+
+// uploadChunkSize is the size of each message of an upload stream
+const uploadChunkSize = 1 << 20
+
+// chaincodeSource is where the runtime reads chaincode from
+type chaincodeSource struct {
+	path     string // Folder or lifecycle package on the runtime host
+	uploadID string
+	gitURL   string
+	gitRef   string
+}
+
+// resolveSource reads the source argument of deploy and upgrade: a git URL
+// with --git, a local file or folder to upload with --upload, and otherwise
+// a path on the runtime host
+func resolveSource(ctx context.Context, client pb.FabricXServiceClient, networkID, arg string, git, upload bool, ref string) chaincodeSource {
+	switch {
+	case git:
+		return chaincodeSource{gitURL: arg, gitRef: ref}
+	case upload:
+		fmt.Printf("📤 Uploading %s...\n", arg)
+		resp, err := uploadChaincode(ctx, client, networkID, arg)
+		if err != nil {
+			log.Fatalf("❌ Failed to upload chaincode: %v", err)
+		}
+		if !resp.Success {
+			log.Fatalf("❌ Upload failed: %s", resp.Message)
+		}
+		fmt.Printf("✓ Uploaded %d bytes\n", resp.Size)
+		if resp.PackageId != "" {
+			fmt.Printf("   Package ID: %s\n", resp.PackageId)
+		}
+		return chaincodeSource{uploadID: resp.UploadId}
+	}
+	return chaincodeSource{path: arg}
+}
+
+// uploadChaincode sends a local lifecycle package, source archive or
+// chaincode folder to the runtime and returns the stored upload
+func uploadChaincode(ctx context.Context, client pb.FabricXServiceClient, networkID, path string) (*pb.UploadChaincodeResponse, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := path
+	kind := "source"
+	if info.IsDir() {
+		// Folders are sent as a source archive
+		archive, err := os.CreateTemp("", "fabricx-upload-*.tar.gz")
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(archive.Name())
+
+		err = archiveFolder(archive, path)
+		archive.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to archive %s: %w", path, err)
+		}
+		file = archive.Name()
+	} else if isLifecyclePackage(path) {
+		kind = "package"
+	}
+
+	sum, err := fileSHA256(file)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stream, err := client.UploadChaincode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &pb.UploadChaincodeRequest{
+		NetworkId: networkID,
+		Kind:      kind,
+		FileName:  filepath.Base(file),
+		Sha256:    sum,
+	}
+	buf := make([]byte, uploadChunkSize)
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			msg.Data = buf[:n]
+			if err := stream.Send(msg); err != nil {
+				// The server closed the stream; its response says why
+				break
+			}
+			msg = &pb.UploadChaincodeRequest{}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stream.CloseAndRecv()
+}
+
+// archiveFolder writes a chaincode folder as a gzipped tar, leaving out
+// version control and installed dependencies
+func archiveFolder(w io.Writer, dir string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.WalkDir(dir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && (entry.Name() == ".git" || entry.Name() == "node_modules") {
+			return filepath.SkipDir
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		hdr, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		hdr.Name = filepath.ToSlash(rel)
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// isLifecyclePackage tells a package made by peer lifecycle chaincode
+// package apart from a source archive
+func isLifecyclePackage(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return false
+	}
+	found := make(map[string]bool)
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			return found["metadata.json"] && found["code.tar.gz"]
+		}
+		found[hdr.Name] = true
+	}
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,18 @@
+// cmd/determinism/main.go
+//
+// fabricx-determinism checks Go chaincode for code endorsers can execute
+// differently. Run it on its own or through go vet:
+//
+//	fabricx-determinism ./...
+//	go vet -vettool=$(which fabricx-determinism) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/temmyjay001/core/pkg/determinism"
+)
+
+func main() {
+	singlechecker.Main(determinism.Analyzer)
+}
@@ -13,6 +13,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/temmyjay001/core/pkg/chaincode"
 	"github.com/temmyjay001/core/pkg/docker"
 	"github.com/temmyjay001/core/pkg/executor"
 	"github.com/temmyjay001/core/pkg/grpcserver"
@@ -31,6 +32,7 @@ func main() {
 	// CLI flags
 	port := flag.String("port", defaultPort, "gRPC server port")
 	showVersion := flag.Bool("version", false, "Show version information")
+	cacheDir := flag.String("cache-dir", chaincode.PackageCacheDir(), "Chaincode package cache directory")
 	flag.Parse()
 
 	if *showVersion {
@@ -43,6 +45,8 @@ func main() {
 		os.Exit(0)
 	}
 
+	chaincode.SetPackageCacheDir(*cacheDir)
+
 	// Ensure Docker is available
 	dockerManager := docker.NewManager(executor.NewRealExecutor())
 	if err := checkDockerAvailable(dockerManager); err != nil {
@@ -0,0 +1,95 @@
+// core/pkg/chaincode/cache.go
+package chaincode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// packageCacheDir holds chaincode packages shared by every network of the
+// runtime, named after their cache key
+var packageCacheDir = defaultPackageCacheDir()
+
+func defaultPackageCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "fabricx", "packages")
+}
+
+// SetPackageCacheDir changes where chaincode packages are cached
+func SetPackageCacheDir(dir string) {
+	packageCacheDir = dir
+}
+
+// PackageCacheDir returns where chaincode packages are cached
+func PackageCacheDir() string {
+	return packageCacheDir
+}
+
+// packageCacheKey identifies a package by its source tree, language, label
+// and whether Go modules were vendored. Sources that cannot be hashed are
+// not cached.
+func packageCacheKey(sourceHash, language, label string, vendored bool) string {
+	if sourceHash == "" {
+		return ""
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%t", sourceHash, language, label, vendored)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// cachedPackage returns the path of a cached package, or an empty string
+// when the key was never packaged
+func cachedPackage(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	path := filepath.Join(packageCacheDir, key+".tar.gz")
+	if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+		return ""
+	}
+	return path
+}
+
+// packageIDOf computes the package ID a peer assigns to a package file:
+// its label and the SHA-256 of the file
+func packageIDOf(packageFile, label string) (string, error) {
+	f, err := os.Open(packageFile)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s:%s", label, hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// installedOn tells whether a peer already reports a package. Peers that
+// cannot be queried are treated as not having it.
+func (d *Deployer) installedOn(ctx context.Context, org *network.Organization, peer *network.Peer, packageID string) bool {
+	packages, err := d.queryInstalled(ctx, org, peer)
+	if err != nil {
+		return false
+	}
+
+	for _, pkg := range packages {
+		if pkg.PackageID == packageID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+// core/pkg/chaincode/cache_test.go
+package chaincode
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+// TestMain keeps tests out of the user's package cache
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "fabricx-cache-*")
+	if err != nil {
+		panic(err)
+	}
+	SetPackageCacheDir(dir)
+
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// packagingExecutor writes a package into the mounted output folder the way
+// peer lifecycle chaincode package does, and reports installedIDs as already
+// installed on every peer
+func packagingExecutor(t *testing.T, content string, installedIDs ...string) *executor.MockExecutor {
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case contains(args, "package"):
+			writePackageOutput(t, args, content)
+			return []byte("success"), nil
+		case contains(args, "queryinstalled"):
+			installed := []string{}
+			for _, id := range installedIDs {
+				installed = append(installed, fmt.Sprintf(`{"package_id": %q, "label": "mycc_1.0"}`, id))
+			}
+			return []byte(fmt.Sprintf(`{"installed_chaincodes": [%s]}`, strings.Join(installed, ","))), nil
+		case contains(args, "install"):
+			sum := sha256.Sum256([]byte(content))
+			return []byte("Chaincode code package identifier: mycc_1.0:" + hex.EncodeToString(sum[:])), nil
+		}
+		return []byte("success"), nil
+	}
+	return mockExec
+}
+
+// writePackageOutput writes content where a peer lifecycle chaincode
+// package container run with args would leave its package
+func writePackageOutput(t *testing.T, args []string, content string) {
+	var outputDir, file string
+	for i, arg := range args {
+		if arg == "-v" && strings.HasSuffix(args[i+1], ":/output") {
+			outputDir = strings.TrimSuffix(args[i+1], ":/output")
+		}
+		if strings.HasPrefix(arg, "/output/") {
+			file = strings.TrimPrefix(arg, "/output/")
+		}
+	}
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, file), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPackageCache(t *testing.T) {
+	srcDir := t.TempDir()
+	for name, content := range map[string]string{"go.mod": "module mycc", "main.go": "package main"} {
+		if err := os.WriteFile(filepath.Join(srcDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sum := sha256.Sum256([]byte("package bytes"))
+	packageID := "mycc_1.0:" + hex.EncodeToString(sum[:])
+
+	countCalls := func(m *executor.MockExecutor, arg string) int {
+		n := 0
+		for _, call := range m.Calls {
+			if contains(call.Args, arg) {
+				n++
+			}
+		}
+		return n
+	}
+
+	// First deploy packages into the cache
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := packagingExecutor(t, "package bytes")
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	result, err := deployer.deploy(context.Background(), &DeployRequest{Name: "mycc", Path: srcDir}, false)
+	if err != nil {
+		t.Fatalf("deploy() error = %v", err)
+	}
+	if result.PackageID != packageID {
+		t.Errorf("Expected package ID %s, got %s", packageID, result.PackageID)
+	}
+	if countCalls(mockExec, "package") != 1 || countCalls(mockExec, "install") != 2 {
+		t.Errorf("Expected one package and two installs, got %v", mockExec.Calls)
+	}
+
+	// A fresh network reuses the cached package
+	fresh := createMockNetwork()
+	defer os.RemoveAll(fresh.BasePath)
+
+	mockExec = packagingExecutor(t, "package bytes")
+	deployer = NewDeployer(fresh, docker.NewManager(mockExec), mockExec)
+	result, err = deployer.deploy(context.Background(), &DeployRequest{Name: "mycc", Path: srcDir}, false)
+	if err != nil {
+		t.Fatalf("deploy() error = %v", err)
+	}
+	if countCalls(mockExec, "package") != 0 {
+		t.Error("Expected the cached package to be reused")
+	}
+	if result.PackageID != packageID {
+		t.Errorf("Expected the cached package ID %s, got %s", packageID, result.PackageID)
+	}
+
+	// Peers that report the package skip install
+	again := createMockNetwork()
+	defer os.RemoveAll(again.BasePath)
+
+	mockExec = packagingExecutor(t, "package bytes", packageID)
+	deployer = NewDeployer(again, docker.NewManager(mockExec), mockExec)
+	if _, err := deployer.deploy(context.Background(), &DeployRequest{Name: "mycc", Path: srcDir}, false); err != nil {
+		t.Fatalf("deploy() error = %v", err)
+	}
+	if countCalls(mockExec, "install") != 0 {
+		t.Error("Expected no install on peers that already have the package")
+	}
+
+	// Another version is packaged separately
+	mockExec = packagingExecutor(t, "other bytes")
+	deployer = NewDeployer(again, docker.NewManager(mockExec), mockExec)
+	if _, err := deployer.deploy(context.Background(), &DeployRequest{Name: "mycc", Path: srcDir, Version: "1.1"}, false); err != nil {
+		t.Fatalf("deploy() error = %v", err)
+	}
+	if countCalls(mockExec, "package") != 1 {
+		t.Error("Expected a new package for another version")
+	}
+}
+
+func TestPackageCacheKey(t *testing.T) {
+	if key := packageCacheKey("", "golang", "mycc_1.0", false); key != "" {
+		t.Errorf("Expected unreadable sources not to be cached, got %s", key)
+	}
+
+	base := packageCacheKey("abc", "golang", "mycc_1.0", false)
+	for _, other := range []string{
+		packageCacheKey("abd", "golang", "mycc_1.0", false),
+		packageCacheKey("abc", "node", "mycc_1.0", false),
+		packageCacheKey("abc", "golang", "mycc_1.1", false),
+		packageCacheKey("abc", "golang", "mycc_1.0", true),
+	} {
+		if other == base {
+			t.Errorf("Expected distinct keys, got %s twice", base)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+// core/pkg/chaincode/ccaas.go
+package chaincode
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	stdNet "net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// Deploy modes
+const (
+	// DeployModePackage packages the source and lets the peers build and
+	// launch the chaincode container
+	DeployModePackage = "package"
+	// DeployModeCCaaS runs the chaincode as a service on the network's
+	// Docker network and installs a connection.json package pointing at it
+	DeployModeCCaaS = "ccaas"
+)
+
+// ccaasPort is the port chaincode servers listen on
+const ccaasPort = 9999
+
+// delvePort is the port Delve listens on inside a debug container
+const delvePort = 2345
+
+// ccaasRuntime describes how a language runs as a chaincode server. The
+// source is mounted at /chaincode.
+type ccaasRuntime struct {
+	image   string
+	command string
+	volumes []string // Caches shared across restarts
+	runArgs []string // Extra docker run flags
+}
+
+var ccaasRuntimes = map[string]*ccaasRuntime{
+	// Go chaincode must start a shim.ChaincodeServer when
+	// CHAINCODE_SERVER_ADDRESS is set
+	LanguageGo: {
+		image:   "golang:1.22",
+		command: "go run .",
+		volumes: []string{"fabricx-go-mod:/go/pkg/mod", "fabricx-go-build:/root/.cache/go-build"},
+	},
+	LanguageNode:       nodeCCaaSRuntime,
+	LanguageTypeScript: nodeCCaaSRuntime,
+}
+
+// nodeCCaaSRuntime serves JavaScript and TypeScript chaincode, compiling
+// TypeScript through the build script
+var nodeCCaaSRuntime = &ccaasRuntime{
+	image:   "node:20",
+	command: "npm install && npm run build --if-present && npx fabric-chaincode-node server --chaincode-address=$CHAINCODE_SERVER_ADDRESS --chaincode-id=$CHAINCODE_ID",
+	volumes: []string{"fabricx-npm-cache:/root/.npm"},
+}
+
+// ccaasDebugRuntime runs Go chaincode under a headless Delve server. The
+// chaincode starts right away; debuggers attach to delvePort.
+var ccaasDebugRuntime = &ccaasRuntime{
+	image: "golang:1.22",
+	command: "command -v dlv >/dev/null || go install github.com/go-delve/delve/cmd/dlv@v1.22.1; " +
+		fmt.Sprintf("dlv debug --headless --listen=:%d --api-version=2 --accept-multiclient --continue --output /tmp/chaincode .", delvePort),
+	volumes: []string{"fabricx-go-mod:/go/pkg/mod", "fabricx-go-build:/root/.cache/go-build", "fabricx-go-bin:/go/bin"},
+	runArgs: []string{"--cap-add", "SYS_PTRACE", "--security-opt", "seccomp=unconfined"},
+}
+
+// deployMode validates the requested mode and returns it with the default
+// applied
+func deployMode(req *DeployRequest) (string, error) {
+	if req.Debug {
+		if req.Language != LanguageGo || (req.Mode != "" && req.Mode != DeployModeCCaaS) {
+			return "", errors.WrapWithContext("deployMode", errors.ErrInvalidConfig, map[string]interface{}{
+				"mode":     req.Mode,
+				"language": req.Language,
+				"reason":   "debugging needs golang chaincode in ccaas mode",
+			})
+		}
+		return DeployModeCCaaS, nil
+	}
+
+	switch req.Mode {
+	case "", DeployModePackage:
+		return DeployModePackage, nil
+	case DeployModeCCaaS:
+		if _, ok := ccaasRuntimes[req.Language]; !ok {
+			return "", errors.WrapWithContext("deployMode", errors.ErrInvalidConfig, map[string]interface{}{
+				"mode":     req.Mode,
+				"language": req.Language,
+				"reason":   "chaincode as a service supports golang, node and typescript chaincode",
+			})
+		}
+		return DeployModeCCaaS, nil
+	default:
+		return "", errors.WrapWithContext("deployMode", errors.ErrInvalidConfig, map[string]interface{}{
+			"mode":   req.Mode,
+			"reason": fmt.Sprintf("mode must be %s or %s", DeployModePackage, DeployModeCCaaS),
+		})
+	}
+}
+
+// ccaasContainerName returns the container, and host name, of a chaincode
+// server
+func ccaasContainerName(net *network.Network, name string) string {
+	return fmt.Sprintf("ccaas-%s-%s", name, net.ID)
+}
+
+// packageCCaaS writes a chaincode package that only holds the address of
+// the chaincode server. It does not depend on the source, so code changes
+// never need a new package.
+func (d *Deployer) packageCCaaS(req *DeployRequest) (string, error) {
+	packagePath := filepath.Join(d.network.BasePath, "chaincode", fmt.Sprintf("%s-ccaas.tar.gz", req.Name))
+
+	connection, _ := json.Marshal(map[string]interface{}{
+		"address":      fmt.Sprintf("%s:%d", ccaasContainerName(d.network, req.Name), ccaasPort),
+		"dial_timeout": "10s",
+		"tls_required": false,
+	})
+	code, err := tarGz(map[string][]byte{"connection.json": connection})
+	if err != nil {
+		return "", errors.Wrap("packageCCaaS", err)
+	}
+
+	metadata, _ := json.Marshal(map[string]string{
+		"type":  "ccaas",
+		"label": fmt.Sprintf("%s_%s", req.Name, req.Version),
+	})
+	pkg, err := tarGz(map[string][]byte{"metadata.json": metadata, "code.tar.gz": code})
+	if err != nil {
+		return "", errors.Wrap("packageCCaaS", err)
+	}
+
+	absPackagePath, err := filepath.Abs(packagePath)
+	if err != nil {
+		return "", errors.WrapWithContext("packageCCaaS", err, map[string]interface{}{
+			"path": packagePath,
+		})
+	}
+	if err := os.MkdirAll(filepath.Dir(absPackagePath), 0755); err != nil {
+		return "", errors.Wrap("packageCCaaS", err)
+	}
+	if err := os.WriteFile(absPackagePath, pkg, 0644); err != nil {
+		return "", errors.Wrap("packageCCaaS", err)
+	}
+
+	fmt.Printf("📦 Packaged chaincode service %s\n", ccaasContainerName(d.network, req.Name))
+	return absPackagePath, nil
+}
+
+// tarGz builds a gzipped tarball with fixed metadata so the same files
+// always produce the same package ID
+func tarGz(files map[string][]byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	// metadata.json goes first, like peer lifecycle chaincode package does
+	for _, name := range []string{"metadata.json", "connection.json", "code.tar.gz"} {
+		content, ok := files[name]
+		if !ok {
+			continue
+		}
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// startCCaaS (re)starts the chaincode server container for a package and
+// returns the host port of its debugger, if any
+func (d *Deployer) startCCaaS(ctx context.Context, req *DeployRequest, packageID string) (int, error) {
+	runtime := ccaasRuntimes[req.Language]
+	containerName := ccaasContainerName(d.network, req.Name)
+
+	absPath, err := filepath.Abs(req.Path)
+	if err != nil {
+		return 0, errors.WrapWithContext("startCCaaS", err, map[string]interface{}{
+			"path": req.Path,
+		})
+	}
+
+	debugPort := 0
+	if req.Debug {
+		runtime = ccaasDebugRuntime
+		debugPort = req.DebugPort
+		if debugPort == 0 {
+			if debugPort, err = freePort(); err != nil {
+				return 0, errors.Wrap("startCCaaS", err)
+			}
+		}
+	}
+
+	// Replacing the container is all a code change needs
+	d.exec.ExecuteCombined(ctx, "docker", "rm", "-f", containerName)
+
+	args := []string{"run", "-d",
+		"--name", containerName,
+		"--network", fmt.Sprintf("fabricx_%s", d.network.ID),
+		"-v", fmt.Sprintf("%s:/chaincode", absPath),
+		"-w", "/chaincode",
+		"-e", fmt.Sprintf("CHAINCODE_SERVER_ADDRESS=0.0.0.0:%d", ccaasPort),
+		"-e", fmt.Sprintf("CHAINCODE_ID=%s", packageID),
+	}
+	for _, volume := range runtime.volumes {
+		args = append(args, "-v", volume)
+	}
+	if debugPort != 0 {
+		args = append(args, "-p", fmt.Sprintf("%d:%d", debugPort, delvePort))
+	}
+	args = append(args, runtime.runArgs...)
+	args = append(args, runtime.image, "sh", "-c", runtime.command)
+
+	fmt.Printf("🚀 Starting chaincode service %s...\n", containerName)
+	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		return 0, errors.WrapWithContext("startCCaaS", errors.ErrContainerFailed, map[string]interface{}{
+			"container": containerName,
+			"error":     err.Error(),
+			"output":    string(output),
+		})
+	}
+
+	fmt.Printf("✓ Chaincode service running at %s:%d\n", containerName, ccaasPort)
+	if debugPort != 0 {
+		fmt.Printf("🐞 Delve listening on localhost:%d\n", debugPort)
+	}
+	return debugPort, nil
+}
+
+// freePort asks the OS for an unused TCP port on the host
+func freePort() (int, error) {
+	listener, err := stdNet.Listen("tcp", ":0")
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*stdNet.TCPAddr).Port, nil
+}
+
+// peerRetryInterval spaces the polls while peers restart
+var peerRetryInterval = 2 * time.Second
+
+// raisePeerTimeouts recreates the peers with a chaincode execute timeout
+// long enough to sit on a breakpoint, then waits until they answer again
+func (d *Deployer) raisePeerTimeouts(ctx context.Context) error {
+	changed, err := d.network.RaiseChaincodeTimeout(network.DebugExecuteTimeout)
+	if err != nil || !changed {
+		return err
+	}
+
+	fmt.Printf("⏱️  Raising peer chaincode timeouts to %s...\n", network.DebugExecuteTimeout)
+	if err := d.dockerMgr.StartNetwork(ctx, d.network); err != nil {
+		return err
+	}
+
+	for _, org := range d.network.Orgs {
+		for _, peer := range org.Peers {
+			if err := d.waitForPeer(ctx, org, peer); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// waitForPeer polls a peer until it serves the default channel again
+func (d *Deployer) waitForPeer(ctx context.Context, org *network.Organization, peer *network.Peer) error {
+	args := []string{"exec"}
+	args = append(args, d.getPeerEnvArgs(org, peer)...)
+	args = append(args, "cli", "peer", "channel", "getinfo", "-c", d.network.Channel.Name)
+
+	var output []byte
+	var err error
+	for attempt := 0; attempt < 30; attempt++ {
+		if output, err = d.exec.ExecuteCombined(ctx, "docker", args...); err == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(peerRetryInterval):
+		}
+	}
+
+	return errors.WrapWithContext("waitForPeer", errors.ErrTimeout, map[string]interface{}{
+		"peer":   peer.Name,
+		"output": string(output),
+	})
+}
+
+// StopServices removes the chaincode server containers of the network
+func (d *Deployer) StopServices(ctx context.Context) error {
+	for _, cc := range d.network.Chaincodes() {
+		if cc.Mode != DeployModeCCaaS {
+			continue
+		}
+
+		containerName := ccaasContainerName(d.network, cc.Name)
+		output, err := d.exec.ExecuteCombined(ctx, "docker", "rm", "-f", containerName)
+		if err != nil {
+			return errors.WrapWithContext("StopServices", errors.ErrContainerFailed, map[string]interface{}{
+				"container": containerName,
+				"error":     err.Error(),
+				"output":    string(output),
+			})
+		}
+	}
+	return nil
+}
+
+// definitionDigest fingerprints the parts of a definition that are not
+// covered by the package, so an unchanged CCaaS redeploy can skip the
+// lifecycle entirely
+func definitionDigest(policyArgs []string, collections []*CollectionConfig) string {
+	data, _ := json.Marshal(struct {
+		Policy      []string
+		Collections []*CollectionConfig
+	}{policyArgs, collections})
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
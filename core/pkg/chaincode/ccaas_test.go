@@ -0,0 +1,325 @@
+// core/pkg/chaincode/ccaas_test.go
+package chaincode
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	stdErr "errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// readTarGz returns the files of a gzipped tarball by name
+func readTarGz(t *testing.T, data []byte) map[string][]byte {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("tar.Next() error = %v", err)
+		}
+		content, _ := io.ReadAll(tr)
+		files[header.Name] = content
+	}
+	return files
+}
+
+func TestDeployCCaaS(t *testing.T) {
+	const installOutput = "Chaincode code package identifier: mycc_1.0:" +
+		"0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		if contains(args, "querycommitted") {
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		}
+		if contains(args, "install") {
+			return []byte(installOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	if _, err := deployer.Deploy(context.Background(), &DeployRequest{
+		Name: "mycc",
+		Path: "/chaincode/mycc",
+		Mode: DeployModeCCaaS,
+	}); err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(net.BasePath, "chaincode", "mycc-ccaas.tar.gz"))
+	if err != nil {
+		t.Fatalf("Expected a CCaaS package: %v", err)
+	}
+	pkg := readTarGz(t, data)
+	if string(pkg["metadata.json"]) != `{"label":"mycc_1.0","type":"ccaas"}` {
+		t.Errorf("Unexpected metadata.json: %s", pkg["metadata.json"])
+	}
+	code := readTarGz(t, pkg["code.tar.gz"])
+	if !strings.Contains(string(code["connection.json"]), `"address":"ccaas-mycc-test-net-123:9999"`) {
+		t.Errorf("Unexpected connection.json: %s", code["connection.json"])
+	}
+
+	var run []string
+	for _, call := range mockExec.Calls {
+		if contains(call.Args, "package") {
+			t.Error("Expected no source packaging in CCaaS mode")
+		}
+		if len(call.Args) > 1 && call.Args[0] == "run" && call.Args[1] == "-d" {
+			run = call.Args
+		}
+	}
+	if run == nil {
+		t.Fatal("Expected the chaincode service container to be started")
+	}
+	for _, want := range []string{
+		"ccaas-mycc-test-net-123",
+		"fabricx_test-net-123",
+		"CHAINCODE_ID=mycc_1.0:0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9",
+		"golang:1.22",
+	} {
+		if !contains(run, want) {
+			t.Errorf("Expected %q in docker run args %v", want, run)
+		}
+	}
+
+	if cc := net.Chaincode("mycc"); cc == nil || cc.Mode != DeployModeCCaaS {
+		t.Errorf("Expected chaincode to be recorded in CCaaS mode, got %+v", cc)
+	}
+}
+
+func TestRedeployCCaaS(t *testing.T) {
+	const committedOutput = `{"sequence": 4, "version": "1.0"}`
+
+	tests := []struct {
+		name          string
+		policy        string
+		wantRestarted bool
+		wantSequence  int64
+	}{
+		{
+			name:          "unchanged definition restarts the service",
+			wantRestarted: true,
+			wantSequence:  4,
+		},
+		{
+			name:         "changed policy commits a new sequence",
+			policy:       "AND('Org1MSP.peer','Org2MSP.peer')",
+			wantSequence: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				if contains(args, "querycommitted") {
+					return []byte(committedOutput), nil
+				}
+				return []byte("success"), nil
+			}
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+			defaultPolicy, _ := deployer.endorsementPolicyArgs(&DeployRequest{})
+			net.RecordChaincode(&network.Chaincode{
+				Name:       "mycc",
+				Version:    "1.0",
+				Sequence:   4,
+				Language:   "golang",
+				PackageID:  "mycc_1.0:service",
+				Mode:       DeployModeCCaaS,
+				Definition: definitionDigest(defaultPolicy, nil),
+			})
+
+			result, err := deployer.Upgrade(context.Background(), &DeployRequest{
+				Name:              "mycc",
+				Path:              "/chaincode/mycc",
+				Mode:              DeployModeCCaaS,
+				EndorsementPolicy: tt.policy,
+			})
+			if err != nil {
+				t.Fatalf("Upgrade() error = %v", err)
+			}
+
+			if result.Restarted != tt.wantRestarted || result.Sequence != tt.wantSequence || result.Reinstalled {
+				t.Errorf("Unexpected result: %+v", result)
+			}
+
+			approved := false
+			for _, call := range mockExec.Calls {
+				if contains(call.Args, "approveformyorg") {
+					approved = true
+					if !contains(call.Args, "mycc_1.0:service") {
+						t.Errorf("Expected the installed service package to be approved, got %v", call.Args)
+					}
+				}
+			}
+			if approved == tt.wantRestarted {
+				t.Errorf("Expected approval %v, got %v", !tt.wantRestarted, approved)
+			}
+
+			last := mockExec.Calls[len(mockExec.Calls)-1].Args
+			if tt.wantRestarted && !contains(last, "CHAINCODE_ID=mycc_1.0:service") {
+				t.Errorf("Expected the service to be restarted last, got %v", last)
+			}
+		})
+	}
+}
+
+func TestDeployDebug(t *testing.T) {
+	const installOutput = "Chaincode code package identifier: mycc_1.0:" +
+		"0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+
+	interval := peerRetryInterval
+	peerRetryInterval = time.Millisecond
+	defer func() { peerRetryInterval = interval }()
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	peerDown := true
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "getinfo") && peerDown:
+			peerDown = false
+			return []byte("Error: connection refused"), fmt.Errorf("exit status 1")
+		case contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case contains(args, "install"):
+			return []byte(installOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	result, err := deployer.deploy(context.Background(), &DeployRequest{
+		Name:      "mycc",
+		Path:      "/chaincode/mycc",
+		Debug:     true,
+		DebugPort: 40000,
+	}, false)
+	if err != nil {
+		t.Fatalf("deploy() error = %v", err)
+	}
+	if result.DebugPort != 40000 {
+		t.Errorf("Expected debug port 40000, got %d", result.DebugPort)
+	}
+
+	compose, err := os.ReadFile(filepath.Join(net.ConfigPath, "docker-compose.yaml"))
+	if err != nil {
+		t.Fatalf("Expected docker-compose.yaml to be rewritten: %v", err)
+	}
+	if !strings.Contains(string(compose), "CORE_CHAINCODE_EXECUTETIMEOUT=1h0m0s") {
+		t.Error("Expected peers to get a raised chaincode execute timeout")
+	}
+
+	recreated := 0
+	var run []string
+	for _, call := range mockExec.Calls {
+		if call.Name == "docker-compose" && contains(call.Args, "up") {
+			recreated++
+		}
+		if len(call.Args) > 1 && call.Args[0] == "run" && call.Args[1] == "-d" {
+			run = call.Args
+		}
+	}
+	if recreated != 1 {
+		t.Errorf("Expected peers to be recreated once, got %d", recreated)
+	}
+	for _, want := range []string{"40000:2345", "SYS_PTRACE"} {
+		if !contains(run, want) {
+			t.Errorf("Expected %q in docker run args %v", want, run)
+		}
+	}
+	if !strings.Contains(run[len(run)-1], "dlv debug --headless --listen=:2345") {
+		t.Errorf("Expected the chaincode to run under Delve, got %s", run[len(run)-1])
+	}
+
+	// The timeout is already raised for the next debug session
+	calls := len(mockExec.Calls)
+	if err := deployer.raisePeerTimeouts(context.Background()); err != nil {
+		t.Fatalf("raisePeerTimeouts() error = %v", err)
+	}
+	if len(mockExec.Calls) != calls {
+		t.Error("Expected no peer restart once timeouts are raised")
+	}
+}
+
+func TestDeployModeValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *DeployRequest
+	}{
+		{name: "unknown mode", req: &DeployRequest{Name: "mycc", Mode: "sidecar"}},
+		{name: "unsupported CCaaS language", req: &DeployRequest{Name: "mycc", Language: "java", Mode: DeployModeCCaaS}},
+		{name: "debug node chaincode", req: &DeployRequest{Name: "mycc", Language: "node", Debug: true}},
+		{name: "debug in package mode", req: &DeployRequest{Name: "mycc", Mode: DeployModePackage, Debug: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			mockExec := executor.NewMockExecutor()
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+			_, err := deployer.Deploy(context.Background(), tt.req)
+			if !stdErr.Is(err, errors.ErrInvalidConfig) {
+				t.Errorf("Expected ErrInvalidConfig, got %v", err)
+			}
+			if len(mockExec.Calls) != 0 {
+				t.Errorf("Expected no commands, got %d", len(mockExec.Calls))
+			}
+		})
+	}
+}
+
+func TestStopServices(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	net.RecordChaincode(&network.Chaincode{Name: "fast", Mode: DeployModeCCaaS})
+	net.RecordChaincode(&network.Chaincode{Name: "slow", Mode: DeployModePackage})
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		return []byte("success"), nil
+	}
+
+	if err := NewDeployer(net, docker.NewManager(mockExec), mockExec).StopServices(context.Background()); err != nil {
+		t.Fatalf("StopServices() error = %v", err)
+	}
+
+	if len(mockExec.Calls) != 1 || strings.Join(mockExec.Calls[0].Args, " ") != "rm -f ccaas-fast-test-net-123" {
+		t.Errorf("Expected only the CCaaS container to be removed, got %v", mockExec.Calls)
+	}
+}
@@ -0,0 +1,165 @@
+// core/pkg/chaincode/collections.go
+package chaincode
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// CollectionConfig is one entry of a Fabric collections_config.json
+type CollectionConfig struct {
+	Name              string                       `json:"name"`
+	Policy            string                       `json:"policy"`
+	RequiredPeerCount int                          `json:"requiredPeerCount"`
+	MaxPeerCount      int                          `json:"maxPeerCount"`
+	BlockToLive       uint64                       `json:"blockToLive"`
+	MemberOnlyRead    bool                         `json:"memberOnlyRead"`
+	MemberOnlyWrite   bool                         `json:"memberOnlyWrite"`
+	EndorsementPolicy *CollectionEndorsementPolicy `json:"endorsementPolicy,omitempty"`
+}
+
+// CollectionEndorsementPolicy overrides the chaincode endorsement policy for
+// writes to a collection
+type CollectionEndorsementPolicy struct {
+	SignaturePolicy     string `json:"signaturePolicy,omitempty"`
+	ChannelConfigPolicy string `json:"channelConfigPolicy,omitempty"`
+}
+
+// LoadCollections reads a collections definition given either as inline
+// JSON or as a path relative to the chaincode folder
+func LoadCollections(config, chaincodePath string) ([]*CollectionConfig, error) {
+	config = strings.TrimSpace(config)
+	if config == "" {
+		return nil, nil
+	}
+
+	data := []byte(config)
+	if !strings.HasPrefix(config, "[") {
+		path := filepath.Clean(config)
+		if filepath.IsAbs(path) || path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+			return nil, errors.WrapWithContext("LoadCollections", errors.ErrInvalidConfig, map[string]interface{}{
+				"path":   config,
+				"reason": "collections config must be inside the chaincode folder",
+			})
+		}
+
+		fileData, err := os.ReadFile(filepath.Join(chaincodePath, path))
+		if err != nil {
+			return nil, errors.WrapWithContext("LoadCollections", errors.ErrInvalidConfig, map[string]interface{}{
+				"path":  config,
+				"error": err.Error(),
+			})
+		}
+		data = fileData
+	}
+
+	var collections []*CollectionConfig
+	if err := json.Unmarshal(data, &collections); err != nil {
+		return nil, errors.WrapWithContext("LoadCollections", errors.ErrInvalidConfig, map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+
+	return collections, nil
+}
+
+// ValidateCollections checks collection definitions against the orgs of
+// the network
+func ValidateCollections(net *network.Network, collections []*CollectionConfig) error {
+	mspIDs := map[string]bool{}
+	for _, org := range net.Orgs {
+		mspIDs[org.MSPID] = true
+	}
+
+	// checkPolicy reports syntax errors and MSP IDs the network doesn't have
+	checkPolicy := func(field, expr string) []string {
+		policy, err := ParsePolicy(expr)
+		if err != nil {
+			return []string{fmt.Sprintf("%s: %v", field, err)}
+		}
+		found := []string{}
+		for _, mspID := range policy.MSPIDs() {
+			if !mspIDs[mspID] {
+				found = append(found, fmt.Sprintf("%s: unknown MSP ID %q", field, mspID))
+			}
+		}
+		return found
+	}
+
+	problems := []string{}
+	seen := map[string]bool{}
+	for i, c := range collections {
+		field := fmt.Sprintf("collections[%d]", i)
+
+		switch {
+		case c.Name == "":
+			problems = append(problems, fmt.Sprintf("%s.name: required", field))
+		case seen[c.Name]:
+			problems = append(problems, fmt.Sprintf("%s.name: duplicate collection %q", field, c.Name))
+		case strings.HasPrefix(c.Name, "_"):
+			problems = append(problems, fmt.Sprintf("%s.name: %q must not start with an underscore", field, c.Name))
+		}
+		seen[c.Name] = true
+
+		if c.Policy == "" {
+			problems = append(problems, fmt.Sprintf("%s.policy: required", field))
+		} else {
+			problems = append(problems, checkPolicy(field+".policy", c.Policy)...)
+		}
+
+		if c.RequiredPeerCount < 0 || c.MaxPeerCount < 0 {
+			problems = append(problems, fmt.Sprintf("%s: peer counts must not be negative", field))
+		}
+		if c.MaxPeerCount < c.RequiredPeerCount {
+			problems = append(problems, fmt.Sprintf("%s.maxPeerCount: %d is less than requiredPeerCount %d", field, c.MaxPeerCount, c.RequiredPeerCount))
+		}
+
+		if ep := c.EndorsementPolicy; ep != nil {
+			if ep.SignaturePolicy != "" && ep.ChannelConfigPolicy != "" {
+				problems = append(problems, fmt.Sprintf("%s.endorsementPolicy: set either signaturePolicy or channelConfigPolicy, not both", field))
+			}
+			if ep.SignaturePolicy != "" {
+				problems = append(problems, checkPolicy(field+".endorsementPolicy.signaturePolicy", ep.SignaturePolicy)...)
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.WrapWithContext("ValidateCollections", errors.ErrInvalidConfig, map[string]interface{}{
+			"problems": strings.Join(problems, "; "),
+		})
+	}
+
+	return nil
+}
+
+// toNetworkCollections converts definitions into the runtime's view of
+// collection membership
+func toNetworkCollections(collections []*CollectionConfig) []*network.Collection {
+	result := make([]*network.Collection, 0, len(collections))
+	for _, c := range collections {
+		// Definitions are validated before conversion
+		members := []string{}
+		if policy, err := ParsePolicy(c.Policy); err == nil {
+			members = policy.MSPIDs()
+		}
+
+		result = append(result, &network.Collection{
+			Name:              c.Name,
+			Policy:            c.Policy,
+			MemberOrgs:        members,
+			RequiredPeerCount: c.RequiredPeerCount,
+			MaxPeerCount:      c.MaxPeerCount,
+			BlockToLive:       c.BlockToLive,
+			MemberOnlyRead:    c.MemberOnlyRead,
+			MemberOnlyWrite:   c.MemberOnlyWrite,
+		})
+	}
+	return result
+}
@@ -0,0 +1,212 @@
+// core/pkg/chaincode/collections_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+const testCollectionsJSON = `[
+  {
+    "name": "Org1Private",
+    "policy": "OR('Org1MSP.member')",
+    "requiredPeerCount": 0,
+    "maxPeerCount": 1,
+    "blockToLive": 100,
+    "memberOnlyRead": true
+  },
+  {
+    "name": "SharedPrices",
+    "policy": "OR('Org1MSP.member','Org2MSP.member')",
+    "requiredPeerCount": 1,
+    "maxPeerCount": 2
+  }
+]`
+
+func TestLoadCollections(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "collections_config.json"), []byte(testCollectionsJSON), 0644); err != nil {
+		t.Fatalf("Failed to write collections config: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		config  string
+		want    int
+		wantErr bool
+	}{
+		{name: "empty", config: "", want: 0},
+		{name: "inline JSON", config: testCollectionsJSON, want: 2},
+		{name: "path in chaincode folder", config: "collections_config.json", want: 2},
+		{name: "missing file", config: "missing.json", wantErr: true},
+		{name: "path outside chaincode folder", config: "../collections_config.json", wantErr: true},
+		{name: "absolute path", config: "/etc/collections_config.json", wantErr: true},
+		{name: "malformed JSON", config: `[{"name": }]`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections, err := LoadCollections(tt.config, dir)
+			if tt.wantErr {
+				if !stdErr.Is(err, errors.ErrInvalidConfig) {
+					t.Errorf("Expected ErrInvalidConfig, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadCollections() error = %v", err)
+			}
+
+			if len(collections) != tt.want {
+				t.Errorf("Expected %d collections, got %d", tt.want, len(collections))
+			}
+		})
+	}
+}
+
+func TestValidateCollections(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	tests := []struct {
+		name    string
+		config  string
+		problem string
+	}{
+		{
+			name:    "unknown MSP",
+			config:  `[{"name": "c1", "policy": "OR('Org1MSP.member','Org9MSP.member')", "maxPeerCount": 1}]`,
+			problem: `collections[0].policy: unknown MSP ID "Org9MSP"`,
+		},
+		{
+			name:    "duplicate name",
+			config:  `[{"name": "c1", "policy": "OR('Org1MSP.member')"}, {"name": "c1", "policy": "OR('Org2MSP.member')"}]`,
+			problem: `collections[1].name: duplicate collection "c1"`,
+		},
+		{
+			name:    "missing policy",
+			config:  `[{"name": "c1"}]`,
+			problem: "collections[0].policy: required",
+		},
+		{
+			name:    "peer counts",
+			config:  `[{"name": "c1", "policy": "OR('Org1MSP.member')", "requiredPeerCount": 2, "maxPeerCount": 1}]`,
+			problem: "maxPeerCount: 1 is less than requiredPeerCount 2",
+		},
+		{
+			name:    "endorsement policy with unknown MSP",
+			config:  `[{"name": "c1", "policy": "OR('Org1MSP.member')", "endorsementPolicy": {"signaturePolicy": "OR('Org3MSP.peer')"}}]`,
+			problem: `endorsementPolicy.signaturePolicy: unknown MSP ID "Org3MSP"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collections, err := LoadCollections(tt.config, "")
+			if err != nil {
+				t.Fatalf("LoadCollections() error = %v", err)
+			}
+
+			err = ValidateCollections(net, collections)
+			if !stdErr.Is(err, errors.ErrInvalidConfig) {
+				t.Fatalf("Expected ErrInvalidConfig, got %v", err)
+			}
+
+			if !strings.Contains(err.Error(), tt.problem) {
+				t.Errorf("Expected error to mention %q, got: %v", tt.problem, err)
+			}
+		})
+	}
+}
+
+func TestDeployWithCollections(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		if contains(args, "package") {
+			writePackageOutput(t, args, "package bytes")
+			return []byte("success"), nil
+		}
+		if contains(args, "queryinstalled") {
+			return []byte("Package ID: private_1.0:hash123, Label: private_1.0"), nil
+		}
+		if contains(args, "querycommitted") {
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+	_, err := deployer.Deploy(context.Background(), &DeployRequest{
+		Name:              "private",
+		Path:              "/chaincode/private",
+		CollectionsConfig: testCollectionsJSON,
+	})
+	if err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	copied, approved, committed := false, 0, false
+	for _, call := range mockExec.Calls {
+		if contains(call.Args, "cp") && contains(call.Args, "cli:/tmp/private_collections.json") {
+			copied = true
+		}
+		if !contains(call.Args, "/tmp/private_collections.json") {
+			continue
+		}
+		if contains(call.Args, "approveformyorg") {
+			approved++
+		}
+		if contains(call.Args, "commit") {
+			committed = true
+		}
+	}
+
+	if !copied {
+		t.Error("Expected collections config to be copied into the cli container")
+	}
+	if approved != 2 || !committed {
+		t.Errorf("Expected --collections-config on 2 approvals and the commit, got %d approvals, commit %v", approved, committed)
+	}
+
+	orgs, err := net.CollectionOrgs("private", "Org1Private")
+	if err != nil {
+		t.Fatalf("CollectionOrgs() error = %v", err)
+	}
+	if len(orgs) != 1 || orgs[0].MSPID != "Org1MSP" {
+		t.Errorf("Expected Org1Private to be held by Org1 only, got %v", orgs)
+	}
+}
+
+func TestDeployRejectsInvalidCollections(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+	_, err := deployer.Deploy(context.Background(), &DeployRequest{
+		Name:              "private",
+		Path:              "/chaincode/private",
+		CollectionsConfig: `[{"name": "c1", "policy": "OR('Org5MSP.member')"}]`,
+	})
+	if !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig, got %v", err)
+	}
+
+	if len(mockExec.Calls) != 0 {
+		t.Errorf("Expected no docker calls for an invalid definition, got %d", len(mockExec.Calls))
+	}
+}
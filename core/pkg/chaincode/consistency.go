@@ -0,0 +1,372 @@
+// core/pkg/chaincode/consistency.go
+package chaincode
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/temmyjay001/core/pkg/fabric/ledger/rwset/kvrwset"
+)
+
+// Parts of a proposal response endorsers can disagree on
+const (
+	DivergenceResponse     = "response"      // Status or message
+	DivergencePayload      = "payload"       // Value the chaincode returned
+	DivergenceEvent        = "event"         // Chaincode event
+	DivergenceRead         = "read"          // Key read, or the version it was read at
+	DivergenceWrite        = "write"         // Key written, or the value written
+	DivergenceRangeQuery   = "range-query"   // Keys a range query returned
+	DivergencePrivateRead  = "private-read"  // Private key hash read
+	DivergencePrivateWrite = "private-write" // Private key hash written, or the value hash
+	DivergenceRWSet        = "rwset"         // Any other part of the signed response
+)
+
+// ConsistencyReport compares the responses of endorsers that executed the
+// same proposal
+type ConsistencyReport struct {
+	TxID        string
+	Peers       []*PeerResponse
+	Divergences []*Divergence
+}
+
+// Consistent reports whether every peer that responded agreed
+func (r *ConsistencyReport) Consistent() bool {
+	return len(r.Divergences) == 0
+}
+
+// PeerResponse is what one endorser answered and would commit
+type PeerResponse struct {
+	Peer    string
+	Org     string
+	Status  int32
+	Message string
+	Payload []byte
+	Error   string // Set when the peer could not be reached
+	Event   *ChaincodeEvent
+	RWSets  []*NamespaceRWSet
+}
+
+// Divergence is a part of the response the endorsers disagree on
+type Divergence struct {
+	Kind       string
+	Namespace  string
+	Collection string
+	Key        string
+	// Values holds what each peer produced, keyed by peer name
+	Values map[string]string
+}
+
+// String renders the divergence as one line per peer
+func (d *Divergence) String() string {
+	var b strings.Builder
+	b.WriteString(d.Kind)
+	if d.Namespace != "" {
+		b.WriteString(" " + d.Namespace)
+	}
+	if d.Collection != "" {
+		b.WriteString("/" + d.Collection)
+	}
+	if d.Key != "" {
+		b.WriteString(" " + d.Key)
+	}
+	for _, peer := range sortedKeys(d.Values) {
+		fmt.Fprintf(&b, "\n  %s: %s", peer, d.Values[peer])
+	}
+	return b.String()
+}
+
+// CheckConsistency sends the same proposal to every endorser separately
+// and compares their responses, without submitting a transaction. Endorsers
+// that disagree make the transaction fail validation, usually because the
+// chaincode is not deterministic. AutoEndorse is ignored so that every
+// candidate peer is compared.
+func (inv *Invoker) CheckConsistency(ctx context.Context, req *InvokeRequest) (*ConsistencyReport, error) {
+	everyPeer := *req
+	everyPeer.AutoEndorse = false
+	round, err := inv.endorse(ctx, "CheckConsistency", &everyPeer)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ConsistencyReport{TxID: round.prop.txID}
+	responded := []*endorsement{}
+	for _, e := range round.endorsements {
+		report.Peers = append(report.Peers, toPeerResponse(e))
+		if e.err == nil {
+			responded = append(responded, e)
+		}
+	}
+	report.Divergences = compareEndorsements(responded)
+	return report, nil
+}
+
+// Placeholders for what a peer did not produce
+const (
+	notProduced = "<none>"
+	notInState  = "<not in state>"
+	deleted     = "<deleted>"
+)
+
+// comparison collects divergences between the endorsements of peers
+type comparison struct {
+	peers       []string
+	divergences []*Divergence
+}
+
+// compareEndorsements lists every part of the responses the peers disagree
+// on, in a stable order
+func compareEndorsements(endorsements []*endorsement) []*Divergence {
+	c := &comparison{}
+	for _, e := range endorsements {
+		c.peers = append(c.peers, e.peer.Name)
+	}
+
+	c.each(DivergenceResponse, "", "", func(i int) map[string]string {
+		resp := endorsements[i].response.GetResponse()
+		return map[string]string{"": fmt.Sprintf("%d %s", resp.GetStatus(), resp.GetMessage())}
+	})
+	c.each(DivergencePayload, "", "", func(i int) map[string]string {
+		return map[string]string{"": renderBytes(endorsements[i].response.GetResponse().GetPayload())}
+	})
+	c.each(DivergenceEvent, "", "", func(i int) map[string]string {
+		action := endorsements[i].action
+		if action == nil || action.event == nil {
+			return nil
+		}
+		return map[string]string{"": fmt.Sprintf("%s %s", action.event.EventName, renderBytes(action.event.Payload))}
+	})
+
+	for _, namespace := range namespaces(endorsements) {
+		sets := make([]*nsRWSet, len(endorsements))
+		for i, e := range endorsements {
+			sets[i] = e.action.namespace(namespace)
+		}
+		c.compareNamespace(namespace, sets)
+	}
+
+	if len(c.divergences) == 0 {
+		// Metadata writes and anything else the peers signed
+		c.each(DivergenceRWSet, "", "", func(i int) map[string]string {
+			sum := sha256.Sum256(endorsements[i].response.GetPayload())
+			return map[string]string{"": "sha256 " + hex.EncodeToString(sum[:])}
+		})
+	}
+	return c.divergences
+}
+
+// compareNamespace compares the read-write sets of one namespace; sets[i]
+// is nil when peer i did not touch it
+func (c *comparison) compareNamespace(namespace string, sets []*nsRWSet) {
+	c.each(DivergenceRead, namespace, "", func(i int) map[string]string {
+		reads := map[string]string{}
+		for _, read := range sets[i].getKV().GetReads() {
+			reads[read.Key] = renderVersion(read.Version)
+		}
+		return reads
+	})
+	c.each(DivergenceWrite, namespace, "", func(i int) map[string]string {
+		writes := map[string]string{}
+		for _, write := range sets[i].getKV().GetWrites() {
+			writes[write.Key] = renderWrite(write.IsDelete, write.Value)
+		}
+		return writes
+	})
+	c.each(DivergenceRangeQuery, namespace, "", func(i int) map[string]string {
+		queries := map[string]string{}
+		for _, query := range sets[i].getKV().GetRangeQueriesInfo() {
+			queries[fmt.Sprintf("[%s, %s)", query.StartKey, query.EndKey)] = renderRangeQuery(query)
+		}
+		return queries
+	})
+
+	collections := map[string]bool{}
+	for _, set := range sets {
+		if set != nil {
+			for _, coll := range set.collections {
+				collections[coll.name] = true
+			}
+		}
+	}
+	for _, name := range sortedKeys(collections) {
+		c.each(DivergencePrivateRead, namespace, name, func(i int) map[string]string {
+			reads := map[string]string{}
+			for _, read := range sets[i].collection(name).GetHashedReads() {
+				reads[hex.EncodeToString(read.KeyHash)] = renderVersion(read.Version)
+			}
+			return reads
+		})
+		c.each(DivergencePrivateWrite, namespace, name, func(i int) map[string]string {
+			writes := map[string]string{}
+			for _, write := range sets[i].collection(name).GetHashedWrites() {
+				value := renderWrite(write.IsDelete, nil)
+				if !write.IsDelete {
+					value = "sha256 " + hex.EncodeToString(write.ValueHash)
+				}
+				if write.IsPurge {
+					value = "<purged>"
+				}
+				writes[hex.EncodeToString(write.KeyHash)] = value
+			}
+			return writes
+		})
+	}
+}
+
+// each records a divergence for every key whose value is not the same on
+// every peer. values(i) returns the keys peer i produced; a peer without a
+// key renders as notProduced.
+func (c *comparison) each(kind, namespace, collection string, values func(i int) map[string]string) {
+	perPeer := make([]map[string]string, len(c.peers))
+	keys := map[string]bool{}
+	for i := range c.peers {
+		perPeer[i] = values(i)
+		for key := range perPeer[i] {
+			keys[key] = true
+		}
+	}
+
+	for _, key := range sortedKeys(keys) {
+		rendered := map[string]string{}
+		same := true
+		for i, peer := range c.peers {
+			value, ok := perPeer[i][key]
+			if !ok {
+				value = notProduced
+			}
+			rendered[peer] = value
+			if value != rendered[c.peers[0]] {
+				same = false
+			}
+		}
+		if !same {
+			c.divergences = append(c.divergences, &Divergence{
+				Kind:       kind,
+				Namespace:  namespace,
+				Collection: collection,
+				Key:        key,
+				Values:     rendered,
+			})
+		}
+	}
+}
+
+// namespaces returns every namespace any endorser read or wrote, sorted
+func namespaces(endorsements []*endorsement) []string {
+	seen := map[string]bool{}
+	for _, e := range endorsements {
+		if e.action == nil {
+			continue
+		}
+		for _, set := range e.action.rwsets {
+			seen[set.namespace] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// namespace returns the read-write set of a namespace, nil when the action
+// did not touch it
+func (a *chaincodeAction) namespace(name string) *nsRWSet {
+	if a == nil {
+		return nil
+	}
+	for _, set := range a.rwsets {
+		if set.namespace == name {
+			return set
+		}
+	}
+	return nil
+}
+
+// getKV returns the public read-write set, nil safe like generated getters
+func (s *nsRWSet) getKV() *kvrwset.KVRWSet {
+	if s == nil {
+		return nil
+	}
+	return s.kv
+}
+
+// collection returns the hashed read-write set of a collection, nil when
+// the namespace did not touch it
+func (s *nsRWSet) collection(name string) *kvrwset.HashedRWSet {
+	if s == nil {
+		return nil
+	}
+	for _, coll := range s.collections {
+		if coll.name == name {
+			return coll.hashed
+		}
+	}
+	return nil
+}
+
+// renderVersion renders the version a key was read at; reads of missing
+// keys carry no version
+func renderVersion(version *kvrwset.Version) string {
+	if version == nil {
+		return notInState
+	}
+	return fmt.Sprintf("version %d:%d", version.BlockNum, version.TxNum)
+}
+
+func renderWrite(isDelete bool, value []byte) string {
+	if isDelete {
+		return deleted
+	}
+	return renderBytes(value)
+}
+
+// renderRangeQuery renders the keys and versions a range query returned.
+// Large results are summarized by Merkle hashes instead.
+func renderRangeQuery(query *kvrwset.RangeQueryInfo) string {
+	var b strings.Builder
+	if hashes := query.GetReadsMerkleHashes(); hashes != nil {
+		b.WriteString("merkle")
+		for _, hash := range hashes.MaxLevelHashes {
+			b.WriteString(" " + hex.EncodeToString(hash))
+		}
+	} else {
+		reads := query.GetRawReads().GetKvReads()
+		if len(reads) == 0 {
+			b.WriteString("no keys")
+		}
+		for i, read := range reads {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%s@%s", read.Key, strings.TrimPrefix(renderVersion(read.Version), "version "))
+		}
+	}
+	if !query.ItrExhausted {
+		b.WriteString(" (not exhausted)")
+	}
+	return b.String()
+}
+
+// renderBytes shows printable values as text and anything else as hex
+func renderBytes(b []byte) string {
+	if len(b) == 0 {
+		return `""`
+	}
+	if utf8.Valid(b) && bytes.IndexFunc(b, func(r rune) bool { return !unicode.IsPrint(r) && !unicode.IsSpace(r) }) < 0 {
+		return string(b)
+	}
+	return "0x" + hex.EncodeToString(b)
+}
+
+// sortedKeys returns the keys of a map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,454 @@
+// core/pkg/chaincode/consistency_test.go
+package chaincode
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	stdErr "errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/fabric/common"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/fabric/ledger/rwset"
+	"github.com/temmyjay001/core/pkg/fabric/ledger/rwset/kvrwset"
+	"github.com/temmyjay001/core/pkg/fabric/msp"
+	"github.com/temmyjay001/core/pkg/fabric/peer"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// writeSigningIdentity creates the MSP folder of an org's identity with a
+// fresh ECDSA key and self-signed certificate
+func writeSigningIdentity(t testing.TB, net *network.Network, org *network.Organization, name string) *x509.Certificate {
+	t.Helper()
+	der, key, err := selfSigned(fmt.Sprintf("%s@%s", name, org.Domain))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mspDir := net.IdentityMSPPath(org, name)
+	for dir, file := range map[string][]byte{
+		"signcerts": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		"keystore":  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8}),
+	} {
+		if err := os.MkdirAll(filepath.Join(mspDir, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(mspDir, dir, "file.pem"), file, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cert, _ := x509.ParseCertificate(der)
+	return cert
+}
+
+// selfSigned creates a fresh ECDSA key and a self-signed DER certificate
+// for it
+func selfSigned(commonName string) ([]byte, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	return der, key, err
+}
+
+// fakeEndorser answers proposals like a peer
+type fakeEndorser struct {
+	peer.UnimplementedEndorserServer
+	respond func(*peer.SignedProposal) (*peer.ProposalResponse, error)
+}
+
+func (f *fakeEndorser) ProcessProposal(ctx context.Context, signed *peer.SignedProposal) (*peer.ProposalResponse, error) {
+	return f.respond(signed)
+}
+
+// serveEndorsers starts an in-memory endorser per peer name and points the
+// invoker at them. Peers without an endorser cannot be reached.
+func serveEndorsers(t testing.TB, inv *Invoker, endorsers map[string]*fakeEndorser) {
+	t.Helper()
+	servePeers(t, inv, endorsers, nil)
+}
+
+// servePeers starts in-memory endorser and gateway services per peer name
+// and points the invoker at them. Peers without either cannot be reached.
+func servePeers(t testing.TB, inv *Invoker, endorsers map[string]*fakeEndorser, gateways map[string]*fakeGateway) {
+	t.Helper()
+	servers := map[string]*grpc.Server{}
+	server := func(name string) *grpc.Server {
+		if servers[name] == nil {
+			servers[name] = grpc.NewServer()
+		}
+		return servers[name]
+	}
+	for name, endorser := range endorsers {
+		peer.RegisterEndorserServer(server(name), endorser)
+	}
+	for name, gw := range gateways {
+		gateway.RegisterGatewayServer(server(name), gw)
+	}
+
+	listeners := map[string]*bufconn.Listener{}
+	for name, server := range servers {
+		lis := bufconn.Listen(1 << 20)
+		go server.Serve(lis)
+		t.Cleanup(server.Stop)
+		listeners[name] = lis
+	}
+
+	inv.dialPeer = func(org *network.Organization, p *network.Peer) (*grpc.ClientConn, error) {
+		lis, ok := listeners[p.Name]
+		if !ok {
+			return nil, fmt.Errorf("connection refused")
+		}
+		return grpc.NewClient("passthrough:///"+p.Name,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}))
+	}
+}
+
+// simulation is what a fake peer's chaincode did
+type simulation struct {
+	status   int32
+	message  string
+	payload  string
+	reads    map[string]*kvrwset.Version
+	writes   map[string]string
+	private  map[string]string // collection -> value hash of key "k"
+	event    string
+	rangeEnd string
+}
+
+// proposalResponse builds the response a peer signs for a simulation
+func proposalResponse(t testing.TB, sim simulation) *peer.ProposalResponse {
+	t.Helper()
+	if sim.status == 0 {
+		sim.status = 200
+	}
+	response := &peer.Response{Status: sim.status, Message: sim.message, Payload: []byte(sim.payload)}
+	if sim.status >= 400 {
+		return &peer.ProposalResponse{Response: response}
+	}
+
+	kv := &kvrwset.KVRWSet{}
+	for key, version := range sim.reads {
+		kv.Reads = append(kv.Reads, &kvrwset.KVRead{Key: key, Version: version})
+	}
+	for key, value := range sim.writes {
+		kv.Writes = append(kv.Writes, &kvrwset.KVWrite{Key: key, Value: []byte(value)})
+	}
+	if sim.rangeEnd != "" {
+		kv.RangeQueriesInfo = append(kv.RangeQueriesInfo, &kvrwset.RangeQueryInfo{
+			StartKey:     "asset",
+			EndKey:       "asset~",
+			ItrExhausted: true,
+			ReadsInfo: &kvrwset.RangeQueryInfo_RawReads{RawReads: &kvrwset.QueryReads{
+				KvReads: []*kvrwset.KVRead{{Key: sim.rangeEnd, Version: &kvrwset.Version{BlockNum: 3}}},
+			}},
+		})
+	}
+	ns := &rwset.NsReadWriteSet{Namespace: "mycc", Rwset: mustMarshal(t, kv)}
+	for coll, valueHash := range sim.private {
+		hashed := &kvrwset.HashedRWSet{HashedWrites: []*kvrwset.KVWriteHash{{KeyHash: []byte("k"), ValueHash: []byte(valueHash)}}}
+		ns.CollectionHashedRwset = append(ns.CollectionHashedRwset, &rwset.CollectionHashedReadWriteSet{
+			CollectionName: coll,
+			HashedRwset:    mustMarshal(t, hashed),
+		})
+	}
+
+	action := &peer.ChaincodeAction{
+		Results:  mustMarshal(t, &rwset.TxReadWriteSet{NsRwset: []*rwset.NsReadWriteSet{ns}}),
+		Response: response,
+	}
+	if sim.event != "" {
+		action.Events = mustMarshal(t, &peer.ChaincodeEvent{ChaincodeId: "mycc", EventName: sim.event})
+	}
+	payload := mustMarshal(t, &peer.ProposalResponsePayload{Extension: mustMarshal(t, action)})
+	return &peer.ProposalResponse{
+		Response:    response,
+		Payload:     payload,
+		Endorsement: &peer.Endorsement{Endorser: []byte("endorser"), Signature: []byte("signature")},
+	}
+}
+
+func mustMarshal(t testing.TB, m proto.Message) []byte {
+	t.Helper()
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// respondWith returns the same simulation for every proposal
+func respondWith(t testing.TB, sim simulation) *fakeEndorser {
+	return &fakeEndorser{respond: func(*peer.SignedProposal) (*peer.ProposalResponse, error) {
+		return proposalResponse(t, sim), nil
+	}}
+}
+
+// endorserLog records the proposals each fake endorser received
+type endorserLog struct {
+	mu        sync.Mutex
+	proposals map[string]*peer.SignedProposal
+}
+
+// endorsers answers with the same simulation from every named peer
+func (l *endorserLog) endorsers(t testing.TB, sim simulation, names ...string) map[string]*fakeEndorser {
+	l.proposals = map[string]*peer.SignedProposal{}
+	endorsers := map[string]*fakeEndorser{}
+	for _, name := range names {
+		name := name
+		endorsers[name] = &fakeEndorser{respond: func(signed *peer.SignedProposal) (*peer.ProposalResponse, error) {
+			l.mu.Lock()
+			l.proposals[name] = signed
+			l.mu.Unlock()
+			return proposalResponse(t, sim), nil
+		}}
+	}
+	return endorsers
+}
+
+// peers lists the endorsers that received a proposal, sorted
+func (l *endorserLog) peers() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	peers := []string{}
+	for name := range l.proposals {
+		peers = append(peers, name)
+	}
+	sort.Strings(peers)
+	return peers
+}
+
+func TestCheckConsistency(t *testing.T) {
+	agreed := simulation{
+		payload: `{"ID":"asset1"}`,
+		reads:   map[string]*kvrwset.Version{"asset1": nil},
+		writes:  map[string]string{"asset1": `{"ID":"asset1"}`},
+	}
+
+	tests := []struct {
+		name      string
+		endorsers map[string]simulation
+		want      []string // Divergence kind and key
+		wantErr   error
+	}{
+		{
+			name: "peers agree",
+			endorsers: map[string]simulation{
+				"peer0.org1.example.com": agreed,
+				"peer0.org2.example.com": agreed,
+			},
+		},
+		{
+			name: "wall clock in the written value",
+			endorsers: map[string]simulation{
+				"peer0.org1.example.com": {payload: "12:00:01", writes: map[string]string{"asset1": "12:00:01", "owner": "alice"}},
+				"peer0.org2.example.com": {payload: "12:00:02", writes: map[string]string{"asset1": "12:00:02", "owner": "alice"}},
+			},
+			want: []string{"payload ", "write asset1"},
+		},
+		{
+			name: "reads, events, range queries and private data",
+			endorsers: map[string]simulation{
+				"peer0.org1.example.com": {reads: map[string]*kvrwset.Version{"asset1": {BlockNum: 5}}, event: "Created", rangeEnd: "asset1", private: map[string]string{"prices": "a"}},
+				"peer0.org2.example.com": {reads: map[string]*kvrwset.Version{"asset1": {BlockNum: 6}}, rangeEnd: "asset2", private: map[string]string{"prices": "b"}},
+			},
+			want: []string{"event ", "read asset1", "range-query [asset, asset~)", "private-write 6b"},
+		},
+		{
+			name: "chaincode fails on one peer",
+			endorsers: map[string]simulation{
+				"peer0.org1.example.com": agreed,
+				"peer0.org2.example.com": {status: 500, message: "asset asset1 already exists"},
+			},
+			want: []string{"response ", "payload ", "read asset1", "write asset1"},
+		},
+		{
+			name: "unreachable peers are skipped",
+			endorsers: map[string]simulation{
+				"peer0.org1.example.com": agreed,
+			},
+		},
+		{
+			name:      "no peer reachable",
+			endorsers: map[string]simulation{},
+			wantErr:   errors.ErrTransactionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+			cert := writeSigningIdentity(t, net, net.Orgs[0], "User1")
+
+			endorsers := map[string]*fakeEndorser{}
+			proposals := make(chan *peer.SignedProposal, len(tt.endorsers))
+			for name, sim := range tt.endorsers {
+				sim := sim
+				endorsers[name] = &fakeEndorser{respond: func(signed *peer.SignedProposal) (*peer.ProposalResponse, error) {
+					proposals <- signed
+					return proposalResponse(t, sim), nil
+				}}
+			}
+
+			mockExec := executor.NewMockExecutor()
+			inv := NewInvoker(net, mockExec)
+			serveEndorsers(t, inv, endorsers)
+
+			report, err := inv.CheckConsistency(context.Background(), &InvokeRequest{
+				Chaincode:   "mycc",
+				Function:    "CreateAsset",
+				Args:        []string{"asset1"},
+				Identity:    "User1",
+				AutoEndorse: true,
+			})
+			if tt.wantErr != nil {
+				if !stdErr.Is(err, tt.wantErr) {
+					t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CheckConsistency() error = %v", err)
+			}
+
+			// Every peer of the network is asked, not a minimal set
+			if len(report.Peers) != 2 {
+				t.Errorf("Expected both peers reported, got %d", len(report.Peers))
+			}
+			if len(mockExec.GetCalls()) != 0 {
+				t.Errorf("Expected nothing submitted or queried, got %v", mockExec.GetCalls())
+			}
+			for _, p := range report.Peers {
+				if _, ok := tt.endorsers[p.Peer]; ok == (p.Error != "") {
+					t.Errorf("%s: error = %q", p.Peer, p.Error)
+				}
+			}
+
+			got := []string{}
+			for _, d := range report.Divergences {
+				got = append(got, d.Kind+" "+d.Key)
+				if len(d.Values) != len(tt.endorsers) {
+					t.Errorf("%s: expected a value per peer, got %v", d, d.Values)
+				}
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) && len(got)+len(tt.want) > 0 {
+				t.Errorf("Divergences = %v, want %v", got, tt.want)
+			}
+			if report.Consistent() != (len(tt.want) == 0) {
+				t.Errorf("Consistent() = %v", report.Consistent())
+			}
+
+			// Every peer executed the same signed proposal
+			close(proposals)
+			for signed := range proposals {
+				checkProposal(t, signed, cert, report.TxID)
+			}
+		})
+	}
+}
+
+// checkProposal verifies a proposal is signed by cert and invokes
+// mycc CreateAsset asset1 with the transaction ID
+func checkProposal(t *testing.T, signed *peer.SignedProposal, cert *x509.Certificate, txID string) {
+	t.Helper()
+	digest := sha256.Sum256(signed.ProposalBytes)
+	if !ecdsa.VerifyASN1(cert.PublicKey.(*ecdsa.PublicKey), digest[:], signed.Signature) {
+		t.Error("Proposal signature does not verify")
+	}
+	var sig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(signed.Signature, &sig); err != nil {
+		t.Fatal(err)
+	}
+	if sig.S.Cmp(new(big.Int).Rsh(elliptic.P256().Params().N, 1)) > 0 {
+		t.Error("Signature S is not low")
+	}
+
+	unmarshal := func(b []byte, m proto.Message) {
+		t.Helper()
+		if err := proto.Unmarshal(b, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	prop := &peer.Proposal{}
+	unmarshal(signed.ProposalBytes, prop)
+	header := &common.Header{}
+	unmarshal(prop.Header, header)
+	channelHeader := &common.ChannelHeader{}
+	unmarshal(header.ChannelHeader, channelHeader)
+	signatureHeader := &common.SignatureHeader{}
+	unmarshal(header.SignatureHeader, signatureHeader)
+	creator := &msp.SerializedIdentity{}
+	unmarshal(signatureHeader.Creator, creator)
+	payload := &peer.ChaincodeProposalPayload{}
+	unmarshal(prop.Payload, payload)
+	spec := &peer.ChaincodeInvocationSpec{}
+	unmarshal(payload.Input, spec)
+
+	if channelHeader.TxId != txID || channelHeader.ChannelId != "mychannel" || channelHeader.Type != int32(common.HeaderType_ENDORSER_TRANSACTION) {
+		t.Errorf("Unexpected channel header %v", channelHeader)
+	}
+	sum := sha256.Sum256(append(signatureHeader.Nonce, signatureHeader.Creator...))
+	if fmt.Sprintf("%x", sum) != txID {
+		t.Error("Transaction ID is not derived from the nonce and creator")
+	}
+	if creator.Mspid != "Org1MSP" {
+		t.Errorf("Creator MSP = %s, want Org1MSP", creator.Mspid)
+	}
+	args := spec.ChaincodeSpec.Input.Args
+	if spec.ChaincodeSpec.ChaincodeId.Name != "mycc" || len(args) != 2 || string(args[0]) != "CreateAsset" || string(args[1]) != "asset1" {
+		t.Errorf("Unexpected invocation %v", spec)
+	}
+}
+
+func TestCheckConsistencyMissingIdentity(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	inv := NewInvoker(net, executor.NewMockExecutor())
+	serveEndorsers(t, inv, map[string]*fakeEndorser{
+		"peer0.org1.example.com": respondWith(t, simulation{}),
+	})
+
+	_, err := inv.CheckConsistency(context.Background(), &InvokeRequest{Chaincode: "mycc", Function: "ReadAsset"})
+	if !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Errorf("Expected invalid configuration without an MSP folder, got %v", err)
+	}
+}
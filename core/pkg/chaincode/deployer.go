@@ -3,21 +3,28 @@ package chaincode
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io/fs"
+	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/google/uuid"
+	"github.com/temmyjay001/core/pkg/determinism"
 	"github.com/temmyjay001/core/pkg/docker"
 	"github.com/temmyjay001/core/pkg/errors"
 	"github.com/temmyjay001/core/pkg/executor"
 	"github.com/temmyjay001/core/pkg/network"
 )
 
-const (
-	fabricToolsImage = "hyperledger/fabric-tools:2.5"
-	ordererTLSCA     = "/etc/hyperledger/fabric/crypto/ordererOrganizations/example.com/orderers/orderer.example.com/tls/ca.crt"
-)
+const fabricToolsImage = "hyperledger/fabric-tools:2.5"
 
 type Deployer struct {
 	network   *network.Network
@@ -31,6 +38,27 @@ type DeployRequest struct {
 	Version               string
 	Language              string
 	EndorsementPolicyOrgs []string
+	EndorsementPolicy     string // Signature policy expression or channel config policy path
+	CollectionsConfig     string // Inline JSON or a path relative to Path
+	Mode                  string // DeployModePackage (default) or DeployModeCCaaS
+	Debug                 bool   // Run Go chaincode under Delve; implies DeployModeCCaaS
+	DebugPort             int    // Host port for Delve; 0 picks a free one
+	Stage                 bool   // Stage the definition for each org to approve instead of approving for all
+	Vendor                bool   // Vendor Go modules into the package so peers build offline
+	Package               string // Lifecycle package on the runtime host, installed as is
+	PackageID             string // Expected package ID, checked before install
+	UploadID              string // Package or source archive stored with SaveUpload
+	GitURL                string // Git repository to check the source out of
+	GitRef                string // Branch, tag or commit of GitURL; the default branch when empty
+	DeterminismCheck      string // DeterminismWarn or DeterminismEnforce; empty skips the check
+}
+
+// definition holds the values approve and commit must agree on
+type definition struct {
+	packageID    string
+	sequence     int64
+	policyArgs   []string
+	initRequired bool
 }
 
 func NewDeployer(net *network.Network, dockerMgr *docker.Manager, exec executor.Executor) *Deployer {
@@ -41,86 +69,399 @@ func NewDeployer(net *network.Network, dockerMgr *docker.Manager, exec executor.
 	}
 }
 
+// DeployResult describes the definition a deploy or upgrade committed
+type DeployResult struct {
+	ChaincodeID string
+	OldVersion  string // Empty on a first deploy
+	OldSequence int64  // 0 on a first deploy
+	Version     string
+	Sequence    int64
+	PackageID   string
+	Reinstalled bool // False when the previous package was reused
+	Restarted   bool // True when only the chaincode service was restarted
+	DebugPort   int  // Host port of the Delve server in debug mode
+	Staged      bool // True when the definition waits for the orgs to approve it
+
+	Diagnostics []determinism.Diagnostic // Findings of the determinism check
+}
+
+// Deploy commits a chaincode definition. Deploying a name that is already
+// committed upgrades it to the next sequence.
 func (d *Deployer) Deploy(ctx context.Context, req *DeployRequest) (string, error) {
+	result, err := d.deploy(ctx, req, false)
+	if err != nil {
+		return "", err
+	}
+	return result.ChaincodeID, nil
+}
+
+// Upgrade commits a new definition of an already committed chaincode,
+// repackaging and installing only when the chaincode changed
+func (d *Deployer) Upgrade(ctx context.Context, req *DeployRequest) (*DeployResult, error) {
+	return d.deploy(ctx, req, true)
+}
+
+func (d *Deployer) deploy(ctx context.Context, req *DeployRequest, upgrade bool) (*DeployResult, error) {
+	op := "Deploy"
+	if upgrade {
+		op = "Upgrade"
+	}
+
 	// Check context
 	if err := ctx.Err(); err != nil {
-		return "", errors.Wrap("Deploy", err)
+		return nil, errors.Wrap(op, err)
 	}
 
-	// Set defaults
-	if req.Version == "" {
-		req.Version = "1.0"
+	if err := d.resolveSource(ctx, req); err != nil {
+		return nil, errors.Wrap(op+".Source", err)
 	}
-	if req.Language == "" {
-		req.Language = "golang"
+
+	// Validate private data collections before doing any work
+	collections, err := LoadCollections(req.CollectionsConfig, req.Path)
+	if err != nil {
+		return nil, errors.Wrap(op+".Collections", err)
+	}
+	if err := ValidateCollections(d.network, collections); err != nil {
+		return nil, errors.Wrap(op+".Collections", err)
 	}
 
-	ccID := fmt.Sprintf("%s-%s", req.Name, uuid.New().String()[:8])
+	policyArgs, err := d.endorsementPolicyArgs(req)
+	if err != nil {
+		return nil, errors.Wrap(op+".Policy", err)
+	}
 
-	// Package chaincode using Docker
-	packageFile, err := d.packageChaincode(ctx, req)
+	// Prebuilt packages carry their language
+	source := req.Path
+	if req.Package != "" {
+		meta, err := readPackageMetadata(req.Package)
+		if err != nil {
+			return nil, errors.Wrap(op+".Package", err)
+		}
+		req.Language = meta.Type
+		source = req.Package
+	} else {
+		language, err := resolveLanguage(req.Path, req.Language)
+		if err != nil {
+			return nil, errors.Wrap(op+".Language", err)
+		}
+		req.Language = language
+	}
+	if req.Vendor && req.Language != LanguageGo {
+		return nil, errors.WrapWithContext(op+".Language", errors.ErrInvalidConfig, map[string]interface{}{
+			"language": req.Language,
+			"reason":   "only golang chaincode dependencies can be vendored",
+		})
+	}
+	mode, err := deployMode(req)
 	if err != nil {
-		return "", errors.Wrap("Deploy.Package", err)
+		return nil, errors.Wrap(op, err)
 	}
+	req.Mode = mode
 
-	// Install on all peers using Docker exec
-	for _, org := range d.network.Orgs {
-		for _, peer := range org.Peers {
-			if err := ctx.Err(); err != nil {
-				return "", errors.Wrap("Deploy", err)
-			}
+	// Stop non-deterministic chaincode before anything is installed
+	diagnostics, err := enforceDeterminism(req)
+	if err != nil {
+		return nil, errors.Wrap(op+".Determinism", err)
+	}
 
-			if err := d.installChaincode(ctx, org, peer, packageFile); err != nil {
-				return "", errors.WrapWithContext("Deploy.Install", err, map[string]interface{}{
-					"peer": peer.Name,
-					"org":  org.Name,
-				})
-			}
+	// Peers must not time out while the chaincode sits on a breakpoint
+	if req.Debug {
+		if err := d.raisePeerTimeouts(ctx); err != nil {
+			return nil, errors.Wrap(op+".Debug", err)
 		}
 	}
 
-	// Approve for all orgs using Docker exec
-	for _, org := range d.network.Orgs {
-		if err := ctx.Err(); err != nil {
-			return "", errors.Wrap("Deploy", err)
+	// The committed definition decides the next sequence
+	committed, err := d.queryCommitted(ctx, req.Name)
+	if err != nil {
+		return nil, errors.Wrap(op+".QueryCommitted", err)
+	}
+	if upgrade && committed == nil {
+		return nil, errors.WrapWithContext(op, errors.ErrChaincodeNotFound, map[string]interface{}{
+			"chaincode": req.Name,
+			"channel":   d.network.Channel.Name,
+		})
+	}
+
+	if committed != nil {
+		if err := d.inheritDefinition(req, committed); err != nil {
+			return nil, errors.Wrap(op+".QueryCommitted", err)
+		}
+		if collections, err = LoadCollections(req.CollectionsConfig, req.Path); err != nil {
+			return nil, errors.Wrap(op+".Collections", err)
+		}
+		if policyArgs, err = d.endorsementPolicyArgs(req); err != nil {
+			return nil, errors.Wrap(op+".Policy", err)
+		}
+	}
+
+	result := &DeployResult{Sequence: 1, Diagnostics: diagnostics}
+	if committed != nil {
+		result.OldVersion = committed.Version
+		result.OldSequence = committed.Sequence
+		result.Sequence = committed.Sequence + 1
+	}
+
+	// Set defaults
+	if req.Version == "" {
+		req.Version = "1.0"
+		if committed != nil {
+			req.Version = committed.Version
 		}
+	}
+	result.Version = req.Version
+	result.ChaincodeID = fmt.Sprintf("%s-%s", req.Name, uuid.New().String()[:8])
 
-		if err := d.approveChaincode(ctx, org, req, packageFile); err != nil {
-			return "", errors.WrapWithContext("Deploy.Approve", err, map[string]interface{}{
-				"org": org.Name,
-			})
+	if collections != nil {
+		if err := d.copyCollectionsConfig(ctx, req.Name, collections); err != nil {
+			return nil, errors.Wrap(op+".Collections", err)
+		}
+	}
+
+	// Reuse the installed package when neither the source nor the label
+	// changed. CCaaS packages do not contain the source.
+	sourceHash := hashSource(source)
+	digest := definitionDigest(policyArgs, collections)
+	previous := d.network.Chaincode(req.Name)
+	reusable := committed != nil && previous != nil &&
+		previous.Version == req.Version &&
+		previous.Language == req.Language &&
+		previous.Mode == req.Mode &&
+		previous.Vendored == req.Vendor &&
+		(req.Mode == DeployModeCCaaS || (sourceHash != "" && previous.SourceHash == sourceHash))
+
+	// An unchanged CCaaS definition only needs the service restarted
+	if reusable && !req.Stage && req.Mode == DeployModeCCaaS && previous.Definition == digest {
+		debugPort, err := d.startCCaaS(ctx, req, previous.PackageID)
+		if err != nil {
+			return nil, errors.Wrap(op, err)
+		}
+		updated := *previous
+		updated.Path = source
+		updated.SourceHash = sourceHash
+		updated.DebugPort = debugPort
+		updated.Determinism = diagnostics
+		d.network.RecordChaincode(&updated)
+
+		result.DebugPort = debugPort
+
+		result.Sequence = committed.Sequence
+		result.PackageID = previous.PackageID
+		result.Restarted = true
+		return result, nil
+	}
+
+	if reusable {
+		result.PackageID = previous.PackageID
+		fmt.Printf("✓ Chaincode unchanged, reusing package %s\n", previous.PackageID)
+	} else {
+		packageID, err := d.packageAndInstall(ctx, req, sourceHash)
+		if err != nil {
+			return nil, errors.Wrap(op, err)
 		}
+		result.PackageID = packageID
+		result.Reinstalled = true
+	}
+
+	// The chaincode service must be reachable before Init runs
+	if req.Mode == DeployModeCCaaS {
+		debugPort, err := d.startCCaaS(ctx, req, result.PackageID)
+		if err != nil {
+			return nil, errors.Wrap(op, err)
+		}
+		result.DebugPort = debugPort
+	}
+
+	def := &definition{
+		packageID:  result.PackageID,
+		sequence:   result.Sequence,
+		policyArgs: policyArgs,
+	}
+	record := &network.Chaincode{
+		Name:        req.Name,
+		Version:     req.Version,
+		Sequence:    result.Sequence,
+		Language:    req.Language,
+		Path:        source,
+		PackageID:   result.PackageID,
+		SourceHash:  sourceHash,
+		Vendored:    req.Vendor,
+		Mode:        req.Mode,
+		Definition:  digest,
+		DebugPort:   result.DebugPort,
+		Determinism: diagnostics,
+	}
+
+	// Governance mode leaves approving and committing to the orgs
+	if req.Stage {
+		d.network.StageDefinition(&network.StagedDefinition{
+			Chaincode:         *record,
+			PolicyArgs:        policyArgs,
+			CollectionsConfig: req.CollectionsConfig,
+			Collections:       toNetworkCollections(collections),
+		})
+		fmt.Printf("📋 Staged %s version %s at sequence %d for approval\n", req.Name, req.Version, result.Sequence)
+		result.Staged = true
+		return result, nil
+	}
+
+	// Approve for all orgs in parallel using Docker exec
+	err = d.forEachOrg(ctx, op+".Approve", func(org *network.Organization) error {
+		return d.approveChaincode(ctx, org, req, def)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Check context before commit
 	if err := ctx.Err(); err != nil {
-		return "", errors.Wrap("Deploy", err)
+		return nil, errors.Wrap(op, err)
 	}
 
 	// Commit chaincode using Docker exec
-	if err := d.commitChaincode(ctx, req); err != nil {
-		return "", errors.Wrap("Deploy.Commit", err)
+	if err := d.commitChaincode(ctx, req, def); err != nil {
+		return nil, errors.Wrap(op+".Commit", err)
 	}
 
+	d.network.SetCollections(req.Name, toNetworkCollections(collections))
+	d.network.RecordChaincode(record)
+	d.network.ClearStagedDefinition(req.Name)
+
 	// Initialize chaincode if Init function exists
-	if err := d.initChaincode(ctx, req); err != nil {
-		// Log warning but don't fail - Init may not be required
-		fmt.Printf("Warning: chaincode init returned error (may be expected): %v\n", err)
+	if result.Sequence == 1 {
+		if err := d.initChaincode(ctx, req); err != nil {
+			// Log warning but don't fail - Init may not be required
+			fmt.Printf("Warning: chaincode init returned error (may be expected): %v\n", err)
+		}
+	}
+
+	return result, nil
+}
+
+// packageAndInstall packages the chaincode, installs it on every peer that
+// does not have it yet and returns its package ID
+func (d *Deployer) packageAndInstall(ctx context.Context, req *DeployRequest, sourceHash string) (string, error) {
+	// Package chaincode using Docker, or just its address for CCaaS.
+	// Prebuilt packages are installed as is.
+	label := fmt.Sprintf("%s_%s", req.Name, req.Version)
+	var packageFile string
+	var err error
+	switch {
+	case req.Package != "":
+		var meta *packageMetadata
+		if meta, err = readPackageMetadata(req.Package); err == nil {
+			packageFile, label = req.Package, meta.Label
+		}
+	case req.Mode == DeployModeCCaaS:
+		packageFile, err = d.packageCCaaS(req)
+	default:
+		packageFile, err = d.packageChaincode(ctx, req, sourceHash)
+	}
+	if err != nil {
+		return "", errors.Wrap("Package", err)
+	}
+
+	// Knowing the package ID up front lets peers that have it skip install
+	packageID, err := packageIDOf(packageFile, label)
+	if err != nil {
+		return "", errors.Wrap("Package", err)
+	}
+	if req.PackageID != "" && req.PackageID != packageID {
+		return "", errors.WrapWithContext("Package", errors.ErrInvalidConfig, map[string]interface{}{
+			"expected": req.PackageID,
+			"computed": packageID,
+			"reason":   "the package does not have the expected package ID",
+		})
+	}
+
+	containerPath, err := d.copyPackage(ctx, packageFile)
+	if err != nil {
+		return "", errors.Wrap("Install", err)
+	}
+
+	// Install on all peers in parallel using Docker exec
+	total := 0
+	for _, org := range d.network.Orgs {
+		total += len(org.Peers)
+	}
+	var done atomic.Int32
+	var mu sync.Mutex
+	installedID := ""
+
+	err = d.forEachPeer(ctx, "Install", func(org *network.Organization, peer *network.Peer) error {
+		if packageID != "" && d.installedOn(ctx, org, peer, packageID) {
+			fmt.Printf("✓ %s already has %s (%d/%d)\n", peer.Name, packageID, done.Add(1), total)
+			return nil
+		}
+
+		id, err := d.installChaincode(ctx, org, peer, containerPath)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✓ Installed on %s (%d/%d)\n", peer.Name, done.Add(1), total)
+
+		if id != "" {
+			mu.Lock()
+			installedID = id
+			mu.Unlock()
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if installedID != "" {
+		// A prebuilt package must be the one the client expected
+		if req.Package != "" && installedID != packageID {
+			return "", errors.WrapWithContext("Install", errors.ErrChaincodeDeployFailed, map[string]interface{}{
+				"computed":  packageID,
+				"installed": installedID,
+				"reason":    "peers report a different package ID than the package has",
+			})
+		}
+		packageID = installedID
 	}
 
-	return ccID, nil
+	if packageID != "" {
+		return packageID, nil
+	}
+
+	// Fall back to looking the package up by label
+	packageID, err = d.getPackageID(ctx, d.network.Orgs[0], req.Name, req.Version)
+	if err != nil {
+		return "", errors.Wrap("GetPackageID", err)
+	}
+	return packageID, nil
 }
 
-func (d *Deployer) packageChaincode(ctx context.Context, req *DeployRequest) (string, error) {
+// packageChaincode packages the chaincode into the package cache, reusing
+// the cached package when the source, language and label are unchanged
+func (d *Deployer) packageChaincode(ctx context.Context, req *DeployRequest, sourceHash string) (string, error) {
+	label := fmt.Sprintf("%s_%s", req.Name, req.Version)
+	key := packageCacheKey(sourceHash, req.Language, label, req.Vendor)
+	if cached := cachedPackage(key); cached != "" {
+		fmt.Printf("✓ Using cached package %s\n", cached)
+		return cached, nil
+	}
+
 	packagePath := filepath.Join(d.network.BasePath, "chaincode", fmt.Sprintf("%s.tar.gz", req.Name))
+	if key != "" {
+		packagePath = filepath.Join(packageCacheDir, key+".tar.gz")
+	}
 
 	// Check context
 	if err := ctx.Err(); err != nil {
 		return "", errors.Wrap("packageChaincode", err)
 	}
 
+	// TypeScript, Java and vendored Go build before packaging
+	sourcePath, err := d.buildChaincode(ctx, req)
+	if err != nil {
+		return "", errors.Wrap("packageChaincode", err)
+	}
+
 	// Convert to absolute paths
-	absChaincodePath, err := filepath.Abs(req.Path)
+	absChaincodePath, err := filepath.Abs(sourcePath)
 	if err != nil {
 		return "", errors.WrapWithContext("packageChaincode", err, map[string]interface{}{
 			"path": req.Path,
@@ -151,13 +492,17 @@ func (d *Deployer) packageChaincode(ctx context.Context, req *DeployRequest) (st
 		"-v", fmt.Sprintf("%s:/output", packageDir),
 		fabricToolsImage,
 		"peer", "lifecycle", "chaincode", "package",
-		fmt.Sprintf("/output/%s.tar.gz", req.Name),
+		"/output/"+filepath.Base(absPackagePath),
 		"--path", "/chaincode",
-		"--lang", req.Language,
-		"--label", fmt.Sprintf("%s_%s", req.Name, req.Version),
+		"--lang", peerLanguage(req.Language),
+		"--label", label,
 	)
 
 	if err != nil {
+		// Don't leave a partial package behind for the next deploy to reuse
+		if key != "" {
+			os.Remove(absPackagePath)
+		}
 		return "", errors.WrapWithContext("packageChaincode", errors.ErrChaincodeDeployFailed, map[string]interface{}{
 			"error":  err.Error(),
 			"output": string(output),
@@ -168,45 +513,61 @@ func (d *Deployer) packageChaincode(ctx context.Context, req *DeployRequest) (st
 	return absPackagePath, nil
 }
 
-func (d *Deployer) installChaincode(ctx context.Context, org *network.Organization, peer *network.Peer, packageFile string) error {
-	// Check context
-	if err := ctx.Err(); err != nil {
-		return errors.Wrap("installChaincode", err)
-	}
-
-	fmt.Printf("📥 Installing on %s...\n", peer.Name)
-
-	// Copy package to cli container
+// copyPackage copies a package into the cli container once for every peer
+// to install, and returns its path there
+func (d *Deployer) copyPackage(ctx context.Context, packageFile string) (string, error) {
 	containerName := "cli"
-	output, err := d.exec.ExecuteCombined(ctx, "docker", "cp", packageFile, fmt.Sprintf("%s:/tmp/chaincode.tar.gz", containerName))
+	containerPath := "/tmp/" + filepath.Base(packageFile)
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", "cp", packageFile, fmt.Sprintf("%s:%s", containerName, containerPath))
 	if err != nil {
-		return errors.WrapWithContext("installChaincode.Copy", err, map[string]interface{}{
+		return "", errors.WrapWithContext("copyPackage", err, map[string]interface{}{
 			"container": containerName,
 			"output":    string(output),
 		})
 	}
+	return containerPath, nil
+}
+
+func (d *Deployer) installChaincode(ctx context.Context, org *network.Organization, peer *network.Peer, containerPath string) (string, error) {
+	// Check context
+	if err := ctx.Err(); err != nil {
+		return "", errors.Wrap("installChaincode", err)
+	}
+
+	fmt.Printf("📥 Installing on %s...\n", peer.Name)
 
 	// Execute install inside cli container
+	containerName := "cli"
 	env := d.getPeerEnvArgs(org, peer)
 	args := []string{"exec"}
 	args = append(args, env...)
 	args = append(args, containerName,
-		"peer", "lifecycle", "chaincode", "install", "/tmp/chaincode.tar.gz")
+		"peer", "lifecycle", "chaincode", "install", containerPath)
 
-	output, err = d.exec.ExecuteCombined(ctx, "docker", args...)
-	if err != nil {
-		return errors.WrapWithContext("installChaincode.Execute", errors.ErrChaincodeDeployFailed, map[string]interface{}{
+	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil && !strings.Contains(string(output), "already successfully installed") {
+		return "", errors.WrapWithContext("installChaincode.Execute", errors.ErrChaincodeDeployFailed, map[string]interface{}{
 			"error":     err.Error(),
 			"output":    string(output),
 			"container": containerName,
 		})
 	}
 
-	fmt.Printf("✓ Installed on %s\n", peer.Name)
-	return nil
+	return extractPackageID(string(output)), nil
+}
+
+var packageIDPattern = regexp.MustCompile(`(?:Chaincode code package identifier|package ID):?\s*'?([A-Za-z0-9_.+-]+:[0-9a-f]{64})`)
+
+// extractPackageID reads the package ID from peer lifecycle install output
+func extractPackageID(output string) string {
+	if m := packageIDPattern.FindStringSubmatch(output); m != nil {
+		return m[1]
+	}
+	return ""
 }
 
-func (d *Deployer) approveChaincode(ctx context.Context, org *network.Organization, req *DeployRequest, packageFile string) error {
+func (d *Deployer) approveChaincode(ctx context.Context, org *network.Organization, req *DeployRequest, def *definition) error {
 	// Check context
 	if err := ctx.Err(); err != nil {
 		return errors.Wrap("approveChaincode", err)
@@ -214,34 +575,26 @@ func (d *Deployer) approveChaincode(ctx context.Context, org *network.Organizati
 
 	fmt.Printf("✅ Approving for %s...\n", org.Name)
 
-	// Get package ID from the peer container
-	packageID, err := d.getPackageID(ctx, org, req.Name, req.Version)
-	if err != nil {
-		return errors.Wrap("approveChaincode.GetPackageID", err)
-	}
-
 	peer := org.Peers[0]
 	containerName := "cli"
 
-	// Build endorsement policy
-	policy := d.buildEndorsementPolicy(req.EndorsementPolicyOrgs)
-
 	env := d.getPeerEnvArgs(org, peer)
 
 	args := []string{"exec"}
 	args = append(args, env...)
 	args = append(args, containerName,
 		"peer", "lifecycle", "chaincode", "approveformyorg",
-		"-o", fmt.Sprintf("%s:%d", d.network.Orderers[0].Name, d.network.Orderers[0].Port),
+		"-o", d.network.OrdererEndpoint(),
 		"--channelID", d.network.Channel.Name,
 		"--name", req.Name,
 		"--version", req.Version,
-		"--package-id", packageID,
-		"--sequence", "1",
-		"--signature-policy", policy,
+		"--package-id", def.packageID,
+		"--sequence", strconv.FormatInt(def.sequence, 10),
 		"--tls", "true",
-		"--cafile", ordererTLSCA,
+		"--cafile", d.network.OrdererTLSCA(),
 	)
+	args = append(args, def.policyArgs...)
+	args = append(args, d.collectionsConfigArgs(req)...)
 
 	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
 	if err != nil {
@@ -256,7 +609,7 @@ func (d *Deployer) approveChaincode(ctx context.Context, org *network.Organizati
 	return nil
 }
 
-func (d *Deployer) commitChaincode(ctx context.Context, req *DeployRequest) error {
+func (d *Deployer) commitChaincode(ctx context.Context, req *DeployRequest, def *definition) error {
 	// Check context
 	if err := ctx.Err(); err != nil {
 		return errors.Wrap("commitChaincode", err)
@@ -281,23 +634,22 @@ func (d *Deployer) commitChaincode(ctx context.Context, req *DeployRequest) erro
 		}
 	}
 
-	policy := d.buildEndorsementPolicy(req.EndorsementPolicyOrgs)
-
 	// Execute commit inside peer container
 	env := d.getPeerEnvArgs(org, peer)
 	args := []string{"exec"}
 	args = append(args, env...)
 	args = append(args, containerName,
 		"peer", "lifecycle", "chaincode", "commit",
-		"-o", fmt.Sprintf("%s:%d", d.network.Orderers[0].Name, d.network.Orderers[0].Port),
+		"-o", d.network.OrdererEndpoint(),
 		"--channelID", d.network.Channel.Name,
 		"--name", req.Name,
 		"--version", req.Version,
-		"--sequence", "1",
-		"--signature-policy", policy,
+		"--sequence", strconv.FormatInt(def.sequence, 10),
 		"--tls", "true",
-		"--cafile", ordererTLSCA,
+		"--cafile", d.network.OrdererTLSCA(),
 	)
+	args = append(args, def.policyArgs...)
+	args = append(args, d.collectionsConfigArgs(req)...)
 
 	args = append(args, peerAddresses...)
 	args = append(args, peerTLSRootCerts...)
@@ -314,6 +666,133 @@ func (d *Deployer) commitChaincode(ctx context.Context, req *DeployRequest) erro
 	return nil
 }
 
+// hashSource returns a digest of the chaincode source tree, or an empty
+// string when it cannot be read
+func hashSource(path string) string {
+	root, err := filepath.Abs(path)
+	if err != nil {
+		return ""
+	}
+
+	h := sha256.New()
+	err = filepath.WalkDir(root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, _ := filepath.Rel(root, p)
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "%s\x00%d\x00", filepath.ToSlash(rel), len(data))
+		h.Write(data)
+		return nil
+	})
+	if err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// copyCollectionsConfig writes the collections definition to disk and copies
+// it into the cli container for approve and commit
+func (d *Deployer) copyCollectionsConfig(ctx context.Context, name string, collections []*CollectionConfig) error {
+	data, err := json.MarshalIndent(collections, "", "  ")
+	if err != nil {
+		return errors.Wrap("copyCollectionsConfig", err)
+	}
+
+	configFile := d.collectionsConfigFile(name)
+	if err := os.MkdirAll(filepath.Dir(configFile), 0755); err != nil {
+		return errors.Wrap("copyCollectionsConfig", err)
+	}
+	if err := os.WriteFile(configFile, data, 0644); err != nil {
+		return errors.Wrap("copyCollectionsConfig", err)
+	}
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", "cp", configFile, fmt.Sprintf("cli:%s", collectionsConfigPath(name)))
+	if err != nil {
+		return errors.WrapWithContext("copyCollectionsConfig", err, map[string]interface{}{
+			"output": string(output),
+		})
+	}
+
+	return nil
+}
+
+// collectionsConfigFile is where the collections of the last definition of
+// a chaincode are kept
+func (d *Deployer) collectionsConfigFile(name string) string {
+	return filepath.Join(d.network.BasePath, "chaincode", fmt.Sprintf("%s_collections.json", name))
+}
+
+// inheritDefinition keeps the committed endorsement policy and collections
+// when a new definition of the chaincode does not set them. The defaults
+// would silently weaken an AND policy to any member, and Fabric rejects
+// definitions that drop existing collections.
+func (d *Deployer) inheritDefinition(req *DeployRequest, committed *CommittedDefinition) error {
+	if strings.TrimSpace(req.EndorsementPolicy) == "" && len(req.EndorsementPolicyOrgs) == 0 && committed.ValidationParameter != "" {
+		policy, ref, err := committed.EndorsementPolicy()
+		if err != nil {
+			return err
+		}
+		switch {
+		case ref != "":
+			req.EndorsementPolicy = ref
+		case policy != nil:
+			req.EndorsementPolicy = policy.String()
+		}
+	}
+
+	if strings.TrimSpace(req.CollectionsConfig) == "" && len(committed.Collections) > 0 {
+		// The stored config keeps collection endorsement policies, which
+		// querycommitted does not decode, unless it belongs to a definition
+		// that was staged but never committed
+		data, err := os.ReadFile(d.collectionsConfigFile(req.Name))
+		if err != nil || !sameCollections(data, committed.Collections) {
+			if data, err = json.Marshal(committed.Collections); err != nil {
+				return err
+			}
+		}
+		req.CollectionsConfig = string(data)
+	}
+	return nil
+}
+
+// sameCollections reports whether a stored collections config defines the
+// committed collections
+func sameCollections(data []byte, committed []*CollectionConfig) bool {
+	var stored []*CollectionConfig
+	if err := json.Unmarshal(data, &stored); err != nil || len(stored) != len(committed) {
+		return false
+	}
+	for i, c := range stored {
+		if c.Name != committed[i].Name || c.BlockToLive != committed[i].BlockToLive {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *Deployer) collectionsConfigArgs(req *DeployRequest) []string {
+	if strings.TrimSpace(req.CollectionsConfig) == "" {
+		return nil
+	}
+	return []string{"--collections-config", collectionsConfigPath(req.Name)}
+}
+
+func collectionsConfigPath(name string) string {
+	return fmt.Sprintf("/tmp/%s_collections.json", name)
+}
+
 func (d *Deployer) initChaincode(ctx context.Context, req *DeployRequest) error {
 	// Check context
 	if err := ctx.Err(); err != nil {
@@ -342,13 +821,13 @@ func (d *Deployer) initChaincode(ctx context.Context, req *DeployRequest) error
 	args = append(args, env...)
 	args = append(args, containerName,
 		"peer", "chaincode", "invoke",
-		"-o", fmt.Sprintf("%s:%d", d.network.Orderers[0].Name, d.network.Orderers[0].Port),
+		"-o", d.network.OrdererEndpoint(),
 		"-C", d.network.Channel.Name,
 		"-n", req.Name,
 		"--isInit",
 		"-c", `{"Args":["Init"]}`,
 		"--tls", "true",
-		"--cafile", ordererTLSCA,
+		"--cafile", d.network.OrdererTLSCA(),
 	)
 
 	args = append(args, peerAddresses...)
@@ -378,7 +857,7 @@ func (d *Deployer) getPackageID(ctx context.Context, org *network.Organization,
 	args = append(args, containerName,
 		"peer", "lifecycle", "chaincode", "queryinstalled",
 		"--tls", "true",
-		"--cafile", ordererTLSCA,
+		"--cafile", d.network.OrdererTLSCA(),
 	)
 
 	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
@@ -424,32 +903,80 @@ func (d *Deployer) getPeerEnvArgs(org *network.Organization, peer *network.Peer)
 	}
 }
 
-func (d *Deployer) buildEndorsementPolicy(orgs []string) string {
+// endorsementPolicyArgs returns the peer lifecycle flags for the requested
+// endorsement policy
+func (d *Deployer) endorsementPolicyArgs(req *DeployRequest) ([]string, error) {
+	expr := strings.TrimSpace(req.EndorsementPolicy)
+
+	if expr != "" && len(req.EndorsementPolicyOrgs) > 0 {
+		return nil, errors.WrapWithContext("endorsementPolicyArgs", errors.ErrInvalidConfig, map[string]interface{}{
+			"reason": "set either an endorsement policy or endorsement policy orgs, not both",
+		})
+	}
+
+	// Channel config policy reference, e.g. /Channel/Application/Endorsement
+	if strings.HasPrefix(expr, "/") {
+		if !channelPolicyPattern.MatchString(expr) {
+			return nil, errors.WrapWithContext("endorsementPolicyArgs", errors.ErrInvalidConfig, map[string]interface{}{
+				"policy": expr,
+				"reason": "channel config policy must look like /Channel/Application/<PolicyName>",
+			})
+		}
+		return []string{"--channel-config-policy", expr}, nil
+	}
+
+	if expr != "" {
+		policy, err := ParsePolicy(expr)
+		if err != nil {
+			return nil, err
+		}
+		if err := policy.Validate(d.mspIDs()); err != nil {
+			return nil, err
+		}
+		return []string{"--signature-policy", policy.String()}, nil
+	}
+
+	policy, err := d.buildEndorsementPolicy(req.EndorsementPolicyOrgs)
+	if err != nil {
+		return nil, err
+	}
+	return []string{"--signature-policy", policy}, nil
+}
+
+var channelPolicyPattern = regexp.MustCompile(`^/Channel/Application(/[A-Za-z0-9_]+)+$`)
+
+func (d *Deployer) mspIDs() []string {
+	ids := make([]string, 0, len(d.network.Orgs))
+	for _, org := range d.network.Orgs {
+		ids = append(ids, org.MSPID)
+	}
+	return ids
+}
+
+// buildEndorsementPolicy builds an OR policy over the given orgs (names or
+// MSP IDs), or over every org when none are given
+func (d *Deployer) buildEndorsementPolicy(orgs []string) (string, error) {
 	if len(orgs) == 0 {
 		// Default: require any org
 		mspids := []string{}
 		for _, org := range d.network.Orgs {
 			mspids = append(mspids, fmt.Sprintf("'%s.member'", org.MSPID))
 		}
-		return fmt.Sprintf("OR(%s)", strings.Join(mspids, ","))
+		return fmt.Sprintf("OR(%s)", strings.Join(mspids, ",")), nil
 	}
 
 	// Build policy from specified orgs
 	mspids := []string{}
 	for _, orgName := range orgs {
-		for _, org := range d.network.Orgs {
-			if org.Name == orgName {
-				mspids = append(mspids, fmt.Sprintf("'%s.member'", org.MSPID))
-			}
-		}
-	}
-
-	if len(mspids) == 0 {
-		// Fallback to all orgs
-		for _, org := range d.network.Orgs {
-			mspids = append(mspids, fmt.Sprintf("'%s.member'", org.MSPID))
+		org, err := d.network.GetOrg(orgName)
+		if err != nil {
+			return "", errors.WrapWithContext("buildEndorsementPolicy", errors.ErrInvalidConfig, map[string]interface{}{
+				"org":    orgName,
+				"reason": "unknown organization in endorsement policy",
+			})
 		}
+		mspids = append(mspids, fmt.Sprintf("'%s.member'", org.MSPID))
 	}
 
-	return fmt.Sprintf("OR(%s)", strings.Join(mspids, ","))
+	return fmt.Sprintf("OR(%s)", strings.Join(mspids, ",")), nil
 }
@@ -3,6 +3,9 @@ package chaincode
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	stdErr "errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,8 +13,17 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
 	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
 	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/fabric/common"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/fabric/msp"
+	"github.com/temmyjay001/core/pkg/fabric/peer"
 	"github.com/temmyjay001/core/pkg/network"
 )
 
@@ -52,6 +64,18 @@ func createMockNetwork() *network.Network {
 	}
 }
 
+// notCommittedOutput is what querycommitted prints for a chaincode that was
+// never committed
+func notCommittedOutput(args []string) string {
+	name := ""
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] == "--name" {
+			name = args[i+1]
+		}
+	}
+	return fmt.Sprintf("Error: query failed with status: 404 - namespace %s is not defined", name)
+}
+
 func TestDeploy(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -91,6 +115,9 @@ func TestDeploy(t *testing.T) {
 						if contains(args, "queryinstalled") {
 							return []byte("Package ID: mycc_1.0:hash123, Label: mycc_1.0"), nil
 						}
+						if contains(args, "querycommitted") {
+							return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+						}
 						if contains(args, "approveformyorg") {
 							return []byte("Approved"), nil
 						}
@@ -124,6 +151,21 @@ func TestDeploy(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "package not written",
+			req: &DeployRequest{
+				Name:    "mycc",
+				Path:    "/chaincode/mycc",
+				Version: "1.0",
+			},
+			setup: func(m *executor.MockExecutor, tempDir string) {
+				m.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+					// Packaging reports success without leaving a package to hash
+					return []byte("success"), nil
+				}
+			},
+			wantErr: true,
+		},
 		{
 			name: "with default values",
 			req: &DeployRequest{
@@ -147,6 +189,9 @@ func TestDeploy(t *testing.T) {
 					if contains(args, "queryinstalled") {
 						return []byte("Package ID: mycc_1.0:hash123, Label: mycc_1.0"), nil
 					}
+					if contains(args, "querycommitted") {
+						return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+					}
 					return []byte("success"), nil
 				}
 			},
@@ -229,6 +274,305 @@ func TestDeployContextCancellation(t *testing.T) {
 	}
 }
 
+func TestUpgrade(t *testing.T) {
+	const committedOutput = `{"sequence": 2, "version": "1.0", "endorsement_plugin": "escc", "validation_plugin": "vscc", "approvals": {"Org1MSP": true, "Org2MSP": true}}`
+	const installOutput = "Installed remotely: response:<status:200 payload:\"\\nMmycc_2.0:0a1b\" > \nChaincode code package identifier: mycc_2.0:" +
+		"0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+
+	tests := []struct {
+		name            string
+		version         string
+		sourceUnchanged bool
+		committed       bool
+		wantVersion     string
+		wantPackageID   string
+		wantReinstalled bool
+		wantErr         error
+	}{
+		{
+			name:            "new version is repackaged",
+			version:         "2.0",
+			committed:       true,
+			wantVersion:     "2.0",
+			wantPackageID:   "mycc_2.0:0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9",
+			wantReinstalled: true,
+		},
+		{
+			name:            "unchanged source reuses package",
+			sourceUnchanged: true,
+			committed:       true,
+			wantVersion:     "1.0",
+			wantPackageID:   "mycc_1.0:previous",
+		},
+		{
+			name:    "not committed",
+			wantErr: errors.ErrChaincodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			srcDir := filepath.Join(net.BasePath, "src")
+			if err := os.MkdirAll(srcDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(srcDir, "main.go"), []byte("package main"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(srcDir, "go.mod"), []byte("module mycc"), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			previous := &network.Chaincode{Name: "mycc", Version: "1.0", Sequence: 2, Language: "golang", PackageID: "mycc_1.0:previous", Mode: DeployModePackage}
+			if tt.sourceUnchanged {
+				previous.SourceHash = hashSource(srcDir)
+			}
+			net.RecordChaincode(previous)
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				if contains(args, "package") {
+					writePackageOutput(t, args, "package bytes")
+					return []byte("success"), nil
+				}
+				if contains(args, "querycommitted") {
+					if !tt.committed {
+						return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+					}
+					return []byte(committedOutput), nil
+				}
+				if contains(args, "install") {
+					return []byte(installOutput), nil
+				}
+				return []byte("success"), nil
+			}
+
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+			result, err := deployer.Upgrade(context.Background(), &DeployRequest{
+				Name:    "mycc",
+				Path:    srcDir,
+				Version: tt.version,
+			})
+			if tt.wantErr != nil {
+				if !stdErr.Is(err, tt.wantErr) {
+					t.Errorf("Expected %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Upgrade() error = %v", err)
+			}
+
+			if result.OldVersion != "1.0" || result.OldSequence != 2 || result.Sequence != 3 || result.Version != tt.wantVersion {
+				t.Errorf("Unexpected result: %+v", result)
+			}
+
+			if result.Reinstalled != tt.wantReinstalled || result.PackageID != tt.wantPackageID {
+				t.Errorf("Expected package %s (reinstalled %v), got %s (%v)", tt.wantPackageID, tt.wantReinstalled, result.PackageID, result.Reinstalled)
+			}
+
+			approvals, commits := 0, 0
+			for _, call := range mockExec.Calls {
+				if contains(call.Args, "package") && !tt.wantReinstalled {
+					t.Error("Expected no repackaging for unchanged chaincode")
+				}
+				if contains(call.Args, "approveformyorg") {
+					approvals++
+					if !containsSequence(call.Args, "3") || !contains(call.Args, tt.wantPackageID) {
+						t.Errorf("Expected approval of sequence 3 with %s, got %v", tt.wantPackageID, call.Args)
+					}
+				}
+				if contains(call.Args, "commit") {
+					commits++
+					if !containsSequence(call.Args, "3") {
+						t.Errorf("Expected commit of sequence 3, got %v", call.Args)
+					}
+				}
+				if contains(call.Args, "--isInit") {
+					t.Error("Expected no Init call on upgrade")
+				}
+			}
+			if approvals != 2 || commits != 1 {
+				t.Errorf("Expected 2 approvals and 1 commit, got %d and %d", approvals, commits)
+			}
+
+			if recorded := net.Chaincode("mycc"); recorded.Sequence != 3 || recorded.PackageID != tt.wantPackageID {
+				t.Errorf("Expected recorded definition to be updated, got %+v", recorded)
+			}
+		})
+	}
+}
+
+func TestUpgradeKeepsCommittedDefinition(t *testing.T) {
+	committedOutput := fmt.Sprintf(`{"sequence": 2, "version": "1.0", "validation_parameter": %q, "collections": %s}`,
+		encodeSignaturePolicy(t, "AND('Org1MSP.peer','Org2MSP.peer')"), committedCollectionsJSON())
+
+	tests := []struct {
+		name           string
+		policy         string
+		stored         string // Collections config kept from the last deploy
+		wantPolicy     string
+		wantCollection string // Expected in the collections config
+	}{
+		{
+			name:           "committed policy and collections",
+			wantPolicy:     "AND('Org1MSP.peer','Org2MSP.peer')",
+			wantCollection: `"name": "Org1Private"`,
+		},
+		{
+			name:           "explicit policy",
+			policy:         "OR('Org1MSP.peer','Org2MSP.peer')",
+			wantPolicy:     "OR('Org1MSP.peer','Org2MSP.peer')",
+			wantCollection: `"name": "Org1Private"`,
+		},
+		{
+			name:           "stored collections keep their endorsement policy",
+			stored:         `[{"name": "Org1Private", "policy": "OR('Org1MSP.member','Org2MSP.member')", "requiredPeerCount": 1, "maxPeerCount": 2, "blockToLive": 100, "memberOnlyRead": true, "endorsementPolicy": {"signaturePolicy": "OR('Org1MSP.peer')"}}]`,
+			wantPolicy:     "AND('Org1MSP.peer','Org2MSP.peer')",
+			wantCollection: `"signaturePolicy": "OR('Org1MSP.peer')"`,
+		},
+		{
+			name:           "stale stored collections",
+			stored:         `[{"name": "Org2Private", "policy": "OR('Org2MSP.member')", "requiredPeerCount": 0, "maxPeerCount": 1}]`,
+			wantPolicy:     "AND('Org1MSP.peer','Org2MSP.peer')",
+			wantCollection: `"name": "Org1Private"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			srcDir := filepath.Join(net.BasePath, "src")
+			if err := os.MkdirAll(srcDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(srcDir, "main.go"), []byte("package main"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filepath.Join(srcDir, "go.mod"), []byte("module mycc"), 0644); err != nil {
+				t.Fatal(err)
+			}
+			net.RecordChaincode(&network.Chaincode{Name: "mycc", Version: "1.0", Sequence: 2, Language: LanguageGo, PackageID: "mycc_1.0:previous", SourceHash: hashSource(srcDir), Mode: DeployModePackage})
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				if contains(args, "querycommitted") {
+					return []byte(committedOutput), nil
+				}
+				return []byte("success"), nil
+			}
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+			if tt.stored != "" {
+				if err := os.MkdirAll(filepath.Dir(deployer.collectionsConfigFile("mycc")), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(deployer.collectionsConfigFile("mycc"), []byte(tt.stored), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if _, err := deployer.Upgrade(context.Background(), &DeployRequest{Name: "mycc", Path: srcDir, EndorsementPolicy: tt.policy}); err != nil {
+				t.Fatalf("Upgrade() error = %v", err)
+			}
+
+			for _, call := range mockExec.Calls {
+				if !contains(call.Args, "approveformyorg") && !contains(call.Args, "commit") {
+					continue
+				}
+				if !containsFlag(call.Args, "--signature-policy", tt.wantPolicy) {
+					t.Errorf("Expected policy %s, got %v", tt.wantPolicy, call.Args)
+				}
+				if !contains(call.Args, "--collections-config") {
+					t.Errorf("Expected the committed collections, got %v", call.Args)
+				}
+			}
+
+			config, err := os.ReadFile(deployer.collectionsConfigFile("mycc"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(config), tt.wantCollection) {
+				t.Errorf("Expected %s in collections config, got %s", tt.wantCollection, config)
+			}
+			if collections := net.Collections("mycc"); len(collections) != 1 || collections[0].BlockToLive != 100 {
+				t.Errorf("Expected the committed collection recorded, got %v", collections)
+			}
+		})
+	}
+}
+
+// containsFlag reports whether args set a flag to a value
+func containsFlag(args []string, flag, value string) bool {
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] == flag && args[i+1] == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestIsNotDefined(t *testing.T) {
+	tests := []struct {
+		output string
+		want   bool
+	}{
+		{output: "Error: query failed with status: 404 - namespace mycc is not defined", want: true},
+		{output: "Error: query failed with status: 404 - namespace mycc2 is not defined", want: false},
+		{output: "Error: failed to connect to peer0.org1.example.com:404", want: false},
+		{output: "Error: query failed with status: 500 - failed to invoke backing implementation", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := isNotDefined(tt.output, "mycc"); got != tt.want {
+			t.Errorf("isNotDefined(%q) = %v, want %v", tt.output, got, tt.want)
+		}
+	}
+}
+
+func TestParseCommittedDefinition(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   int64
+	}{
+		{name: "numeric sequence", output: `{"sequence": 4, "version": "2.1"}`, want: 4},
+		{name: "string sequence", output: `{"sequence": "4", "version": "2.1"}`, want: 4},
+		{name: "leading log lines", output: "2024-01-01 00:00:00.000 UTC 0001 INFO [lifecycle] query\n{\"sequence\": 4, \"version\": \"2.1\"}", want: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def, err := parseCommittedDefinition([]byte(tt.output))
+			if err != nil {
+				t.Fatalf("parseCommittedDefinition() error = %v", err)
+			}
+			if def.Sequence != tt.want || def.Version != "2.1" {
+				t.Errorf("Expected sequence %d version 2.1, got %d %s", tt.want, def.Sequence, def.Version)
+			}
+		})
+	}
+
+	if _, err := parseCommittedDefinition([]byte("Error: something else")); err == nil {
+		t.Error("Expected error for output without a definition")
+	}
+}
+
+// containsSequence reports whether args carry --sequence with the given value
+func containsSequence(args []string, seq string) bool {
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] == "--sequence" && args[i+1] == seq {
+			return true
+		}
+	}
+	return false
+}
+
 func TestGetPackageID(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -306,6 +650,7 @@ func TestBuildEndorsementPolicy(t *testing.T) {
 		name         string
 		orgs         []string
 		wantContains []string
+		wantErr      bool
 	}{
 		{
 			name:         "default policy (all orgs)",
@@ -322,6 +667,16 @@ func TestBuildEndorsementPolicy(t *testing.T) {
 			orgs:         []string{"Org1", "Org2"},
 			wantContains: []string{"OR(", "Org1MSP.member", "Org2MSP.member"},
 		},
+		{
+			name:         "MSP ID",
+			orgs:         []string{"Org2MSP"},
+			wantContains: []string{"OR('Org2MSP.member')"},
+		},
+		{
+			name:    "unknown org",
+			orgs:    []string{"Org1", "Org7"},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -332,7 +687,16 @@ func TestBuildEndorsementPolicy(t *testing.T) {
 			dockerMgr := docker.NewManager(executor.NewMockExecutor())
 			deployer := NewDeployer(net, dockerMgr, executor.NewMockExecutor())
 
-			policy := deployer.buildEndorsementPolicy(tt.orgs)
+			policy, err := deployer.buildEndorsementPolicy(tt.orgs)
+			if tt.wantErr {
+				if !stdErr.Is(err, errors.ErrInvalidConfig) {
+					t.Errorf("Expected ErrInvalidConfig, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("buildEndorsementPolicy() error = %v", err)
+			}
 
 			for _, want := range tt.wantContains {
 				if !containsStr(policy, want) {
@@ -359,61 +723,178 @@ func contains(slice []string, item string) bool {
 // Invoker Tests
 
 func TestInvoke(t *testing.T) {
+	payload := "line 1\nline 2\x00\xff" // Returned as is, not trimmed or decoded
+	endorsed := simulation{payload: payload, writes: map[string]string{"asset1": "value1"}}
+
+	rejected, err := status.New(codes.Aborted, "failed to endorse transaction, see attached details for more info").WithDetails(&gateway.ErrorDetail{
+		Address: "peer0.org2.example.com:8051",
+		MspId:   "Org2MSP",
+		Message: "chaincode response 500, asset asset1 already exists",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unreachable, err := status.New(codes.Aborted, "failed to endorse transaction, see attached details for more info").WithDetails(&gateway.ErrorDetail{
+		Address: "peer0.org2.example.com:8051",
+		MspId:   "Org2MSP",
+		Message: "rpc error: code = Unavailable desc = connection refused",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
-		name      string
-		chaincode string
-		function  string
-		args      []string
-		setup     func(*executor.MockExecutor)
-		wantTxID  string
-		wantErr   bool
+		name          string
+		endorseErr    error
+		submitErr     error
+		result        peer.TxValidationCode
+		wantErr       error
+		wantSent      bool
+		wantCode      peer.TxValidationCode
+		wantStatus    int32
+		wantMessage   string
+		wantRetryable bool
 	}{
 		{
-			name:      "successful invoke",
-			chaincode: "mycc",
-			function:  "createAsset",
-			args:      []string{"asset1", "value1"},
-			setup: func(m *executor.MockExecutor) {
-				m.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
-					return []byte("Chaincode invoke successful. result: status:200 txid [abc123def456] committed with status (VALID)"), nil
-				}
-			},
-			wantTxID: "abc123def456",
-			wantErr:  false,
+			name:       "committed",
+			result:     peer.TxValidationCode_VALID,
+			wantSent:   true,
+			wantCode:   peer.TxValidationCode_VALID,
+			wantStatus: 200,
 		},
 		{
-			name:      "invoke fails",
-			chaincode: "mycc",
-			function:  "createAsset",
-			args:      []string{"asset1"},
-			setup: func(m *executor.MockExecutor) {
-				m.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
-					return nil, fmt.Errorf("invoke failed")
-				}
-			},
-			wantErr: true,
+			name:        "chaincode error",
+			endorseErr:  rejected.Err(),
+			wantErr:     errors.ErrEndorsementRejected,
+			wantCode:    peer.TxValidationCode_NOT_VALIDATED,
+			wantStatus:  500,
+			wantMessage: "asset asset1 already exists",
+		},
+		{
+			name:       "endorsers disagree",
+			endorseErr: status.Error(codes.Aborted, "failed to assemble transaction: ProposalResponsePayloads do not match"),
+			wantErr:    errors.ErrEndorsementMismatch,
+			wantCode:   peer.TxValidationCode_NOT_VALIDATED,
+		},
+		{
+			name:       "endorser unreachable",
+			endorseErr: unreachable.Err(),
+			wantErr:    errors.ErrTransactionFailed,
+			wantCode:   peer.TxValidationCode_NOT_VALIDATED,
+		},
+		{
+			name:          "read conflict",
+			result:        peer.TxValidationCode_MVCC_READ_CONFLICT,
+			wantErr:       errors.ErrTransactionInvalidated,
+			wantSent:      true,
+			wantCode:      peer.TxValidationCode_MVCC_READ_CONFLICT,
+			wantStatus:    200,
+			wantRetryable: true,
+		},
+		{
+			name:          "phantom read",
+			result:        peer.TxValidationCode_PHANTOM_READ_CONFLICT,
+			wantErr:       errors.ErrTransactionInvalidated,
+			wantSent:      true,
+			wantCode:      peer.TxValidationCode_PHANTOM_READ_CONFLICT,
+			wantStatus:    200,
+			wantRetryable: true,
+		},
+		{
+			name:       "endorsement policy failure",
+			result:     peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE,
+			wantErr:    errors.ErrTransactionInvalidated,
+			wantSent:   true,
+			wantCode:   peer.TxValidationCode_ENDORSEMENT_POLICY_FAILURE,
+			wantStatus: 200,
+		},
+		{
+			name:       "orderer unavailable",
+			submitErr:  status.Error(codes.Unavailable, "no orderer available"),
+			wantErr:    errors.ErrTransactionFailed,
+			wantSent:   true,
+			wantCode:   peer.TxValidationCode_NOT_VALIDATED,
+			wantStatus: 200,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockExec := executor.NewMockExecutor()
-			tt.setup(mockExec)
-
 			net := createMockNetwork()
 			defer os.RemoveAll(net.BasePath)
+			cert := writeSigningIdentity(t, net, net.Orgs[0], "Admin")
+
+			invoker := NewInvoker(net, executor.NewMockExecutor())
+			gw := &fakeGateway{
+				endorsement: proposalResponse(t, endorsed),
+				endorseErr:  tt.endorseErr,
+				submitErr:   tt.submitErr,
+				result:      tt.result,
+				block:       7,
+			}
+			servePeers(t, invoker, nil, map[string]*fakeGateway{"peer0.org1.example.com": gw})
+
+			result, err := invoker.Submit(context.Background(), &InvokeRequest{
+				Chaincode: "mycc",
+				Function:  "createAsset",
+				Args:      []string{"asset1", "value1"},
+			})
+			if (len(gw.submitted) == 1) != tt.wantSent {
+				t.Errorf("Expected transaction submitted %v, got %d submissions", tt.wantSent, len(gw.submitted))
+			}
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Submit() error = %v", err)
+			}
+			if tt.wantErr != nil && !stdErr.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
 
-			invoker := NewInvoker(net, mockExec)
-
-			ctx := context.Background()
-			txID, _, err := invoker.Invoke(ctx, tt.chaincode, tt.function, tt.args)
-
-			if (err != nil) != tt.wantErr {
-				t.Errorf("Invoke() error = %v, wantErr %v", err, tt.wantErr)
+			if result == nil {
+				t.Fatal("Expected a result")
+			}
+			if result.ValidationCode != tt.wantCode || result.Status != tt.wantStatus || result.Retryable() != tt.wantRetryable {
+				t.Errorf("Submit() = %s, status %d, retryable %v; want %s, status %d, retryable %v",
+					result.ValidationCode, result.Status, result.Retryable(), tt.wantCode, tt.wantStatus, tt.wantRetryable)
+			}
+			if tt.wantMessage != "" && result.Message != tt.wantMessage {
+				t.Errorf("Submit() message = %q, want %q", result.Message, tt.wantMessage)
+			}
+			if tt.endorseErr != nil && tt.wantErr != errors.ErrEndorsementRejected && errors.IsEndorsementRejected(err) {
+				t.Errorf("Expected %v not to be reported as rejected at endorsement", err)
+			}
+			if len(gw.endorsed) != 1 || strings.Join(gw.endorsed[0].EndorsingOrganizations, ",") != "Org1MSP,Org2MSP" {
+				t.Errorf("Expected endorsement by Org1MSP and Org2MSP, got %v", gw.endorsed)
+			}
+			if !tt.wantSent {
+				return
+			}
+			if strings.Join(result.Endorsers, ",") != "peer0.org1.example.com,peer0.org2.example.com" {
+				t.Errorf("Submit() endorsers = %v", result.Endorsers)
+			}
+			if tt.wantErr != nil {
+				return
 			}
 
-			if !tt.wantErr && txID != tt.wantTxID {
-				t.Errorf("Invoke() txID = %v, want %v", txID, tt.wantTxID)
+			header, _ := submittedTransaction(t, gw)
+			digest := sha256.Sum256(gw.submitted[0].PreparedTransaction.Payload)
+			if !ecdsa.VerifyASN1(cert.PublicKey.(*ecdsa.PublicKey), digest[:], gw.submitted[0].PreparedTransaction.Signature) {
+				t.Error("Submitted transaction is not signed by the signer")
+			}
+			channelHeader := &common.ChannelHeader{}
+			if err := proto.Unmarshal(header.Header.ChannelHeader, channelHeader); err != nil {
+				t.Fatal(err)
+			}
+			if result.TxID != channelHeader.TxId || gw.submitted[0].TransactionId != result.TxID || gw.endorsed[0].TransactionId != result.TxID {
+				t.Errorf("Submit() txID = %s, want %s", result.TxID, channelHeader.TxId)
+			}
+			if string(result.Payload) != payload {
+				t.Errorf("Submit() payload = %q, want %q", result.Payload, payload)
+			}
+			if result.BlockNumber != 7 || result.ValidationCode != peer.TxValidationCode_VALID {
+				t.Errorf("Submit() committed in block %d as %s", result.BlockNumber, result.ValidationCode)
+			}
+			if len(gw.statuses) != 1 || gw.statuses[0].TransactionId != result.TxID {
+				t.Errorf("Expected the commit status of %s, got %v", result.TxID, gw.statuses)
 			}
 		})
 	}
@@ -421,36 +902,29 @@ func TestInvoke(t *testing.T) {
 
 func TestQuery(t *testing.T) {
 	tests := []struct {
-		name      string
-		chaincode string
-		function  string
-		args      []string
-		setup     func(*executor.MockExecutor)
-		wantData  string
-		wantErr   bool
+		name     string
+		evaluate func(*gateway.EvaluateRequest) (*gateway.EvaluateResponse, error)
+		wantData string
+		wantErr  bool
 	}{
 		{
-			name:      "successful query",
-			chaincode: "mycc",
-			function:  "getAsset",
-			args:      []string{"asset1"},
-			setup: func(m *executor.MockExecutor) {
-				m.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
-					return []byte(`{"id":"asset1","value":"value1"}`), nil
-				}
+			name: "successful query",
+			evaluate: func(*gateway.EvaluateRequest) (*gateway.EvaluateResponse, error) {
+				return &gateway.EvaluateResponse{Result: &peer.Response{Status: 200, Payload: []byte("{\"id\":\"asset1\"}\n")}}, nil
 			},
-			wantData: `{"id":"asset1","value":"value1"}`,
-			wantErr:  false,
+			wantData: "{\"id\":\"asset1\"}\n",
 		},
 		{
-			name:      "query fails",
-			chaincode: "mycc",
-			function:  "getAsset",
-			args:      []string{"nonexistent"},
-			setup: func(m *executor.MockExecutor) {
-				m.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
-					return nil, fmt.Errorf("asset not found")
-				}
+			name: "chaincode error",
+			evaluate: func(*gateway.EvaluateRequest) (*gateway.EvaluateResponse, error) {
+				return &gateway.EvaluateResponse{Result: &peer.Response{Status: 500, Message: "asset nonexistent does not exist"}}, nil
+			},
+			wantErr: true,
+		},
+		{
+			name: "gateway error",
+			evaluate: func(*gateway.EvaluateRequest) (*gateway.EvaluateResponse, error) {
+				return nil, status.Error(codes.Aborted, "failed to evaluate transaction")
 			},
 			wantErr: true,
 		},
@@ -458,97 +932,165 @@ func TestQuery(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockExec := executor.NewMockExecutor()
-			tt.setup(mockExec)
-
 			net := createMockNetwork()
 			defer os.RemoveAll(net.BasePath)
+			writeSigningIdentity(t, net, net.Orgs[0], "Admin")
 
-			invoker := NewInvoker(net, mockExec)
-
-			ctx := context.Background()
-			data, err := invoker.Query(ctx, tt.chaincode, tt.function, tt.args)
+			invoker := NewInvoker(net, executor.NewMockExecutor())
+			gw := &fakeGateway{evaluate: tt.evaluate}
+			servePeers(t, invoker, nil, map[string]*fakeGateway{"peer0.org1.example.com": gw})
 
+			data, err := invoker.Query(context.Background(), "mycc", "getAsset", []string{"asset1"})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Query() error = %v, wantErr %v", err, tt.wantErr)
 			}
-
 			if !tt.wantErr && string(data) != tt.wantData {
-				t.Errorf("Query() data = %v, want %v", string(data), tt.wantData)
+				t.Errorf("Query() data = %q, want %q", data, tt.wantData)
+			}
+
+			req := gw.evaluated[0]
+			if req.ChannelId != "mychannel" || strings.Join(req.TargetOrganizations, ",") != "Org1MSP" {
+				t.Errorf("Expected evaluation on mychannel by Org1MSP, got %s %v", req.ChannelId, req.TargetOrganizations)
 			}
 		})
 	}
 }
 
-func TestBuildArgsJSON(t *testing.T) {
+func TestSubmitAsIdentity(t *testing.T) {
 	net := createMockNetwork()
 	defer os.RemoveAll(net.BasePath)
+	writeSigningIdentity(t, net, net.Orgs[1], "User1")
 
 	invoker := NewInvoker(net, executor.NewMockExecutor())
+	gateways := map[string]*fakeGateway{"peer0.org1.example.com": {}, "peer0.org2.example.com": {}}
+	servePeers(t, invoker, nil, gateways)
+
+	_, err := invoker.Submit(context.Background(), &InvokeRequest{
+		Chaincode: "token",
+		Function:  "Transfer",
+		Args:      []string{"bob", "10"},
+		Identity:  "User1@org2.example.com",
+	})
+	if err != nil {
+		t.Fatalf("Submit() error = %v", err)
+	}
 
-	tests := []struct {
-		name     string
-		function string
-		args     []string
-		want     string
-	}{
-		{
-			name:     "no args",
-			function: "init",
-			args:     []string{},
-			want:     `{"Args":["init"]}`,
-		},
-		{
-			name:     "with args",
-			function: "createAsset",
-			args:     []string{"asset1", "value1"},
-			want:     `{"Args":["createAsset","asset1","value1"]}`,
-		},
+	// The signer's own peer endorses and orders the transaction
+	if len(gateways["peer0.org1.example.com"].endorsed) != 0 || len(gateways["peer0.org1.example.com"].submitted) != 0 {
+		t.Error("Expected no transaction through an Org1 peer")
+	}
+	gw := gateways["peer0.org2.example.com"]
+	header, _ := submittedTransaction(t, gw)
+	signatureHeader := &common.SignatureHeader{}
+	if err := proto.Unmarshal(header.Header.SignatureHeader, signatureHeader); err != nil {
+		t.Fatal(err)
+	}
+	for _, creator := range [][]byte{signatureHeader.Creator, gw.statuses[0].Identity} {
+		id := &msp.SerializedIdentity{}
+		if err := proto.Unmarshal(creator, id); err != nil {
+			t.Fatal(err)
+		}
+		if id.Mspid != "Org2MSP" {
+			t.Errorf("Expected an Org2MSP creator, got %s", id.Mspid)
+		}
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			result := invoker.buildArgsJSON(tt.function, tt.args)
-			if result != tt.want {
-				t.Errorf("buildArgsJSON() = %v, want %v", result, tt.want)
-			}
-		})
+	// Identities that were never enrolled are rejected before calling a peer
+	if _, err := invoker.Evaluate(context.Background(), &InvokeRequest{Chaincode: "token", Function: "Balance", Org: "Org1", Identity: "User1"}); err == nil {
+		t.Error("Expected error for an identity without an enrolled MSP")
+	}
+	if len(gateways["peer0.org1.example.com"].evaluated) != 0 {
+		t.Error("Expected no peer call for an unknown identity")
 	}
 }
 
-func TestExtractTxID(t *testing.T) {
-	net := createMockNetwork()
-	defer os.RemoveAll(net.BasePath)
-
-	invoker := NewInvoker(net, executor.NewMockExecutor())
-
+func TestSubmitWithTransient(t *testing.T) {
 	tests := []struct {
-		name   string
-		output string
-		want   string
+		name        string
+		org         string
+		collection  string
+		wantOrgs    []string
+		wantGateway string
+		wantErr     bool
 	}{
 		{
-			name:   "valid tx ID",
-			output: "Chaincode invoke successful. result: status:200 txid [abc123def456] committed with status (VALID)",
-			want:   "abc123def456",
+			name:        "signer org only",
+			org:         "Org2",
+			wantOrgs:    []string{"Org2MSP"},
+			wantGateway: "peer0.org2.example.com",
+		},
+		{
+			name:        "collection members",
+			collection:  "Org1Org2Shared",
+			wantOrgs:    []string{"Org1MSP", "Org2MSP"},
+			wantGateway: "peer0.org1.example.com",
 		},
 		{
-			name:   "tx ID with newlines",
-			output: "Some output\nChaincode invoke successful.\ntxid [xyz789abc] committed\nMore output",
-			want:   "xyz789abc",
+			name:        "single member collection",
+			org:         "Org2",
+			collection:  "Org1Private",
+			wantOrgs:    []string{"Org1MSP"},
+			wantGateway: "peer0.org2.example.com",
 		},
 		{
-			name:   "no tx ID",
-			output: "Some output without transaction ID",
-			want:   "unknown",
+			name:       "unknown collection",
+			collection: "Org3Private",
+			wantErr:    true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := invoker.extractTxID(tt.output)
-			if result != tt.want {
-				t.Errorf("extractTxID() = %v, want %v", result, tt.want)
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+			for _, org := range net.Orgs {
+				writeSigningIdentity(t, net, org, "Admin")
+			}
+
+			net.SetCollections("private", []*network.Collection{
+				{Name: "Org1Private", MemberOrgs: []string{"Org1MSP"}},
+				{Name: "Org1Org2Shared", MemberOrgs: []string{"Org1MSP", "Org2MSP"}},
+			})
+
+			invoker := NewInvoker(net, executor.NewMockExecutor())
+			gateways := map[string]*fakeGateway{"peer0.org1.example.com": {}, "peer0.org2.example.com": {}}
+			servePeers(t, invoker, nil, gateways)
+
+			_, err := invoker.Submit(context.Background(), &InvokeRequest{
+				Chaincode:  "private",
+				Function:   "CreateSecret",
+				Org:        tt.org,
+				Transient:  map[string][]byte{"secret": []byte("s3cr3t")},
+				Collection: tt.collection,
+			})
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected error for an unknown collection")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Submit() error = %v", err)
+			}
+
+			gw := gateways[tt.wantGateway]
+			if len(gw.endorsed) != 1 {
+				t.Fatalf("Expected 1 endorsement through %s, got %d", tt.wantGateway, len(gw.endorsed))
+			}
+			req := gw.endorsed[0]
+			if strings.Join(req.EndorsingOrganizations, ",") != strings.Join(tt.wantOrgs, ",") {
+				t.Errorf("Expected endorsing orgs %v, got %v", tt.wantOrgs, req.EndorsingOrganizations)
+			}
+			proposed := &peer.Proposal{}
+			payload := &peer.ChaincodeProposalPayload{}
+			if err := proto.Unmarshal(req.ProposedTransaction.ProposalBytes, proposed); err != nil {
+				t.Fatal(err)
+			}
+			if err := proto.Unmarshal(proposed.Payload, payload); err != nil {
+				t.Fatal(err)
+			}
+			if string(payload.TransientMap["secret"]) != "s3cr3t" {
+				t.Errorf("Expected transient data in the proposal, got %v", payload.TransientMap)
 			}
 		})
 	}
@@ -585,15 +1127,16 @@ func BenchmarkDeploy(b *testing.B) {
 }
 
 func BenchmarkInvoke(b *testing.B) {
-	mockExec := executor.NewMockExecutor()
-	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
-		return []byte("txid [abc123] committed"), nil
-	}
-
 	net := createMockNetwork()
 	defer os.RemoveAll(net.BasePath)
+	writeSigningIdentity(b, net, net.Orgs[0], "Admin")
 
-	invoker := NewInvoker(net, mockExec)
+	invoker := NewInvoker(net, executor.NewMockExecutor())
+	sim := simulation{payload: "ok", writes: map[string]string{"arg1": "value"}}
+	servePeers(b, invoker, map[string]*fakeEndorser{
+		"peer0.org1.example.com": respondWith(b, sim),
+		"peer0.org2.example.com": respondWith(b, sim),
+	}, map[string]*fakeGateway{"peer0.org1.example.com": {}})
 
 	ctx := context.Background()
 
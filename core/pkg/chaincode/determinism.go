@@ -0,0 +1,92 @@
+// core/pkg/chaincode/determinism.go
+package chaincode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/temmyjay001/core/pkg/determinism"
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// Determinism check modes of a deploy
+const (
+	DeterminismWarn    = "warn"    // Report findings and deploy anyway
+	DeterminismEnforce = "enforce" // Refuse to deploy when a finding is an error
+)
+
+// CheckDeterminism checks the source of a request, a path, upload or git
+// repository, for code endorsers can execute differently without deploying
+// it
+func (d *Deployer) CheckDeterminism(ctx context.Context, req *DeployRequest) ([]determinism.Diagnostic, error) {
+	if req.GitURL != "" && req.Name == "" {
+		return nil, errors.WrapWithContext("CheckDeterminism", errors.ErrInvalidConfig, map[string]interface{}{
+			"git_url": req.GitURL,
+			"reason":  "a chaincode name is needed to check out a git repository",
+		})
+	}
+	if err := d.resolveSource(ctx, req); err != nil {
+		return nil, errors.Wrap("CheckDeterminism.Source", err)
+	}
+	return checkDeterminism(req)
+}
+
+// checkDeterminism checks the resolved source of a request
+func checkDeterminism(req *DeployRequest) ([]determinism.Diagnostic, error) {
+	if req.Package != "" {
+		return nil, errors.WrapWithContext("checkDeterminism", errors.ErrInvalidConfig, map[string]interface{}{
+			"package": req.Package,
+			"reason":  "a prebuilt package cannot be checked, deploy its source instead",
+		})
+	}
+
+	language, err := resolveLanguage(req.Path, req.Language)
+	if err != nil {
+		return nil, err
+	}
+	if language != LanguageGo {
+		return nil, errors.WrapWithContext("checkDeterminism", errors.ErrInvalidConfig, map[string]interface{}{
+			"language": language,
+			"reason":   "only golang chaincode can be checked for determinism",
+		})
+	}
+
+	return determinism.CheckDir(req.Path)
+}
+
+// enforceDeterminism runs the determinism check a deploy asked for and, in
+// enforce mode, fails with ErrNondeterministic when it finds errors
+func enforceDeterminism(req *DeployRequest) ([]determinism.Diagnostic, error) {
+	switch req.DeterminismCheck {
+	case "":
+		return nil, nil
+	case DeterminismWarn, DeterminismEnforce:
+	default:
+		return nil, errors.WrapWithContext("enforceDeterminism", errors.ErrInvalidConfig, map[string]interface{}{
+			"determinism_check": req.DeterminismCheck,
+			"reason":            "determinism check must be warn or enforce",
+		})
+	}
+
+	diagnostics, err := checkDeterminism(req)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range diagnostics {
+		fmt.Printf("⚠️  %s\n", d)
+	}
+
+	if req.DeterminismCheck == DeterminismEnforce && determinism.HasErrors(diagnostics) {
+		var findings []string
+		for _, d := range diagnostics {
+			if d.Severity == determinism.SeverityError {
+				findings = append(findings, d.String())
+			}
+		}
+		return diagnostics, errors.WrapWithContext("enforceDeterminism", errors.ErrNondeterministic, map[string]interface{}{
+			"chaincode": req.Name,
+			"errors":    findings,
+		})
+	}
+	return diagnostics, nil
+}
@@ -0,0 +1,138 @@
+// core/pkg/chaincode/determinism_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/determinism"
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+const clockContract = `package main
+
+import (
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+type Clock struct {
+	contractapi.Contract
+}
+
+func (c *Clock) Tick(ctx contractapi.TransactionContextInterface) error {
+	return ctx.GetStub().PutState("tick", []byte(time.Now().String()))
+}
+`
+
+func TestDeployDeterminismCheck(t *testing.T) {
+	src := writeProject(t, map[string]string{"go.mod": "module clock", "main.go": clockContract})
+
+	tests := []struct {
+		name        string
+		req         *DeployRequest
+		wantErr     error
+		wantInstall bool
+	}{
+		{
+			name:        "warn deploys and records findings",
+			req:         &DeployRequest{Name: "clock", Path: src, DeterminismCheck: DeterminismWarn},
+			wantInstall: true,
+		},
+		{
+			name:    "enforce refuses errors",
+			req:     &DeployRequest{Name: "clock", Path: src, DeterminismCheck: DeterminismEnforce},
+			wantErr: errors.ErrNondeterministic,
+		},
+		{
+			name:    "unknown mode",
+			req:     &DeployRequest{Name: "clock", Path: src, DeterminismCheck: "strict"},
+			wantErr: errors.ErrInvalidConfig,
+		},
+		{
+			name:    "other languages",
+			req:     &DeployRequest{Name: "clock", Path: src, Language: LanguageJava, DeterminismCheck: DeterminismWarn},
+			wantErr: errors.ErrInvalidConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				switch {
+				case contains(args, "package"):
+					writePackageOutput(t, args, "package bytes")
+				case contains(args, "querycommitted"):
+					return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+				case contains(args, "install"):
+					return []byte(stagedInstallOutput), nil
+				}
+				return []byte("success"), nil
+			}
+
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+			_, err := deployer.Deploy(context.Background(), tt.req)
+			if tt.wantErr != nil {
+				if !stdErr.Is(err, tt.wantErr) {
+					t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Deploy() error = %v", err)
+			}
+
+			installed := false
+			for _, call := range mockExec.GetCalls() {
+				if contains(call.Args, "install") {
+					installed = true
+				}
+			}
+			if installed != tt.wantInstall {
+				t.Errorf("Installed = %v, want %v", installed, tt.wantInstall)
+			}
+
+			if !tt.wantInstall {
+				return
+			}
+			cc := net.Chaincode("clock")
+			if cc == nil || len(cc.Determinism) != 1 || cc.Determinism[0].Check != determinism.CheckTime {
+				t.Errorf("Expected the time.Now finding recorded, got %+v", cc)
+			}
+		})
+	}
+}
+
+func TestCheckDeterminism(t *testing.T) {
+	src := writeProject(t, map[string]string{"go.mod": "module clock", "main.go": clockContract})
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+	diagnostics, err := deployer.CheckDeterminism(context.Background(), &DeployRequest{Path: src})
+	if err != nil {
+		t.Fatalf("CheckDeterminism() error = %v", err)
+	}
+	if len(diagnostics) != 1 || diagnostics[0].File != "main.go" || diagnostics[0].Line != 14 || diagnostics[0].Function != "Clock.Tick" {
+		t.Errorf("Expected time.Now in Clock.Tick at main.go:14, got %+v", diagnostics)
+	}
+	if len(mockExec.GetCalls()) != 0 {
+		t.Errorf("Expected no commands, got %v", mockExec.GetCalls())
+	}
+
+	// Checking out a repository needs a folder name
+	_, err = deployer.CheckDeterminism(context.Background(), &DeployRequest{GitURL: "https://example.com/clock.git"})
+	if !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Errorf("Expected invalid configuration without a name, got %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+// core/pkg/chaincode/endorse.go
+package chaincode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/fabric/ledger/rwset/kvrwset"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// EndorseResult is what each endorser of a transaction would commit
+type EndorseResult struct {
+	TxID      string
+	Endorsers []*PeerResponse
+}
+
+// Version is the block and transaction that last wrote a key
+type Version struct {
+	BlockNum uint64
+	TxNum    uint64
+}
+
+// KeyRead is a key the chaincode read. Version is nil when the key was not
+// in the world state.
+type KeyRead struct {
+	Key     string
+	Version *Version
+}
+
+// KeyWrite is a key the chaincode wrote or deleted
+type KeyWrite struct {
+	Key      string
+	Value    []byte
+	IsDelete bool
+}
+
+// RangeQuery is a range the chaincode iterated. Peers summarize large
+// results as Merkle hashes instead of listing Reads.
+type RangeQuery struct {
+	StartKey     string
+	EndKey       string
+	Exhausted    bool
+	Reads        []*KeyRead
+	MerkleHashes [][]byte
+}
+
+// HashedRead is a private data key read, known to endorsers only by hash
+type HashedRead struct {
+	KeyHash []byte
+	Version *Version
+}
+
+// HashedWrite is a private data key written, known only by hashes
+type HashedWrite struct {
+	KeyHash   []byte
+	ValueHash []byte
+	IsDelete  bool
+	IsPurge   bool
+}
+
+// CollectionRWSet is what the chaincode did in a private data collection
+type CollectionRWSet struct {
+	Collection string
+	Reads      []*HashedRead
+	Writes     []*HashedWrite
+}
+
+// NamespaceRWSet is what the chaincode did in one namespace, its own or
+// one it called
+type NamespaceRWSet struct {
+	Namespace    string
+	Reads        []*KeyRead
+	Writes       []*KeyWrite
+	RangeQueries []*RangeQuery
+	Collections  []*CollectionRWSet
+}
+
+// ChaincodeEvent is the event a transaction set
+type ChaincodeEvent struct {
+	Name    string
+	Payload []byte
+}
+
+// Endorse sends a transaction proposal to the endorsers selected as for
+// Submit and returns what each would commit, without ordering it. Peers
+// that cannot be reached are reported with an error.
+func (inv *Invoker) Endorse(ctx context.Context, req *InvokeRequest) (*EndorseResult, error) {
+	round, err := inv.endorse(ctx, "Endorse", req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &EndorseResult{TxID: round.prop.txID}
+	for _, e := range round.endorsements {
+		result.Endorsers = append(result.Endorsers, toPeerResponse(e))
+	}
+	return result, nil
+}
+
+// endorsed is a signed proposal and the responses of its endorsers
+type endorsed struct {
+	signer       *network.Signer
+	id           *signingIdentity
+	prop         *proposal
+	endorsements []*endorsement
+}
+
+// endorse signs a proposal for the request and sends it to each selected
+// endorser directly rather than through a gateway, which would only return
+// the response all endorsers agreed on. It fails when no endorser
+// responded.
+func (inv *Invoker) endorse(ctx context.Context, op string, req *InvokeRequest) (*endorsed, error) {
+	// Check context
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrap(op, err)
+	}
+
+	signer, err := inv.network.ResolveIdentity(req.Org, req.Identity)
+	if err != nil {
+		return nil, errors.Wrap(op, err)
+	}
+	id, err := loadSigningIdentity(inv.network, signer)
+	if err != nil {
+		return nil, errors.Wrap(op, err)
+	}
+	endorsers, err := inv.selectEndorsers(ctx, nil, signer, id, req)
+	if err != nil {
+		return nil, errors.Wrap(op, err)
+	}
+
+	prop, err := newProposal(id, inv.network.Channel.Name, req)
+	if err != nil {
+		return nil, errors.WrapWithContext(op, errors.ErrTransactionFailed, map[string]interface{}{
+			"chaincode": req.Chaincode,
+			"function":  req.Function,
+			"error":     err.Error(),
+		})
+	}
+
+	endorsements := inv.processProposal(ctx, endorsers, prop)
+	failed := []error{}
+	for _, e := range endorsements {
+		if e.err == nil {
+			return &endorsed{signer: signer, id: id, prop: prop, endorsements: endorsements}, nil
+		}
+		failed = append(failed, fmt.Errorf("%s: %v", e.peer.Name, e.err))
+	}
+	return nil, errors.WrapWithContext(op, errors.ErrTransactionFailed, map[string]interface{}{
+		"chaincode": req.Chaincode,
+		"function":  req.Function,
+		"error":     errors.Join(failed...).Error(),
+	})
+}
+
+// toPeerResponse converts one peer's endorsement
+func toPeerResponse(e *endorsement) *PeerResponse {
+	result := &PeerResponse{Peer: e.peer.Name, Org: e.org.Name}
+	if e.err != nil {
+		result.Error = e.err.Error()
+		return result
+	}
+
+	result.Status = e.response.GetResponse().GetStatus()
+	result.Message = e.response.GetResponse().GetMessage()
+	result.Payload = e.response.GetResponse().GetPayload()
+	if e.action == nil {
+		return result
+	}
+	if event := e.action.event; event != nil {
+		result.Event = &ChaincodeEvent{Name: event.EventName, Payload: event.Payload}
+	}
+	for _, set := range e.action.rwsets {
+		result.RWSets = append(result.RWSets, toNamespaceRWSet(set))
+	}
+	return result
+}
+
+func toNamespaceRWSet(set *nsRWSet) *NamespaceRWSet {
+	ns := &NamespaceRWSet{Namespace: set.namespace}
+	for _, read := range set.kv.GetReads() {
+		ns.Reads = append(ns.Reads, &KeyRead{Key: read.Key, Version: toVersion(read.Version)})
+	}
+	for _, write := range set.kv.GetWrites() {
+		ns.Writes = append(ns.Writes, &KeyWrite{Key: write.Key, Value: write.Value, IsDelete: write.IsDelete})
+	}
+	for _, query := range set.kv.GetRangeQueriesInfo() {
+		rq := &RangeQuery{StartKey: query.StartKey, EndKey: query.EndKey, Exhausted: query.ItrExhausted}
+		for _, read := range query.GetRawReads().GetKvReads() {
+			rq.Reads = append(rq.Reads, &KeyRead{Key: read.Key, Version: toVersion(read.Version)})
+		}
+		rq.MerkleHashes = query.GetReadsMerkleHashes().GetMaxLevelHashes()
+		ns.RangeQueries = append(ns.RangeQueries, rq)
+	}
+
+	for _, coll := range set.collections {
+		private := &CollectionRWSet{Collection: coll.name}
+		for _, read := range coll.hashed.GetHashedReads() {
+			private.Reads = append(private.Reads, &HashedRead{KeyHash: read.KeyHash, Version: toVersion(read.Version)})
+		}
+		for _, write := range coll.hashed.GetHashedWrites() {
+			private.Writes = append(private.Writes, &HashedWrite{
+				KeyHash:   write.KeyHash,
+				ValueHash: write.ValueHash,
+				IsDelete:  write.IsDelete,
+				IsPurge:   write.IsPurge,
+			})
+		}
+		ns.Collections = append(ns.Collections, private)
+	}
+	return ns
+}
+
+func toVersion(version *kvrwset.Version) *Version {
+	if version == nil {
+		return nil
+	}
+	return &Version{BlockNum: version.BlockNum, TxNum: version.TxNum}
+}
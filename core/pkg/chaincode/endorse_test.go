@@ -0,0 +1,121 @@
+// core/pkg/chaincode/endorse_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"os"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/fabric/ledger/rwset/kvrwset"
+)
+
+func TestEndorse(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+	writeSigningIdentity(t, net, net.Orgs[0], "User1")
+
+	mockExec := executor.NewMockExecutor()
+	inv := NewInvoker(net, mockExec)
+	serveEndorsers(t, inv, map[string]*fakeEndorser{
+		"peer0.org1.example.com": respondWith(t, simulation{
+			payload:  "ok",
+			reads:    map[string]*kvrwset.Version{"asset1": {BlockNum: 5, TxNum: 2}, "asset2": nil},
+			writes:   map[string]string{"asset1": "v2"},
+			private:  map[string]string{"prices": "h"},
+			event:    "Updated",
+			rangeEnd: "asset3",
+		}),
+		"peer0.org2.example.com": respondWith(t, simulation{}),
+	})
+
+	result, err := inv.Endorse(context.Background(), &InvokeRequest{
+		Chaincode:      "mycc",
+		Function:       "CreateAsset",
+		Args:           []string{"asset1"},
+		Identity:       "User1",
+		EndorsingPeers: []string{"peer0.org1.example.com"},
+	})
+	if err != nil {
+		t.Fatalf("Endorse() error = %v", err)
+	}
+	if len(mockExec.GetCalls()) != 0 {
+		t.Errorf("Expected nothing submitted, got %v", mockExec.GetCalls())
+	}
+	if result.TxID == "" {
+		t.Error("Expected a transaction ID")
+	}
+
+	// Only the requested peer endorses
+	if len(result.Endorsers) != 1 {
+		t.Fatalf("Expected 1 endorser, got %d", len(result.Endorsers))
+	}
+	got := result.Endorsers[0]
+	if got.Peer != "peer0.org1.example.com" || got.Org != "Org1" || got.Status != 200 || string(got.Payload) != "ok" {
+		t.Errorf("Unexpected response %+v", got)
+	}
+	if got.Event == nil || got.Event.Name != "Updated" {
+		t.Errorf("Event = %+v, want Updated", got.Event)
+	}
+	if len(got.RWSets) != 1 || got.RWSets[0].Namespace != "mycc" {
+		t.Fatalf("Unexpected read-write sets %+v", got.RWSets)
+	}
+
+	set := got.RWSets[0]
+	versions := map[string]*Version{}
+	for _, read := range set.Reads {
+		versions[read.Key] = read.Version
+	}
+	if v := versions["asset1"]; v == nil || v.BlockNum != 5 || v.TxNum != 2 {
+		t.Errorf("asset1 read at %+v, want 5:2", v)
+	}
+	if v, ok := versions["asset2"]; !ok || v != nil {
+		t.Errorf("Expected asset2 read without a version, got %+v", v)
+	}
+	if len(set.Writes) != 1 || set.Writes[0].Key != "asset1" || string(set.Writes[0].Value) != "v2" {
+		t.Errorf("Unexpected writes %+v", set.Writes)
+	}
+	if len(set.RangeQueries) != 1 || !set.RangeQueries[0].Exhausted || len(set.RangeQueries[0].Reads) != 1 || set.RangeQueries[0].Reads[0].Key != "asset3" {
+		t.Errorf("Unexpected range queries %+v", set.RangeQueries)
+	}
+	if len(set.Collections) != 1 || set.Collections[0].Collection != "prices" ||
+		len(set.Collections[0].Writes) != 1 || string(set.Collections[0].Writes[0].ValueHash) != "h" {
+		t.Errorf("Unexpected private data %+v", set.Collections)
+	}
+}
+
+func TestEndorseChaincodeError(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+	writeSigningIdentity(t, net, net.Orgs[0], "User1")
+
+	inv := NewInvoker(net, executor.NewMockExecutor())
+	serveEndorsers(t, inv, map[string]*fakeEndorser{
+		"peer0.org1.example.com": respondWith(t, simulation{status: 500, message: "asset asset1 already exists"}),
+	})
+
+	// A failing chaincode is a response, an unreachable peer an error
+	result, err := inv.Endorse(context.Background(), &InvokeRequest{Chaincode: "mycc", Function: "CreateAsset", Identity: "User1"})
+	if err != nil {
+		t.Fatalf("Endorse() error = %v", err)
+	}
+	for _, p := range result.Endorsers {
+		switch p.Peer {
+		case "peer0.org1.example.com":
+			if p.Status != 500 || p.Message != "asset asset1 already exists" || p.RWSets != nil {
+				t.Errorf("Unexpected response %+v", p)
+			}
+		default:
+			if p.Error == "" {
+				t.Errorf("%s: expected an error", p.Peer)
+			}
+		}
+	}
+
+	serveEndorsers(t, inv, map[string]*fakeEndorser{})
+	if _, err := inv.Endorse(context.Background(), &InvokeRequest{Chaincode: "mycc", Function: "CreateAsset", Identity: "User1"}); !stdErr.Is(err, errors.ErrTransactionFailed) {
+		t.Errorf("Expected transaction failure without endorsers, got %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+// core/pkg/chaincode/endorsers.go
+package chaincode
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// channelPolicyPrefix prefixes channel config policy references that
+// resolve to the application group of the channel
+const channelPolicyPrefix = "/Channel/Application/"
+
+// endorser is a peer asked to endorse a transaction
+type endorser struct {
+	org  *network.Organization
+	peer *network.Peer
+}
+
+// selectEndorsers picks the peers that endorse a transaction. Explicit
+// peers or orgs win; AutoEndorse picks the smallest set satisfying the
+// committed endorsement policy, read through the gateway on conn or on a
+// new connection when conn is nil. Otherwise every peer of the candidate
+// orgs endorses.
+func (inv *Invoker) selectEndorsers(ctx context.Context, conn *grpc.ClientConn, signer *network.Signer, id *signingIdentity, req *InvokeRequest) ([]endorser, error) {
+	if len(req.EndorsingPeers) > 0 {
+		return inv.namedPeers(req.EndorsingPeers)
+	}
+
+	if len(req.EndorsingOrgs) > 0 {
+		endorsers := []endorser{}
+		for _, name := range req.EndorsingOrgs {
+			org, err := inv.network.GetOrg(name)
+			if err != nil {
+				return nil, err
+			}
+			endorsers = append(endorsers, endorser{org: org, peer: org.Peers[0]})
+		}
+		return endorsers, nil
+	}
+
+	orgs, err := inv.endorsingOrgs(signer, req)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []endorser{}
+	for _, org := range orgs {
+		for _, peer := range org.Peers {
+			candidates = append(candidates, endorser{org: org, peer: peer})
+		}
+	}
+
+	if !req.AutoEndorse {
+		return candidates, nil
+	}
+
+	if conn == nil {
+		if conn, err = inv.connect(signer); err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+	}
+	policy, err := inv.committedPolicy(ctx, gateway.NewGatewayClient(conn), id, req.Chaincode)
+	if err != nil {
+		return nil, err
+	}
+	return minimalEndorsers(policy, candidates)
+}
+
+// endorsingOrganizations returns the MSP IDs of the orgs a gateway must
+// collect endorsements from, in endorser order. The gateway endorses on one
+// peer per org, so it is left to plan from the endorsement policy itself,
+// with nil, when the selected endorsers include several peers of an org.
+func endorsingOrganizations(endorsers []endorser, req *InvokeRequest) []string {
+	mspIDs := []string{}
+	seen := map[string]bool{}
+	for _, e := range endorsers {
+		if seen[e.org.MSPID] {
+			if req.AutoEndorse {
+				return nil
+			}
+			continue
+		}
+		seen[e.org.MSPID] = true
+		mspIDs = append(mspIDs, e.org.MSPID)
+	}
+	return mspIDs
+}
+
+// namedPeers looks up peers by name across all orgs
+func (inv *Invoker) namedPeers(names []string) ([]endorser, error) {
+	endorsers := []endorser{}
+	for _, name := range names {
+		var found *endorser
+		for _, org := range inv.network.Orgs {
+			for _, peer := range org.Peers {
+				if peer.Name == name {
+					found = &endorser{org: org, peer: peer}
+				}
+			}
+		}
+		if found == nil {
+			return nil, errors.WrapWithContext("selectEndorsers", errors.ErrInvalidConfig, map[string]interface{}{
+				"peer":   name,
+				"reason": "no peer with this name in the network",
+			})
+		}
+		endorsers = append(endorsers, *found)
+	}
+	return endorsers, nil
+}
+
+// committedPolicy returns the endorsement policy of the committed chaincode
+// definition, resolving channel config policy references
+func (inv *Invoker) committedPolicy(ctx context.Context, client gateway.GatewayClient, id *signingIdentity, name string) (*Policy, error) {
+	def, err := inv.queryDefinition(ctx, client, id, name)
+	if err != nil {
+		return nil, err
+	}
+	if def == nil {
+		return nil, errors.WrapWithContext("committedPolicy", errors.ErrChaincodeNotFound, map[string]interface{}{
+			"chaincode": name,
+			"channel":   inv.network.Channel.Name,
+		})
+	}
+
+	policy, ref, err := def.EndorsementPolicy()
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil {
+		return policy, nil
+	}
+	return channelPolicy(inv.network, ref)
+}
+
+// channelPolicy expands a channel config policy reference such as
+// /Channel/Application/Endorsement into a signature policy over the orgs
+// of the default channel
+func channelPolicy(net *network.Network, ref string) (*Policy, error) {
+	name := strings.TrimPrefix(ref, channelPolicyPrefix)
+	if name != "Endorsement" && name != "LifecycleEndorsement" {
+		return nil, errors.WrapWithContext("channelPolicy", errors.ErrInvalidConfig, map[string]interface{}{
+			"policy": ref,
+			"reason": "only the Endorsement and LifecycleEndorsement channel policies can be resolved",
+		})
+	}
+
+	// Both default to MAJORITY Endorsement in configtx.yaml
+	rule := network.Policy{Type: "ImplicitMeta", Rule: "MAJORITY Endorsement"}
+	if override, ok := net.Channel.Policies[name]; ok {
+		rule = override
+	}
+
+	if rule.Type == "Signature" {
+		return ParsePolicy(rule.Rule)
+	}
+
+	fields := strings.Fields(rule.Rule)
+	if len(fields) != 2 || fields[1] != "Endorsement" {
+		return nil, errors.WrapWithContext("channelPolicy", errors.ErrInvalidConfig, map[string]interface{}{
+			"policy": ref,
+			"rule":   rule.Rule,
+			"reason": "only ImplicitMeta rules over the org Endorsement policies can be resolved",
+		})
+	}
+
+	orgs := net.ChannelOrgs(net.Channel)
+	policy := &Policy{}
+	for _, org := range orgs {
+		orgPolicy, err := orgEndorsementPolicy(org)
+		if err != nil {
+			return nil, err
+		}
+		policy.Rules = append(policy.Rules, orgPolicy)
+	}
+
+	switch strings.ToUpper(fields[0]) {
+	case "ANY":
+		policy.N = 1
+	case "ALL":
+		policy.N = len(orgs)
+	case "MAJORITY":
+		policy.N = len(orgs)/2 + 1
+	default:
+		return nil, errors.WrapWithContext("channelPolicy", errors.ErrInvalidConfig, map[string]interface{}{
+			"policy": ref,
+			"rule":   rule.Rule,
+			"reason": fmt.Sprintf("unknown ImplicitMeta quantifier %s", fields[0]),
+		})
+	}
+	return policy, nil
+}
+
+// orgEndorsementPolicy returns the Endorsement policy of an org, which
+// defaults to any of its peers
+func orgEndorsementPolicy(org *network.Organization) (*Policy, error) {
+	if override, ok := org.Policies["Endorsement"]; ok && override.Type == "Signature" {
+		return ParsePolicy(override.Rule)
+	}
+	return &Policy{N: 1, Rules: []*Policy{{MSPID: org.MSPID, Role: RolePeer}}}, nil
+}
+
+// minimalEndorsers returns the smallest subset of candidates, in candidate
+// order, whose peer signatures satisfy the policy
+func minimalEndorsers(policy *Policy, candidates []endorser) ([]endorser, error) {
+	for size := 1; size <= len(candidates); size++ {
+		if chosen := satisfyingSubset(policy, candidates, size); chosen != nil {
+			return chosen, nil
+		}
+	}
+
+	return nil, errors.WrapWithContext("selectEndorsers", errors.ErrInvalidConfig, map[string]interface{}{
+		"policy": policy.String(),
+		"reason": "no set of peers can satisfy the endorsement policy",
+	})
+}
+
+// satisfyingSubset tries every combination of size candidates in order
+func satisfyingSubset(policy *Policy, candidates []endorser, size int) []endorser {
+	indexes := make([]int, size)
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	for {
+		mspIDs := make([]string, size)
+		for i, idx := range indexes {
+			mspIDs[i] = candidates[idx].org.MSPID
+		}
+		if policy.satisfiedBy(mspIDs, make([]bool, size)) {
+			chosen := make([]endorser, size)
+			for i, idx := range indexes {
+				chosen[i] = candidates[idx]
+			}
+			return chosen
+		}
+
+		// Advance to the next combination
+		i := size - 1
+		for i >= 0 && indexes[i] == len(candidates)-size+i {
+			i--
+		}
+		if i < 0 {
+			return nil
+		}
+		indexes[i]++
+		for j := i + 1; j < size; j++ {
+			indexes[j] = indexes[j-1] + 1
+		}
+	}
+}
+
+// satisfiedBy evaluates the policy against peer signatures from the given
+// MSPs the way Fabric does: each signature counts towards one principal
+// only, and a peer signature satisfies the member and peer roles.
+func (p *Policy) satisfiedBy(mspIDs []string, used []bool) bool {
+	if p.IsPrincipal() {
+		if p.Role != RoleMember && p.Role != RolePeer {
+			return false
+		}
+		for i, id := range mspIDs {
+			if !used[i] && id == p.MSPID {
+				used[i] = true
+				return true
+			}
+		}
+		return false
+	}
+
+	verified := 0
+	for _, rule := range p.Rules {
+		attempt := make([]bool, len(used))
+		copy(attempt, used)
+		if rule.satisfiedBy(mspIDs, attempt) {
+			verified++
+			copy(used, attempt)
+		}
+	}
+	return verified >= p.N
+}
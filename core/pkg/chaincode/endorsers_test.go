@@ -0,0 +1,286 @@
+// core/pkg/chaincode/endorsers_test.go
+package chaincode
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/fabric/peer"
+	"github.com/temmyjay001/core/pkg/fabric/peer/lifecycle"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// createEndorsementNetwork adds a second Org1 peer and a third org to the
+// mock network
+func createEndorsementNetwork() *network.Network {
+	net := createMockNetwork()
+	net.Orgs[0].Peers = append(net.Orgs[0].Peers, &network.Peer{Name: "peer1.org1.example.com", Port: 7151})
+	net.Orgs = append(net.Orgs, &network.Organization{
+		Name:   "Org3",
+		MSPID:  "Org3MSP",
+		Domain: "org3.example.com",
+		Peers:  []*network.Peer{{Name: "peer0.org3.example.com", Port: 9051}},
+	})
+	return net
+}
+
+// encodeSignaturePolicy builds a base64 ApplicationPolicy for a parsed
+// signature policy, as returned by querycommitted
+func encodeSignaturePolicy(t *testing.T, expr string) string {
+	policy, err := ParsePolicy(expr)
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	var identities [][]byte
+	index := map[string]uint64{}
+	roles := map[string]uint64{RoleMember: 0, RoleAdmin: 1, RoleClient: 2, RolePeer: 3, RoleOrderer: 4}
+
+	var encodeRule func(*Policy) []byte
+	encodeRule = func(p *Policy) []byte {
+		var b []byte
+		if p.IsPrincipal() {
+			key := p.MSPID + "." + p.Role
+			if _, ok := index[key]; !ok {
+				var role []byte
+				role = protowire.AppendTag(role, 1, protowire.BytesType)
+				role = protowire.AppendString(role, p.MSPID)
+				role = protowire.AppendTag(role, 2, protowire.VarintType)
+				role = protowire.AppendVarint(role, roles[p.Role])
+
+				var principal []byte
+				principal = protowire.AppendTag(principal, 2, protowire.BytesType)
+				principal = protowire.AppendBytes(principal, role)
+
+				index[key] = uint64(len(identities))
+				identities = append(identities, principal)
+			}
+			b = protowire.AppendTag(b, 1, protowire.VarintType)
+			return protowire.AppendVarint(b, index[key])
+		}
+
+		var nOutOf []byte
+		nOutOf = protowire.AppendTag(nOutOf, 1, protowire.VarintType)
+		nOutOf = protowire.AppendVarint(nOutOf, uint64(p.N))
+		for _, rule := range p.Rules {
+			nOutOf = protowire.AppendTag(nOutOf, 2, protowire.BytesType)
+			nOutOf = protowire.AppendBytes(nOutOf, encodeRule(rule))
+		}
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		return protowire.AppendBytes(b, nOutOf)
+	}
+
+	var envelope []byte
+	envelope = protowire.AppendTag(envelope, 2, protowire.BytesType)
+	envelope = protowire.AppendBytes(envelope, encodeRule(policy))
+	for _, identity := range identities {
+		envelope = protowire.AppendTag(envelope, 3, protowire.BytesType)
+		envelope = protowire.AppendBytes(envelope, identity)
+	}
+
+	var app []byte
+	app = protowire.AppendTag(app, 1, protowire.BytesType)
+	app = protowire.AppendBytes(app, envelope)
+	return base64.StdEncoding.EncodeToString(app)
+}
+
+// encodeChannelPolicy builds a base64 ApplicationPolicy referencing a
+// channel config policy
+func encodeChannelPolicy(ref string) string {
+	var app []byte
+	app = protowire.AppendTag(app, 2, protowire.BytesType)
+	app = protowire.AppendString(app, ref)
+	return base64.StdEncoding.EncodeToString(app)
+}
+
+func TestDecodeApplicationPolicy(t *testing.T) {
+	def := &CommittedDefinition{
+		Name:                "mycc",
+		ValidationParameter: encodeSignaturePolicy(t, "AND('Org1MSP.peer', OutOf(1, 'Org2MSP.member', 'Org3MSP.admin'))"),
+	}
+
+	policy, ref, err := def.EndorsementPolicy()
+	if err != nil {
+		t.Fatalf("EndorsementPolicy() error = %v", err)
+	}
+	if ref != "" {
+		t.Errorf("Expected no channel policy reference, got %s", ref)
+	}
+
+	want := "AND('Org1MSP.peer',OR('Org2MSP.member','Org3MSP.admin'))"
+	if policy.String() != want {
+		t.Errorf("Expected %s, got %s", want, policy.String())
+	}
+
+	def.ValidationParameter = encodeChannelPolicy("/Channel/Application/Endorsement")
+	policy, ref, err = def.EndorsementPolicy()
+	if err != nil {
+		t.Fatalf("EndorsementPolicy() error = %v", err)
+	}
+	if policy != nil || ref != "/Channel/Application/Endorsement" {
+		t.Errorf("Expected channel policy reference, got %v %q", policy, ref)
+	}
+
+	def.ValidationParameter = "not base64!"
+	if _, _, err := def.EndorsementPolicy(); err == nil {
+		t.Error("Expected error for an invalid validation parameter")
+	}
+}
+
+func TestSubmitEndorsers(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      InvokeRequest
+		policy   string
+		channel  map[string]network.Policy
+		wantOrgs []string
+		wantErr  bool
+	}{
+		{
+			name:     "all orgs by default",
+			wantOrgs: []string{"Org1MSP", "Org2MSP", "Org3MSP"},
+		},
+		{
+			name:     "explicit peers",
+			req:      InvokeRequest{EndorsingPeers: []string{"peer1.org1.example.com", "peer0.org3.example.com"}},
+			wantOrgs: []string{"Org1MSP", "Org3MSP"},
+		},
+		{
+			name:    "unknown peer",
+			req:     InvokeRequest{EndorsingPeers: []string{"peer9.org1.example.com"}},
+			wantErr: true,
+		},
+		{
+			name:     "explicit orgs",
+			req:      InvokeRequest{EndorsingOrgs: []string{"Org1", "Org3MSP"}},
+			wantOrgs: []string{"Org1MSP", "Org3MSP"},
+		},
+		{
+			name:    "unknown org",
+			req:     InvokeRequest{EndorsingOrgs: []string{"Org9"}},
+			wantErr: true,
+		},
+		{
+			name:     "auto with signature policy",
+			req:      InvokeRequest{AutoEndorse: true},
+			policy:   encodeSignaturePolicy(t, "AND('Org2MSP.peer', OR('Org1MSP.member', 'Org3MSP.member'))"),
+			wantOrgs: []string{"Org1MSP", "Org2MSP"},
+		},
+		{
+			name:     "auto needs distinct peers of one org",
+			req:      InvokeRequest{AutoEndorse: true},
+			policy:   encodeSignaturePolicy(t, "OutOf(2, 'Org1MSP.peer', 'Org1MSP.peer', 'Org3MSP.admin')"),
+			wantOrgs: []string{},
+		},
+		{
+			name:     "auto with channel majority",
+			req:      InvokeRequest{AutoEndorse: true},
+			policy:   encodeChannelPolicy("/Channel/Application/Endorsement"),
+			wantOrgs: []string{"Org1MSP", "Org2MSP"},
+		},
+		{
+			name:     "auto with overridden channel policy",
+			req:      InvokeRequest{AutoEndorse: true},
+			policy:   encodeChannelPolicy("/Channel/Application/Endorsement"),
+			channel:  map[string]network.Policy{"Endorsement": {Type: "ImplicitMeta", Rule: "ANY Endorsement"}},
+			wantOrgs: []string{"Org1MSP"},
+		},
+		{
+			name:    "auto with unsatisfiable policy",
+			req:     InvokeRequest{AutoEndorse: true},
+			policy:  encodeSignaturePolicy(t, "AND('Org1MSP.admin', 'Org2MSP.peer')"),
+			wantErr: true,
+		},
+		{
+			name:    "auto with chaincode not committed",
+			req:     InvokeRequest{AutoEndorse: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createEndorsementNetwork()
+			defer os.RemoveAll(net.BasePath)
+			net.Channel.Policies = tt.channel
+			writeSigningIdentity(t, net, net.Orgs[0], "Admin")
+
+			gw := &fakeGateway{evaluate: func(req *gateway.EvaluateRequest) (*gateway.EvaluateResponse, error) {
+				return queryDefinitionResponse(t, req, tt.policy)
+			}}
+			invoker := NewInvoker(net, executor.NewMockExecutor())
+			servePeers(t, invoker, nil, map[string]*fakeGateway{"peer0.org1.example.com": gw})
+
+			req := tt.req
+			req.Chaincode = "mycc"
+			req.Function = "Transfer"
+
+			_, err := invoker.Submit(context.Background(), &req)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Submit() error = %v", err)
+			}
+
+			if orgs := gw.endorsed[0].EndorsingOrganizations; strings.Join(orgs, ",") != strings.Join(tt.wantOrgs, ",") {
+				t.Errorf("Expected endorsing orgs %v, got %v", tt.wantOrgs, orgs)
+			}
+			if tt.req.AutoEndorse != (len(gw.evaluated) == 1) {
+				t.Errorf("Expected the committed definition read only for AutoEndorse, got %d evaluations", len(gw.evaluated))
+			}
+		})
+	}
+}
+
+// queryDefinitionResponse answers a _lifecycle QueryChaincodeDefinition of
+// mycc with a base64 validation parameter, or like a peer where mycc was
+// never committed when it is empty
+func queryDefinitionResponse(t *testing.T, req *gateway.EvaluateRequest, policy string) (*gateway.EvaluateResponse, error) {
+	prop := &peer.Proposal{}
+	payload := &peer.ChaincodeProposalPayload{}
+	spec := &peer.ChaincodeInvocationSpec{}
+	args := &lifecycle.QueryChaincodeDefinitionArgs{}
+	if err := proto.Unmarshal(req.ProposedTransaction.ProposalBytes, prop); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(prop.Payload, payload); err != nil {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(payload.Input, spec); err != nil {
+		t.Fatal(err)
+	}
+	input := spec.ChaincodeSpec.Input.Args
+	if spec.ChaincodeSpec.ChaincodeId.Name != "_lifecycle" || len(input) != 2 || string(input[0]) != "QueryChaincodeDefinition" {
+		t.Fatalf("Unexpected evaluation %v", spec)
+	}
+	if err := proto.Unmarshal(input[1], args); err != nil || args.Name != "mycc" {
+		t.Fatalf("Unexpected QueryChaincodeDefinition arguments %v", args)
+	}
+
+	if policy == "" {
+		return nil, status.Error(codes.Unknown, "evaluate call to endorser returned error: chaincode response 500, namespace mycc is not defined")
+	}
+	parameter, err := base64.StdEncoding.DecodeString(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result, err := proto.Marshal(&lifecycle.QueryChaincodeDefinitionResult{Sequence: 1, Version: "1.0", ValidationParameter: parameter})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &gateway.EvaluateResponse{Result: &peer.Response{Status: 200, Payload: result}}, nil
+}
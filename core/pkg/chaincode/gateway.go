@@ -0,0 +1,219 @@
+// core/pkg/chaincode/gateway.go
+package chaincode
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/fabric/common"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/fabric/msp"
+	"github.com/temmyjay001/core/pkg/fabric/peer"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// endorseTransaction asks a gateway to collect endorsements of a proposal
+// from the given orgs, or from the orgs the gateway picks from the
+// endorsement policy when none are given. The prepared transaction it
+// returns is not signed yet.
+func (inv *Invoker) endorseTransaction(ctx context.Context, client gateway.GatewayClient, prop *proposal, orgs []string) (*common.Envelope, error) {
+	resp, err := client.Endorse(ctx, &gateway.EndorseRequest{
+		TransactionId:          prop.txID,
+		ChannelId:              inv.network.Channel.Name,
+		ProposedTransaction:    prop.signed,
+		EndorsingOrganizations: orgs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PreparedTransaction, nil
+}
+
+// commit signs a prepared transaction, sends it to the orderer through a
+// gateway and waits for the gateway's peer to commit it
+func (inv *Invoker) commit(ctx context.Context, client gateway.GatewayClient, id *signingIdentity, txID string, prepared *common.Envelope) (*gateway.CommitStatusResponse, error) {
+	signature, err := id.sign(prepared.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := inv.network.Channel.Name
+	if _, err := client.Submit(ctx, &gateway.SubmitRequest{
+		TransactionId:       txID,
+		ChannelId:           channel,
+		PreparedTransaction: &common.Envelope{Payload: prepared.Payload, Signature: signature},
+	}); err != nil {
+		return nil, err
+	}
+
+	creator, err := id.creator()
+	if err != nil {
+		return nil, err
+	}
+	request, err := proto.Marshal(&gateway.CommitStatusRequest{TransactionId: txID, ChannelId: channel, Identity: creator})
+	if err != nil {
+		return nil, err
+	}
+	signature, err = id.sign(request)
+	if err != nil {
+		return nil, err
+	}
+	return client.CommitStatus(ctx, &gateway.SignedCommitStatusRequest{Request: request, Signature: signature})
+}
+
+// evaluate runs a proposal on a peer of the signer's org through a
+// gateway, without ordering it
+func (inv *Invoker) evaluate(ctx context.Context, client gateway.GatewayClient, id *signingIdentity, prop *proposal) (*peer.Response, error) {
+	resp, err := client.Evaluate(ctx, &gateway.EvaluateRequest{
+		TransactionId:       prop.txID,
+		ChannelId:           inv.network.Channel.Name,
+		ProposedTransaction: prop.signed,
+		TargetOrganizations: []string{id.mspID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Result, nil
+}
+
+// connect opens a connection to the gateway of the signer's first peer
+func (inv *Invoker) connect(signer *network.Signer) (*grpc.ClientConn, error) {
+	return inv.dialPeer(signer.Org, signer.Org.Peers[0])
+}
+
+// gatewayError unwraps the peer and orderer errors a gateway reports in
+// the details of its gRPC status. Deadlines become timeouts.
+func gatewayError(op string, err error, fields map[string]interface{}) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		fields["error"] = err.Error()
+		return errors.WrapWithContext(op, errors.ErrTransactionFailed, fields)
+	}
+	fields["error"] = gatewayMessage(err)
+	fields["code"] = st.Code().String()
+
+	if st.Code() == codes.DeadlineExceeded {
+		return errors.WrapWithContext(op, errors.ErrTimeout, fields)
+	}
+	return errors.WrapWithContext(op, errors.ErrTransactionFailed, fields)
+}
+
+// endorseError tells why a gateway could not endorse a transaction. An
+// endorser's chaincode error status rejects it, and its status and message
+// are recorded in the result; endorsers that disagree make a mismatch.
+// Anything else, such as unreachable peers, is a gateway error.
+func endorseError(op string, err error, result *TransactionResult, fields map[string]interface{}) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return gatewayError(op, err, fields)
+	}
+
+	for _, detail := range st.Details() {
+		d, ok := detail.(*gateway.ErrorDetail)
+		if !ok {
+			continue
+		}
+		var code int32
+		if n, _ := fmt.Sscanf(d.Message, "chaincode response %d,", &code); n == 1 && code >= 400 {
+			result.Status = code
+			result.Message = strings.TrimPrefix(d.Message, fmt.Sprintf("chaincode response %d, ", code))
+			fields["error"] = gatewayMessage(err)
+			return errors.WrapWithContext(op, errors.ErrEndorsementRejected, fields)
+		}
+	}
+
+	// Fabric's message when endorsers signed different response payloads
+	if message := gatewayMessage(err); strings.Contains(message, "ProposalResponsePayloads do not match") {
+		fields["error"] = message
+		return errors.WrapWithContext(op, errors.ErrEndorsementMismatch, fields)
+	}
+	return gatewayError(op, err, fields)
+}
+
+// gatewayMessage joins the message of a gateway error with the peer and
+// orderer errors in its details
+func gatewayMessage(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err.Error()
+	}
+	message := st.Message()
+	for _, detail := range st.Details() {
+		if d, ok := detail.(*gateway.ErrorDetail); ok {
+			message += fmt.Sprintf("; %s (%s): %s", d.Address, d.MspId, d.Message)
+		}
+	}
+	return message
+}
+
+// preparedTransaction is what a prepared transaction says about how it
+// was endorsed
+type preparedTransaction struct {
+	response  *peer.Response
+	endorsers []string
+}
+
+// readPrepared decodes the chaincode response and the endorsers of a
+// transaction the gateway prepared. Endorsers are named by the common name
+// of their certificate, which is the peer name.
+func readPrepared(envelope *common.Envelope) (*preparedTransaction, error) {
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(envelope.GetPayload(), payload); err != nil {
+		return nil, err
+	}
+	tx := &peer.Transaction{}
+	if err := proto.Unmarshal(payload.Data, tx); err != nil {
+		return nil, err
+	}
+	if len(tx.Actions) == 0 {
+		return nil, fmt.Errorf("prepared transaction has no actions")
+	}
+	actionPayload := &peer.ChaincodeActionPayload{}
+	if err := proto.Unmarshal(tx.Actions[0].Payload, actionPayload); err != nil {
+		return nil, err
+	}
+	responsePayload := &peer.ProposalResponsePayload{}
+	if err := proto.Unmarshal(actionPayload.GetAction().GetProposalResponsePayload(), responsePayload); err != nil {
+		return nil, err
+	}
+	action := &peer.ChaincodeAction{}
+	if err := proto.Unmarshal(responsePayload.Extension, action); err != nil {
+		return nil, err
+	}
+
+	prepared := &preparedTransaction{response: action.Response}
+	for _, endorsement := range actionPayload.Action.Endorsements {
+		name, err := endorserName(endorsement.Endorser)
+		if err != nil {
+			return nil, err
+		}
+		prepared.endorsers = append(prepared.endorsers, name)
+	}
+	return prepared, nil
+}
+
+// endorserName returns the common name of a serialized peer identity
+func endorserName(serialized []byte) (string, error) {
+	id := &msp.SerializedIdentity{}
+	if err := proto.Unmarshal(serialized, id); err != nil {
+		return "", err
+	}
+	block, _ := pem.Decode(id.IdBytes)
+	if block == nil {
+		return "", fmt.Errorf("endorser certificate of %s is not PEM encoded", id.Mspid)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", err
+	}
+	return cert.Subject.CommonName, nil
+}
@@ -0,0 +1,281 @@
+// core/pkg/chaincode/gateway_test.go
+package chaincode
+
+import (
+	"context"
+	"encoding/pem"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/fabric/common"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/fabric/msp"
+	"github.com/temmyjay001/core/pkg/fabric/peer"
+)
+
+// fakeGateway endorses, orders and commits transactions like a peer's
+// gateway. Endorsements come from peer0 of each requested org, or of Org1
+// when the gateway is left to pick.
+type fakeGateway struct {
+	gateway.UnimplementedGatewayServer
+
+	mu        sync.Mutex
+	endorsed  []*gateway.EndorseRequest
+	submitted []*gateway.SubmitRequest
+	statuses  []*gateway.CommitStatusRequest
+	evaluated []*gateway.EvaluateRequest
+
+	endorsement *peer.ProposalResponse // What every endorser signed; a bare 200 when nil
+	endorseErr  error
+	submitErr   error
+	result      peer.TxValidationCode
+	block       uint64
+	evaluate    func(*gateway.EvaluateRequest) (*gateway.EvaluateResponse, error)
+}
+
+func (g *fakeGateway) Endorse(ctx context.Context, req *gateway.EndorseRequest) (*gateway.EndorseResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.endorsed = append(g.endorsed, req)
+	if g.endorseErr != nil {
+		return nil, g.endorseErr
+	}
+
+	peers := []string{}
+	for _, mspID := range req.EndorsingOrganizations {
+		peers = append(peers, fmt.Sprintf("peer0.%s.example.com", strings.ToLower(strings.TrimSuffix(mspID, "MSP"))))
+	}
+	if len(peers) == 0 {
+		peers = append(peers, "peer0.org1.example.com")
+	}
+	prepared, err := prepareTransaction(req.ProposedTransaction, g.endorsement, peers)
+	if err != nil {
+		return nil, err
+	}
+	return &gateway.EndorseResponse{PreparedTransaction: prepared}, nil
+}
+
+func (g *fakeGateway) Submit(ctx context.Context, req *gateway.SubmitRequest) (*gateway.SubmitResponse, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.submitted = append(g.submitted, req)
+	if g.submitErr != nil {
+		return nil, g.submitErr
+	}
+	return &gateway.SubmitResponse{}, nil
+}
+
+func (g *fakeGateway) CommitStatus(ctx context.Context, signed *gateway.SignedCommitStatusRequest) (*gateway.CommitStatusResponse, error) {
+	req := &gateway.CommitStatusRequest{}
+	if err := proto.Unmarshal(signed.Request, req); err != nil {
+		return nil, err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.statuses = append(g.statuses, req)
+	return &gateway.CommitStatusResponse{Result: g.result, BlockNumber: g.block}, nil
+}
+
+func (g *fakeGateway) Evaluate(ctx context.Context, req *gateway.EvaluateRequest) (*gateway.EvaluateResponse, error) {
+	g.mu.Lock()
+	g.evaluated = append(g.evaluated, req)
+	g.mu.Unlock()
+	return g.evaluate(req)
+}
+
+// prepareTransaction assembles the transaction the Fabric Gateway returns
+// for a proposal: the proposal without transient data, the response every
+// endorser signed and their endorsements, unsigned
+func prepareTransaction(signed *peer.SignedProposal, response *peer.ProposalResponse, endorsers []string) (*common.Envelope, error) {
+	proposed := &peer.Proposal{}
+	if err := proto.Unmarshal(signed.ProposalBytes, proposed); err != nil {
+		return nil, err
+	}
+	header := &common.Header{}
+	if err := proto.Unmarshal(proposed.Header, header); err != nil {
+		return nil, err
+	}
+	payload := &peer.ChaincodeProposalPayload{}
+	if err := proto.Unmarshal(proposed.Payload, payload); err != nil {
+		return nil, err
+	}
+	proposalPayload, err := proto.Marshal(&peer.ChaincodeProposalPayload{Input: payload.Input})
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil {
+		action, err := proto.Marshal(&peer.ChaincodeAction{Response: &peer.Response{Status: 200}})
+		if err != nil {
+			return nil, err
+		}
+		payload, err := proto.Marshal(&peer.ProposalResponsePayload{Extension: action})
+		if err != nil {
+			return nil, err
+		}
+		response = &peer.ProposalResponse{Payload: payload}
+	}
+
+	endorsements := []*peer.Endorsement{}
+	for _, name := range endorsers {
+		der, _, err := selfSigned(name)
+		if err != nil {
+			return nil, err
+		}
+		identity, err := proto.Marshal(&msp.SerializedIdentity{
+			Mspid:   "PeerMSP",
+			IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		})
+		if err != nil {
+			return nil, err
+		}
+		endorsements = append(endorsements, &peer.Endorsement{Endorser: identity, Signature: []byte("signature")})
+	}
+	actionPayload, err := proto.Marshal(&peer.ChaincodeActionPayload{
+		ChaincodeProposalPayload: proposalPayload,
+		Action: &peer.ChaincodeEndorsedAction{
+			ProposalResponsePayload: response.Payload,
+			Endorsements:            endorsements,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	tx, err := proto.Marshal(&peer.Transaction{
+		Actions: []*peer.TransactionAction{{Header: header.SignatureHeader, Payload: actionPayload}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	envelopePayload, err := proto.Marshal(&common.Payload{Header: header, Data: tx})
+	if err != nil {
+		return nil, err
+	}
+	return &common.Envelope{Payload: envelopePayload}, nil
+}
+
+// submittedTransaction decodes the only transaction a gateway received
+func submittedTransaction(t *testing.T, g *fakeGateway) (*common.Payload, *peer.ChaincodeActionPayload) {
+	t.Helper()
+	if len(g.submitted) != 1 {
+		t.Fatalf("Expected 1 submitted transaction, got %d", len(g.submitted))
+	}
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(g.submitted[0].PreparedTransaction.Payload, payload); err != nil {
+		t.Fatal(err)
+	}
+	tx := &peer.Transaction{}
+	if err := proto.Unmarshal(payload.Data, tx); err != nil {
+		t.Fatal(err)
+	}
+	action := &peer.ChaincodeActionPayload{}
+	if err := proto.Unmarshal(tx.Actions[0].Payload, action); err != nil {
+		t.Fatal(err)
+	}
+	return payload, action
+}
+
+func TestReadPrepared(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+	writeSigningIdentity(t, net, net.Orgs[0], "User1")
+
+	signer, err := net.ResolveIdentity("", "User1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := loadSigningIdentity(net, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prop, err := newProposal(id, "mychannel", &InvokeRequest{Chaincode: "mycc", Function: "CreateAsset", Args: []string{"asset1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sim := simulation{status: 201, message: "created", payload: "asset1\x00", writes: map[string]string{"asset1": "v1"}}
+	envelope, err := prepareTransaction(prop.signed, proposalResponse(t, sim), []string{"peer0.org1.example.com", "peer0.org2.example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prepared, err := readPrepared(envelope)
+	if err != nil {
+		t.Fatalf("readPrepared() error = %v", err)
+	}
+	if strings.Join(prepared.endorsers, ",") != "peer0.org1.example.com,peer0.org2.example.com" {
+		t.Errorf("readPrepared() endorsers = %v", prepared.endorsers)
+	}
+	resp := prepared.response
+	if resp.Status != 201 || resp.Message != "created" || string(resp.Payload) != "asset1\x00" {
+		t.Errorf("readPrepared() response = %v", resp)
+	}
+
+	if _, err := readPrepared(&common.Envelope{Payload: []byte("not a payload")}); err == nil {
+		t.Error("Expected error for an invalid prepared transaction")
+	}
+}
+
+func TestGatewayError(t *testing.T) {
+	detailed, err := status.New(codes.Aborted, "failed to endorse transaction").WithDetails(&gateway.ErrorDetail{
+		Address: "peer0.org2.example.com:8051",
+		MspId:   "Org2MSP",
+		Message: "chaincode response 500, asset asset1 does not exist",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		want    error
+		wantMsg string
+	}{
+		{
+			name:    "peer details",
+			err:     detailed.Err(),
+			want:    errors.ErrTransactionFailed,
+			wantMsg: "peer0.org2.example.com:8051 (Org2MSP): chaincode response 500, asset asset1 does not exist",
+		},
+		{
+			name:    "gRPC code",
+			err:     status.Error(codes.Unavailable, "connection refused"),
+			want:    errors.ErrTransactionFailed,
+			wantMsg: "code:Unavailable",
+		},
+		{
+			name:    "deadline",
+			err:     status.Error(codes.DeadlineExceeded, "context deadline exceeded"),
+			want:    errors.ErrTimeout,
+			wantMsg: "context deadline exceeded",
+		},
+		{
+			name:    "not a gRPC error",
+			err:     fmt.Errorf("connection refused"),
+			want:    errors.ErrTransactionFailed,
+			wantMsg: "connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := gatewayError("Invoke", tt.err, map[string]interface{}{"chaincode": "mycc"})
+			if !stdErr.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("Expected %q in %v", tt.wantMsg, err)
+			}
+		})
+	}
+}
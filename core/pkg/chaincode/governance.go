@@ -0,0 +1,225 @@
+// core/pkg/chaincode/governance.go
+package chaincode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// lifecyclePolicyRef is the channel policy a definition's approvals must
+// satisfy before it can be committed
+const lifecyclePolicyRef = "/Channel/Application/LifecycleEndorsement"
+
+// CommitReadiness tells whether a staged definition can be committed
+type CommitReadiness struct {
+	Name       string
+	Version    string
+	Sequence   int64
+	Approvals  map[string]bool   // MSP ID -> approved, from checkcommitreadiness
+	Rejections map[string]string // MSP ID -> reason for rejecting
+	Mismatched []string          // MSP IDs that approved a different definition at the sequence
+	Policy     string            // LifecycleEndorsement as a signature policy
+	Ready      bool              // Approvals satisfy the LifecycleEndorsement policy
+}
+
+// stagedDefinition returns the staged definition of a chaincode with the
+// request and values approve and commit take
+func (d *Deployer) stagedDefinition(name string) (*network.StagedDefinition, *DeployRequest, *definition, error) {
+	staged := d.network.StagedDefinition(name)
+	if staged == nil {
+		return nil, nil, nil, errors.WrapWithContext("stagedDefinition", errors.ErrChaincodeNotFound, map[string]interface{}{
+			"chaincode": name,
+			"reason":    "no staged definition, deploy or upgrade with stage first",
+		})
+	}
+
+	req := &DeployRequest{
+		Name:              staged.Name,
+		Version:           staged.Version,
+		CollectionsConfig: staged.CollectionsConfig,
+	}
+	def := &definition{
+		packageID:  staged.PackageID,
+		sequence:   staged.Sequence,
+		policyArgs: staged.PolicyArgs,
+	}
+	return staged, req, def, nil
+}
+
+// ApproveStaged approves the staged definition of a chaincode for one org
+func (d *Deployer) ApproveStaged(ctx context.Context, name, orgName string) error {
+	_, req, def, err := d.stagedDefinition(name)
+	if err != nil {
+		return errors.Wrap("ApproveStaged", err)
+	}
+
+	org, err := d.network.GetOrg(orgName)
+	if err != nil {
+		return errors.Wrap("ApproveStaged", err)
+	}
+
+	if err := d.approveChaincode(ctx, org, req, def); err != nil {
+		return errors.WrapWithContext("ApproveStaged", err, map[string]interface{}{
+			"org": org.Name,
+		})
+	}
+	return d.network.RecordVote(name, org.MSPID, true, "")
+}
+
+// RejectStaged records an org rejecting the staged definition of a
+// chaincode. Fabric has no rejection transaction; the org simply does not
+// approve, and the reason is reported with the commit readiness.
+func (d *Deployer) RejectStaged(name, orgName, reason string) error {
+	org, err := d.network.GetOrg(orgName)
+	if err != nil {
+		return errors.Wrap("RejectStaged", err)
+	}
+
+	if err := d.network.RecordVote(name, org.MSPID, false, reason); err != nil {
+		return errors.Wrap("RejectStaged", err)
+	}
+
+	fmt.Printf("🚫 %s rejected %s\n", org.Name, name)
+	return nil
+}
+
+// CommitReadiness checks which orgs approved the staged definition of a
+// chaincode and whether their approvals satisfy the LifecycleEndorsement
+// policy
+func (d *Deployer) CommitReadiness(ctx context.Context, name string) (*CommitReadiness, error) {
+	staged, req, def, err := d.stagedDefinition(name)
+	if err != nil {
+		return nil, errors.Wrap("CommitReadiness", err)
+	}
+
+	approvals, err := d.checkCommitReadiness(ctx, d.network.Channel, req, def)
+	if err != nil {
+		return nil, errors.Wrap("CommitReadiness", err)
+	}
+
+	policy, err := channelPolicy(d.network, lifecyclePolicyRef)
+	if err != nil {
+		return nil, errors.Wrap("CommitReadiness", err)
+	}
+
+	readiness := &CommitReadiness{
+		Name:       staged.Name,
+		Version:    staged.Version,
+		Sequence:   staged.Sequence,
+		Approvals:  approvals,
+		Rejections: staged.Rejected,
+		Mismatched: []string{},
+		Policy:     policy.String(),
+	}
+
+	// An org that approved something at this sequence, but not this
+	// definition, approved a different one
+	approving := []string{}
+	for _, org := range d.network.Orgs {
+		if approvals[org.MSPID] {
+			approving = append(approving, org.MSPID)
+			continue
+		}
+
+		approved, err := d.queryApproved(ctx, d.network.Channel, org, name, staged.Sequence)
+		if err != nil {
+			return nil, errors.WrapWithContext("CommitReadiness", err, map[string]interface{}{
+				"org": org.Name,
+			})
+		}
+		if approved != nil {
+			readiness.Mismatched = append(readiness.Mismatched, org.MSPID)
+		}
+	}
+	sort.Strings(readiness.Mismatched)
+
+	readiness.Ready = approvedBy(policy, approving)
+	return readiness, nil
+}
+
+// CommitStaged commits the staged definition of a chaincode once the
+// approvals satisfy the LifecycleEndorsement policy
+func (d *Deployer) CommitStaged(ctx context.Context, name string) (*DeployResult, error) {
+	readiness, err := d.CommitReadiness(ctx, name)
+	if err != nil {
+		return nil, errors.Wrap("CommitStaged", err)
+	}
+
+	details := map[string]interface{}{
+		"chaincode": name,
+		"sequence":  readiness.Sequence,
+		"approvals": readiness.Approvals,
+		"policy":    readiness.Policy,
+	}
+	switch {
+	case len(readiness.Mismatched) > 0:
+		details["orgs"] = readiness.Mismatched
+		return nil, errors.WrapWithContext("CommitStaged", errors.ErrDefinitionMismatch, details)
+	case !readiness.Ready && len(readiness.Rejections) > 0:
+		details["rejections"] = readiness.Rejections
+		return nil, errors.WrapWithContext("CommitStaged", errors.ErrDefinitionRejected, details)
+	case !readiness.Ready:
+		return nil, errors.WrapWithContext("CommitStaged", errors.ErrApprovalsMissing, details)
+	}
+
+	staged, req, def, err := d.stagedDefinition(name)
+	if err != nil {
+		return nil, errors.Wrap("CommitStaged", err)
+	}
+
+	if err := d.commitChaincode(ctx, req, def); err != nil {
+		return nil, errors.Wrap("CommitStaged", err)
+	}
+
+	previous := d.network.Chaincode(name)
+	d.network.SetCollections(name, staged.Collections)
+	record := staged.Chaincode
+	d.network.RecordChaincode(&record)
+	d.network.ClearStagedDefinition(name)
+
+	result := &DeployResult{
+		ChaincodeID: fmt.Sprintf("%s-%s", name, uuid.New().String()[:8]),
+		Version:     staged.Version,
+		Sequence:    staged.Sequence,
+		PackageID:   staged.PackageID,
+		DebugPort:   staged.DebugPort,
+	}
+	if previous != nil {
+		result.OldVersion = previous.Version
+		result.OldSequence = previous.Sequence
+	}
+
+	if result.Sequence == 1 {
+		if err := d.initChaincode(ctx, req); err != nil {
+			// Log warning but don't fail - Init may not be required
+			fmt.Printf("Warning: chaincode init returned error (may be expected): %v\n", err)
+		}
+	}
+
+	return result, nil
+}
+
+// approvedBy evaluates a lifecycle policy against org approvals. An
+// approval is signed by an org admin and endorsed by an org peer, so it
+// satisfies any role of the org.
+func approvedBy(policy *Policy, mspIDs []string) bool {
+	return anyRole(policy).satisfiedBy(mspIDs, make([]bool, len(mspIDs)))
+}
+
+// anyRole copies a policy with every principal turned into a peer
+func anyRole(p *Policy) *Policy {
+	if p.IsPrincipal() {
+		return &Policy{MSPID: p.MSPID, Role: RolePeer}
+	}
+
+	node := &Policy{N: p.N}
+	for _, rule := range p.Rules {
+		node.Rules = append(node.Rules, anyRole(rule))
+	}
+	return node
+}
@@ -0,0 +1,209 @@
+// core/pkg/chaincode/governance_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+const stagedInstallOutput = "Chaincode code package identifier: mycc_1.0:" +
+	"0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+
+func TestStageAndApprove(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "package"):
+			writePackageOutput(t, args, "package bytes")
+		case contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case contains(args, "install"):
+			return []byte(stagedInstallOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	result, err := deployer.Upgrade(context.Background(), &DeployRequest{Name: "mycc", Path: "/chaincode/mycc", Stage: true})
+	if err == nil {
+		t.Fatalf("Expected Upgrade() of an uncommitted chaincode to fail, got %+v", result)
+	}
+
+	result, err = deployer.deploy(context.Background(), &DeployRequest{Name: "mycc", Path: "/chaincode/mycc", Stage: true}, false)
+	if err != nil {
+		t.Fatalf("deploy() error = %v", err)
+	}
+	if !result.Staged || result.Sequence != 1 {
+		t.Errorf("Expected a definition staged at sequence 1, got %+v", result)
+	}
+
+	for _, call := range mockExec.Calls {
+		if contains(call.Args, "approveformyorg") || contains(call.Args, "commit") {
+			t.Fatalf("Expected staging to leave approval to the orgs, got %v", call.Args)
+		}
+	}
+	if net.Chaincode("mycc") != nil {
+		t.Error("Expected nothing recorded before the definition is committed")
+	}
+
+	mockExec.Calls = nil
+	if err := deployer.ApproveStaged(context.Background(), "mycc", "Org2"); err != nil {
+		t.Fatalf("ApproveStaged() error = %v", err)
+	}
+	if len(mockExec.Calls) != 1 || !contains(mockExec.Calls[0].Args, "CORE_PEER_LOCALMSPID=Org2MSP") ||
+		!contains(mockExec.Calls[0].Args, "approveformyorg") || !containsSequence(mockExec.Calls[0].Args, "1") {
+		t.Errorf("Expected a single approval by Org2, got %v", mockExec.Calls)
+	}
+	if staged := net.StagedDefinition("mycc"); !staged.Approved["Org2MSP"] {
+		t.Errorf("Expected the Org2 approval to be recorded, got %+v", staged.Approved)
+	}
+
+	if err := deployer.ApproveStaged(context.Background(), "other", "Org1"); !stdErr.Is(err, errors.ErrChaincodeNotFound) {
+		t.Errorf("Expected ErrChaincodeNotFound for an unstaged chaincode, got %v", err)
+	}
+	if err := deployer.ApproveStaged(context.Background(), "mycc", "Org9"); !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig for an unknown org, got %v", err)
+	}
+}
+
+func TestCommitStaged(t *testing.T) {
+	tests := []struct {
+		name       string
+		approvals  string
+		reject     bool
+		mismatched bool // Org2 approved something else at the sequence
+		errType    error
+	}{
+		{
+			name:      "all orgs approved",
+			approvals: `{"Org1MSP": true, "Org2MSP": true}`,
+		},
+		{
+			name:      "majority not reached",
+			approvals: `{"Org1MSP": true, "Org2MSP": false}`,
+			errType:   errors.ErrApprovalsMissing,
+		},
+		{
+			name:      "org rejected",
+			approvals: `{"Org1MSP": true, "Org2MSP": false}`,
+			reject:    true,
+			errType:   errors.ErrDefinitionRejected,
+		},
+		{
+			name:       "org approved another definition",
+			approvals:  `{"Org1MSP": true, "Org2MSP": false}`,
+			mismatched: true,
+			errType:    errors.ErrDefinitionMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				switch {
+				case contains(args, "package"):
+					writePackageOutput(t, args, "package bytes")
+				case contains(args, "querycommitted"):
+					return []byte(`{"sequence": 1, "version": "1.0"}`), nil
+				case contains(args, "checkcommitreadiness"):
+					return []byte(fmt.Sprintf(`{"approvals": %s}`, tt.approvals)), nil
+				case contains(args, "queryapproved"):
+					if tt.mismatched {
+						return []byte(`{"sequence": 2, "version": "1.1"}`), nil
+					}
+					return []byte("Error: could not fetch approved chaincode definition"), fmt.Errorf("exit status 1")
+				case contains(args, "install"):
+					return []byte(stagedInstallOutput), nil
+				}
+				return []byte("success"), nil
+			}
+
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+			staged, err := deployer.Upgrade(context.Background(), &DeployRequest{
+				Name:  "mycc",
+				Path:  "/chaincode/mycc",
+				Stage: true,
+			})
+			if err != nil {
+				t.Fatalf("Upgrade() error = %v", err)
+			}
+			if tt.reject {
+				if err := deployer.RejectStaged("mycc", "Org2", "needs an audit"); err != nil {
+					t.Fatalf("RejectStaged() error = %v", err)
+				}
+			}
+
+			mockExec.Calls = nil
+			result, err := deployer.CommitStaged(context.Background(), "mycc")
+
+			committed := false
+			for _, call := range mockExec.Calls {
+				if contains(call.Args, "commit") {
+					committed = true
+				}
+			}
+
+			if tt.errType != nil {
+				if !stdErr.Is(err, tt.errType) {
+					t.Fatalf("Expected %v, got %v", tt.errType, err)
+				}
+				if committed || net.StagedDefinition("mycc") == nil {
+					t.Error("Expected the definition to stay staged and uncommitted")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("CommitStaged() error = %v", err)
+			}
+			if !committed || result.Sequence != staged.Sequence || result.OldSequence != 0 {
+				t.Errorf("Unexpected commit result: %+v", result)
+			}
+			if cc := net.Chaincode("mycc"); cc == nil || cc.Sequence != 2 {
+				t.Errorf("Expected the committed definition to be recorded, got %+v", cc)
+			}
+			if net.StagedDefinition("mycc") != nil {
+				t.Error("Expected the staged definition to be cleared")
+			}
+		})
+	}
+}
+
+func TestApprovedBy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		mspIDs []string
+		want   bool
+	}{
+		{name: "majority of two", policy: "AND('Org1MSP.peer','Org2MSP.peer')", mspIDs: []string{"Org1MSP"}, want: false},
+		{name: "admin principal", policy: "OR('Org1MSP.admin','Org2MSP.admin')", mspIDs: []string{"Org2MSP"}, want: true},
+		{name: "two of three", policy: "OutOf(2,'Org1MSP.peer','Org2MSP.peer','Org3MSP.peer')", mspIDs: []string{"Org1MSP", "Org3MSP"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParsePolicy(tt.policy)
+			if err != nil {
+				t.Fatalf("ParsePolicy() error = %v", err)
+			}
+			if got := approvedBy(policy, tt.mspIDs); got != tt.want {
+				t.Errorf("approvedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,355 +3,301 @@ package chaincode
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"regexp"
-	"strings"
+	"strconv"
+
+	"google.golang.org/grpc"
 
 	"github.com/temmyjay001/core/pkg/errors"
 	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/fabric/peer"
 	"github.com/temmyjay001/core/pkg/network"
 )
 
 type Invoker struct {
 	network *network.Network
 	exec    executor.Executor
+
+	// dialPeer connects to a peer's gRPC services
+	dialPeer func(org *network.Organization, peer *network.Peer) (*grpc.ClientConn, error)
 }
 
 func NewInvoker(net *network.Network, exec executor.Executor) *Invoker {
-	return &Invoker{
+	inv := &Invoker{
 		network: net,
 		exec:    exec,
 	}
+	inv.dialPeer = inv.dialPeerTLS
+	return inv
 }
 
-// Invoke executes a transaction inside a peer container (no local binaries)
-func (inv *Invoker) Invoke(ctx context.Context, chaincodeName, functionName string, args []string) (string, []byte, error) {
-	// Check context
-	if err := ctx.Err(); err != nil {
-		return "", nil, errors.Wrap("Invoke", err)
-	}
-
-	// Use first org for invocation
-	org := inv.network.Orgs[0]
-	peer := org.Peers[0]
-	containerName := "cli"
+// InvokeRequest describes a transaction or query and who signs it
+type InvokeRequest struct {
+	Chaincode string
+	Function  string
+	Args      []string
+	Org       string // Org name or MSP ID; defaults to the first org
+	Identity  string // e.g. User1 or User1@org2.example.com; defaults to Admin
+
+	// Transient data is passed to the chaincode but never written to the
+	// ledger. Transactions carrying it are only sent to Collection members,
+	// or to the signer's org when no collection is given.
+	Transient  map[string][]byte
+	Collection string
+
+	// Endorsement targets. EndorsingPeers (peer names) win over
+	// EndorsingOrgs (first peer of each org); AutoEndorse picks the smallest
+	// set of peers satisfying the committed endorsement policy.
+	EndorsingOrgs  []string
+	EndorsingPeers []string
+	AutoEndorse    bool
+}
 
-	// Build arguments JSON
-	argsJSON := inv.buildArgsJSON(functionName, args)
+// TransactionResult is the outcome of a submitted transaction. Submit also
+// returns it with endorsement, ordering and ErrTransactionInvalidated
+// errors, with ValidationCode NOT_VALIDATED until the peer validated the
+// transaction.
+type TransactionResult struct {
+	TxID           string
+	Payload        []byte // Exactly what the chaincode returned
+	BlockNumber    uint64
+	ValidationCode peer.TxValidationCode
+	Endorsers      []string // Peers whose endorsements the transaction carries
+
+	// Chaincode response the endorsers agreed on, or of the endorser that
+	// rejected the transaction
+	Status  int32
+	Message string
+}
 
-	// Build peer addresses for endorsement
-	peerAddresses := []string{}
-	peerTLSRootCerts := []string{}
-	for _, org := range inv.network.Orgs {
-		for _, peer := range org.Peers {
-			peerAddresses = append(peerAddresses, "--peerAddresses", fmt.Sprintf("%s:%d", peer.Name, peer.Port))
-			tlsCert := fmt.Sprintf("/etc/hyperledger/fabric/crypto/peerOrganizations/%s/peers/%s/tls/ca.crt", org.Domain, peer.Name)
-			peerTLSRootCerts = append(peerTLSRootCerts, "--tlsRootCertFiles", tlsCert)
-		}
+// Retryable reports whether the transaction was only invalidated because
+// keys it read changed before it committed, so endorsing and submitting it
+// again may succeed
+func (r *TransactionResult) Retryable() bool {
+	switch r.ValidationCode {
+	case peer.TxValidationCode_MVCC_READ_CONFLICT, peer.TxValidationCode_PHANTOM_READ_CONFLICT:
+		return true
 	}
+	return false
+}
 
-	// Execute invoke inside CLI container
-	env := inv.getPeerEnvArgs(org, peer)
-	cmdArgs := []string{"exec"}
-	cmdArgs = append(cmdArgs, env...)
-	cmdArgs = append(cmdArgs, containerName,
-		"peer", "chaincode", "invoke",
-		"-o", fmt.Sprintf("%s:%d", inv.network.Orderers[0].Name, inv.network.Orderers[0].Port),
-		"-C", inv.network.Channel.Name,
-		"-n", chaincodeName,
-		"-c", argsJSON,
-		"--waitForEvent",
-		"--tls", "true",
-		"--cafile", ordererTLSCA,
-	)
-	cmdArgs = append(cmdArgs, peerAddresses...)
-	cmdArgs = append(cmdArgs, peerTLSRootCerts...)
-
-	output, err := inv.exec.ExecuteCombined(ctx, "docker", cmdArgs...)
+// Invoke executes a transaction as the first org's admin
+func (inv *Invoker) Invoke(ctx context.Context, chaincodeName, functionName string, args []string) (string, []byte, error) {
+	result, err := inv.Submit(ctx, &InvokeRequest{
+		Chaincode: chaincodeName,
+		Function:  functionName,
+		Args:      args,
+	})
 	if err != nil {
-		return "", nil, errors.WrapWithContext("Invoke", errors.ErrTransactionFailed, map[string]interface{}{
-			"chaincode": chaincodeName,
-			"function":  functionName,
-			"error":     err.Error(),
-			"output":    string(output),
-		})
+		return "", nil, err
 	}
-
-	// Extract transaction ID from output
-	txID := inv.extractTxID(string(output))
-
-	// Clean up the output - remove ANSI codes and extract payload if present
-	cleanOutput := inv.cleanOutput(string(output))
-
-	// Try to extract result payload from the output
-	payload := inv.extractPayload(cleanOutput)
-
-	return txID, payload, nil
+	return result.TxID, result.Payload, nil
 }
 
-// Query executes a read-only query inside a peer container
+// Query executes a read-only query as the first org's admin
 func (inv *Invoker) Query(ctx context.Context, chaincodeName, functionName string, args []string) ([]byte, error) {
+	return inv.Evaluate(ctx, &InvokeRequest{
+		Chaincode: chaincodeName,
+		Function:  functionName,
+		Args:      args,
+	})
+}
+
+// Submit endorses a transaction through the Fabric Gateway of the signer's
+// first peer, on the selected orgs, signed by the requested identity, then
+// orders it through the same gateway and waits until that peer commits it.
+// Transactions whose chaincode returns an error status fail with
+// ErrEndorsementRejected, and those the endorsers disagree on with
+// ErrEndorsementMismatch; neither is ordered. Ordered transactions the peer
+// marks invalid fail with ErrTransactionInvalidated.
+func (inv *Invoker) Submit(ctx context.Context, req *InvokeRequest) (*TransactionResult, error) {
 	// Check context
 	if err := ctx.Err(); err != nil {
-		return nil, errors.Wrap("Query", err)
+		return nil, errors.Wrap("Invoke", err)
 	}
 
-	// Use first org for query
-	org := inv.network.Orgs[0]
-	peer := org.Peers[0]
-	containerName := "cli"
-
-	// Build arguments JSON
-	argsJSON := inv.buildArgsJSON(functionName, args)
-
-	// Execute query inside CLI container
-	env := inv.getPeerEnvArgs(org, peer)
-	cmdArgs := []string{"exec"}
-	cmdArgs = append(cmdArgs, env...)
-	cmdArgs = append(cmdArgs, containerName,
-		"peer", "chaincode", "query",
-		"-C", inv.network.Channel.Name,
-		"-n", chaincodeName,
-		"-c", argsJSON,
-		"--tls", "true",
-		"--cafile", ordererTLSCA,
-	)
+	signer, err := inv.network.ResolveIdentity(req.Org, req.Identity)
+	if err != nil {
+		return nil, errors.Wrap("Invoke", err)
+	}
+	id, err := loadSigningIdentity(inv.network, signer)
+	if err != nil {
+		return nil, errors.Wrap("Invoke", err)
+	}
+	conn, err := inv.connect(signer)
+	if err != nil {
+		return nil, errors.Wrap("Invoke", err)
+	}
+	defer conn.Close()
+	client := gateway.NewGatewayClient(conn)
 
-	output, err := inv.exec.ExecuteCombined(ctx, "docker", cmdArgs...)
+	endorsers, err := inv.selectEndorsers(ctx, conn, signer, id, req)
 	if err != nil {
-		return nil, errors.WrapWithContext("Query", err, map[string]interface{}{
-			"chaincode": chaincodeName,
-			"function":  functionName,
+		return nil, errors.Wrap("Invoke", err)
+	}
+	prop, err := newProposal(id, inv.network.Channel.Name, req)
+	if err != nil {
+		return nil, errors.WrapWithContext("Invoke", errors.ErrTransactionFailed, map[string]interface{}{
+			"chaincode": req.Chaincode,
+			"function":  req.Function,
 			"error":     err.Error(),
-			"output":    string(output),
 		})
 	}
-
-	// Clean the output
-	cleanOutput := inv.cleanOutput(string(output))
-
-	// For queries, the last line typically contains the result
-	lines := strings.Split(strings.TrimSpace(cleanOutput), "\n")
-	if len(lines) > 0 {
-		result := lines[len(lines)-1]
-		// If it looks like JSON, return it as-is
-		if strings.HasPrefix(strings.TrimSpace(result), "{") || strings.HasPrefix(strings.TrimSpace(result), "[") {
-			return []byte(result), nil
+	fields := func() map[string]interface{} {
+		return map[string]interface{}{
+			"chaincode": req.Chaincode,
+			"function":  req.Function,
+			"org":       signer.Org.Name,
+			"identity":  signer.Name,
+			"tx_id":     prop.txID,
 		}
 	}
 
-	return []byte(cleanOutput), nil
+	result := &TransactionResult{TxID: prop.txID, ValidationCode: peer.TxValidationCode_NOT_VALIDATED}
+	prepared, err := inv.endorseTransaction(ctx, client, prop, endorsingOrganizations(endorsers, req))
+	if err != nil {
+		return result, endorseError("Invoke", err, result, fields())
+	}
+	endorsement, err := readPrepared(prepared)
+	if err != nil {
+		details := fields()
+		details["error"] = fmt.Sprintf("invalid prepared transaction: %v", err)
+		return result, errors.WrapWithContext("Invoke", errors.ErrTransactionFailed, details)
+	}
+	result.Endorsers = endorsement.endorsers
+	result.Status = endorsement.response.GetStatus()
+	result.Message = endorsement.response.GetMessage()
+	result.Payload = endorsement.response.GetPayload()
+
+	status, err := inv.commit(ctx, client, id, prop.txID, prepared)
+	if err != nil {
+		return result, gatewayError("Invoke", err, fields())
+	}
+	result.BlockNumber = status.BlockNumber
+	result.ValidationCode = status.Result
+	if status.Result != peer.TxValidationCode_VALID {
+		details := fields()
+		details["block"] = status.BlockNumber
+		details["validation_code"] = status.Result.String()
+		return result, errors.WrapWithContext("Invoke", errors.ErrTransactionInvalidated, details)
+	}
+	return result, nil
 }
 
-// InvokeWithTransient executes a transaction with transient data
-func (inv *Invoker) InvokeWithTransient(ctx context.Context, chaincodeName, functionName string, args []string, transient map[string][]byte) (string, []byte, error) {
+// Evaluate executes a read-only query on a peer of the signer's org
+// through the Fabric Gateway and returns the exact chaincode response
+func (inv *Invoker) Evaluate(ctx context.Context, req *InvokeRequest) ([]byte, error) {
 	// Check context
 	if err := ctx.Err(); err != nil {
-		return "", nil, errors.Wrap("InvokeWithTransient", err)
+		return nil, errors.Wrap("Query", err)
 	}
 
-	org := inv.network.Orgs[0]
-	peer := org.Peers[0]
-	containerName := "cli"
-
-	argsJSON := inv.buildArgsJSON(functionName, args)
-
-	// For transient data, we'd need to write it to a file in the container
-	// This is simplified - production would handle this more robustly
-	transientJSON, _ := json.Marshal(transient)
-
-	peerAddresses := []string{}
-	for _, org := range inv.network.Orgs {
-		for _, peer := range org.Peers {
-			peerAddresses = append(peerAddresses, "--peerAddresses", fmt.Sprintf("%s:%d", peer.Name, peer.Port))
-		}
+	signer, err := inv.network.ResolveIdentity(req.Org, req.Identity)
+	if err != nil {
+		return nil, errors.Wrap("Query", err)
 	}
-
-	env := inv.getPeerEnvArgs(org, peer)
-	cmdArgs := []string{"exec"}
-	cmdArgs = append(cmdArgs, env...)
-	cmdArgs = append(cmdArgs, containerName,
-		"peer", "chaincode", "invoke",
-		"-o", fmt.Sprintf("%s:%d", inv.network.Orderers[0].Name, inv.network.Orderers[0].Port),
-		"-C", inv.network.Channel.Name,
-		"-n", chaincodeName,
-		"-c", argsJSON,
-		"--transient", string(transientJSON),
-		"--waitForEvent",
-	)
-	cmdArgs = append(cmdArgs, peerAddresses...)
-
-	output, err := inv.exec.ExecuteCombined(ctx, "docker", cmdArgs...)
+	id, err := loadSigningIdentity(inv.network, signer)
 	if err != nil {
-		return "", nil, errors.WrapWithContext("InvokeWithTransient", errors.ErrTransactionFailed, map[string]interface{}{
-			"chaincode": chaincodeName,
-			"function":  functionName,
-			"error":     err.Error(),
-			"output":    string(output),
-		})
+		return nil, errors.Wrap("Query", err)
 	}
-
-	txID := inv.extractTxID(string(output))
-	cleanOutput := inv.cleanOutput(string(output))
-	payload := inv.extractPayload(cleanOutput)
-
-	return txID, payload, nil
-}
-
-func (inv *Invoker) getPeerEnvArgs(org *network.Organization, peer *network.Peer) []string {
-	return []string{
-		"-e", fmt.Sprintf("CORE_PEER_LOCALMSPID=%s", org.MSPID),
-		"-e", fmt.Sprintf("CORE_PEER_ADDRESS=%s:%d", peer.Name, peer.Port),
-		"-e", fmt.Sprintf("CORE_PEER_MSPCONFIGPATH=/etc/hyperledger/fabric/crypto/peerOrganizations/%s/users/Admin@%s/msp", org.Domain, org.Domain),
-		"-e", "CORE_PEER_TLS_ENABLED=true",
-		"-e", fmt.Sprintf("CORE_PEER_TLS_ROOTCERT_FILE=/etc/hyperledger/fabric/crypto/peerOrganizations/%s/peers/%s/tls/ca.crt", org.Domain, peer.Name),
-		"-e", "FABRIC_CFG_PATH=/etc/hyperledger/fabric/config",
+	fields := map[string]interface{}{
+		"chaincode": req.Chaincode,
+		"function":  req.Function,
+		"org":       signer.Org.Name,
+		"identity":  signer.Name,
 	}
-}
 
-func (inv *Invoker) buildArgsJSON(functionName string, args []string) string {
-	allArgs := append([]string{functionName}, args...)
-
-	argsMap := map[string]interface{}{
-		"Args": allArgs,
+	prop, err := newProposal(id, inv.network.Channel.Name, req)
+	if err != nil {
+		fields["error"] = err.Error()
+		return nil, errors.WrapWithContext("Query", errors.ErrTransactionFailed, fields)
 	}
-
-	jsonBytes, _ := json.Marshal(argsMap)
-	return string(jsonBytes)
-}
-
-func (inv *Invoker) extractTxID(output string) string {
-	// Parse transaction ID from output
-	// Format: "... txid [TRANSACTION_ID] committed with status ..."
-	lines := strings.Split(output, "\n")
-	for _, line := range lines {
-		if strings.Contains(line, "txid") && strings.Contains(line, "committed") {
-			// Use regex to extract txid
-			re := regexp.MustCompile(`txid\s+\[([a-zA-Z0-9]+)\]`)
-			matches := re.FindStringSubmatch(line)
-			if len(matches) > 1 {
-				return matches[1]
-			}
-		}
+	conn, err := inv.connect(signer)
+	if err != nil {
+		return nil, errors.Wrap("Query", err)
 	}
-	return "unknown"
-}
+	defer conn.Close()
 
-// cleanOutput removes ANSI escape codes and cleans up docker output
-func (inv *Invoker) cleanOutput(output string) string {
-	// Remove ANSI escape codes (color codes)
-	ansiRegex := regexp.MustCompile(`\x1b\[[0-9;]*m`)
-	cleaned := ansiRegex.ReplaceAllString(output, "")
-
-	// Remove timestamp prefixes like "2025-11-11 02:52:58.506 UTC 0001 INFO"
-	timestampRegex := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}\s+\d{2}:\d{2}:\d{2}\.\d+\s+UTC\s+\d+\s+INFO\s+`)
-	lines := strings.Split(cleaned, "\n")
-	var cleanedLines []string
-	for _, line := range lines {
-		line = timestampRegex.ReplaceAllString(line, "")
-		if strings.TrimSpace(line) != "" {
-			cleanedLines = append(cleanedLines, line)
-		}
+	resp, err := inv.evaluate(ctx, gateway.NewGatewayClient(conn), id, prop)
+	if err != nil {
+		return nil, gatewayError("Query", err, fields)
 	}
-
-	return strings.Join(cleanedLines, "\n")
+	if resp.GetStatus() >= 400 {
+		fields["error"] = fmt.Sprintf("chaincode response %d, %s", resp.Status, resp.Message)
+		return nil, errors.WrapWithContext("Query", errors.ErrTransactionFailed, fields)
+	}
+	return resp.GetPayload(), nil
 }
 
-// extractPayload tries to extract the result payload from chaincode output
-func (inv *Invoker) extractPayload(output string) []byte {
-	// Look for "result: status:200 payload:..." pattern
-	re := regexp.MustCompile(`result:\s*status:(\d+)\s+(?:payload:"?([^"]*)"?)?`)
-	matches := re.FindStringSubmatch(output)
-
-	if len(matches) > 2 && matches[2] != "" {
-		// Found payload
-		return []byte(matches[2])
+// InvokeWithTransient executes a transaction with transient data as the
+// first org's admin
+func (inv *Invoker) InvokeWithTransient(ctx context.Context, chaincodeName, functionName string, args []string, transient map[string][]byte) (string, []byte, error) {
+	result, err := inv.Submit(ctx, &InvokeRequest{
+		Chaincode: chaincodeName,
+		Function:  functionName,
+		Args:      args,
+		Transient: transient,
+	})
+	if err != nil {
+		return "", nil, err
 	}
+	return result.TxID, result.Payload, nil
+}
 
-	// Look for lines that might contain chaincode response
-	lines := strings.Split(output, "\n")
-	for _, line := range lines {
-		// Skip lines that are clearly log messages
-		if strings.Contains(line, "INFO") ||
-			strings.Contains(line, "ClientWait") ||
-			strings.Contains(line, "committed with status") {
-			continue
+// endorsingOrgs picks the orgs whose peers may endorse a transaction. Private
+// data must not leave the orgs allowed to see it, so transient transactions
+// only go to collection members.
+func (inv *Invoker) endorsingOrgs(signer *network.Signer, req *InvokeRequest) ([]*network.Organization, error) {
+	if req.Collection != "" {
+		orgs, err := inv.network.CollectionOrgs(req.Chaincode, req.Collection)
+		if err != nil {
+			return nil, err
 		}
-
-		// If line looks like JSON, return it
-		trimmed := strings.TrimSpace(line)
-		if strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
-			return []byte(trimmed)
+		if len(orgs) == 0 {
+			return nil, errors.WrapWithContext("endorsingOrgs", errors.ErrInvalidConfig, map[string]interface{}{
+				"collection": req.Collection,
+				"reason":     "no organization of this network is a member of the collection",
+			})
 		}
+		return orgs, nil
 	}
 
-	// Return empty if no payload found (for transactions that don't return data)
-	return []byte{}
-}
-
-// Helper to get block info
-func (inv *Invoker) GetBlockByNumber(ctx context.Context, blockNum uint64) ([]byte, error) {
-	// Check context
-	if err := ctx.Err(); err != nil {
-		return nil, errors.Wrap("GetBlockByNumber", err)
+	if len(req.Transient) > 0 {
+		return []*network.Organization{signer.Org}, nil
 	}
 
-	org := inv.network.Orgs[0]
-	peer := org.Peers[0]
-	containerName := "cli"
-
-	env := inv.getPeerEnvArgs(org, peer)
-	cmdArgs := []string{"exec"}
-	cmdArgs = append(cmdArgs, env...)
-	cmdArgs = append(cmdArgs, containerName,
-		"peer", "channel", "getinfo",
-		"-c", inv.network.Channel.Name,
-	)
+	return inv.network.Orgs, nil
+}
 
-	output, err := inv.exec.ExecuteCombined(ctx, "docker", cmdArgs...)
+// GetBlockByNumber returns a block of the channel as a marshaled
+// common.Block, read through qscc as the first org's admin
+func (inv *Invoker) GetBlockByNumber(ctx context.Context, blockNum uint64) ([]byte, error) {
+	block, err := inv.Evaluate(ctx, &InvokeRequest{
+		Chaincode: "qscc",
+		Function:  "GetBlockByNumber",
+		Args:      []string{inv.network.Channel.Name, strconv.FormatUint(blockNum, 10)},
+	})
 	if err != nil {
 		return nil, errors.WrapWithContext("GetBlockByNumber", err, map[string]interface{}{
 			"block_num": blockNum,
-			"error":     err.Error(),
-			"output":    string(output),
 		})
 	}
-
-	cleanOutput := inv.cleanOutput(string(output))
-	return []byte(cleanOutput), nil
+	return block, nil
 }
 
-// Helper to get transaction by ID
+// GetTransactionByID returns a transaction of the channel as a marshaled
+// ProcessedTransaction, read through qscc as the first org's admin
 func (inv *Invoker) GetTransactionByID(ctx context.Context, txID string) ([]byte, error) {
-	// Check context
-	if err := ctx.Err(); err != nil {
-		return nil, errors.Wrap("GetTransactionByID", err)
-	}
-
-	org := inv.network.Orgs[0]
-	peer := org.Peers[0]
-	containerName := "cli"
-
-	env := inv.getPeerEnvArgs(org, peer)
-	cmdArgs := []string{"exec"}
-	cmdArgs = append(cmdArgs, env...)
-	cmdArgs = append(cmdArgs, containerName,
-		"peer", "chaincode", "query",
-		"-C", inv.network.Channel.Name,
-		"-n", "qscc",
-		"-c", fmt.Sprintf(`{"Args":["GetTransactionByID","%s","%s"]}`, inv.network.Channel.Name, txID),
-	)
-
-	output, err := inv.exec.ExecuteCombined(ctx, "docker", cmdArgs...)
+	tx, err := inv.Evaluate(ctx, &InvokeRequest{
+		Chaincode: "qscc",
+		Function:  "GetTransactionByID",
+		Args:      []string{inv.network.Channel.Name, txID},
+	})
 	if err != nil {
 		return nil, errors.WrapWithContext("GetTransactionByID", err, map[string]interface{}{
-			"tx_id":  txID,
-			"error":  err.Error(),
-			"output": string(output),
+			"tx_id": txID,
 		})
 	}
-
-	cleanOutput := inv.cleanOutput(string(output))
-	return []byte(cleanOutput), nil
+	return tx, nil
 }
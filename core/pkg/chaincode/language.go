@@ -0,0 +1,301 @@
+// core/pkg/chaincode/language.go
+package chaincode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// Chaincode languages
+const (
+	LanguageGo         = "golang"
+	LanguageNode       = "node"
+	LanguageTypeScript = "typescript" // Compiled to JavaScript and packaged as node
+	LanguageJava       = "java"
+)
+
+// Images the pre-package builds run in
+const (
+	goBuildImage     = "golang:1.22"
+	nodeBuildImage   = "node:20"
+	gradleBuildImage = "gradle:8.5-jdk11"
+	mavenBuildImage  = "maven:3.9-eclipse-temurin-11"
+)
+
+// languageAliases maps accepted spellings to a language
+var languageAliases = map[string]string{
+	"go":         LanguageGo,
+	"golang":     LanguageGo,
+	"node":       LanguageNode,
+	"nodejs":     LanguageNode,
+	"javascript": LanguageNode,
+	"js":         LanguageNode,
+	"typescript": LanguageTypeScript,
+	"ts":         LanguageTypeScript,
+	"java":       LanguageJava,
+}
+
+// resolveLanguage normalizes the requested language, detecting it from the
+// project files when empty, and checks the project layout. Paths the
+// runtime cannot read, e.g. on a remote Docker host, are passed through
+// unchecked as golang unless a language is given.
+func resolveLanguage(path, language string) (string, error) {
+	if language != "" {
+		lang, ok := languageAliases[strings.ToLower(language)]
+		if !ok {
+			return "", errors.WrapWithContext("resolveLanguage", errors.ErrInvalidConfig, map[string]interface{}{
+				"language": language,
+				"reason":   "language must be golang, node, typescript or java",
+			})
+		}
+		language = lang
+	}
+
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		if language == "" {
+			language = LanguageGo
+		}
+		return language, nil
+	}
+
+	if language == "" {
+		language = detectLanguage(path)
+		if language == "" {
+			return "", errors.WrapWithContext("resolveLanguage", errors.ErrInvalidConfig, map[string]interface{}{
+				"path":   path,
+				"reason": "cannot detect the chaincode language, expected go.mod, package.json, build.gradle or pom.xml",
+			})
+		}
+	}
+
+	if err := validateLayout(path, language); err != nil {
+		return "", errors.WrapWithContext("resolveLanguage", errors.ErrInvalidConfig, map[string]interface{}{
+			"path":     path,
+			"language": language,
+			"reason":   err.Error(),
+		})
+	}
+	return language, nil
+}
+
+// detectLanguage guesses the language from the project's build files
+func detectLanguage(path string) string {
+	switch {
+	case fileExists(path, "go.mod"):
+		return LanguageGo
+	case fileExists(path, "package.json") && fileExists(path, "tsconfig.json"):
+		return LanguageTypeScript
+	case fileExists(path, "package.json"):
+		return LanguageNode
+	case javaBuildFile(path) != "":
+		return LanguageJava
+	}
+	return ""
+}
+
+// validateLayout checks a project has what its language's build needs
+func validateLayout(path, language string) error {
+	switch language {
+	case LanguageGo:
+		if !fileExists(path, "go.mod") {
+			return fmt.Errorf("golang chaincode needs a go.mod at the root of the chaincode folder")
+		}
+
+	case LanguageNode, LanguageTypeScript:
+		scripts, err := packageScripts(path)
+		if err != nil {
+			return err
+		}
+		// The node chaincode builder launches the chaincode with npm start
+		if scripts["start"] == "" {
+			return fmt.Errorf(`package.json needs a start script, e.g. "start": "fabric-chaincode-node start"`)
+		}
+		if language == LanguageTypeScript {
+			if !fileExists(path, "tsconfig.json") {
+				return fmt.Errorf("typescript chaincode needs a tsconfig.json")
+			}
+			if scripts["build"] == "" {
+				return fmt.Errorf(`package.json needs a build script that compiles the typescript, e.g. "build": "tsc"`)
+			}
+		}
+
+	case LanguageJava:
+		if javaBuildFile(path) == "" {
+			return fmt.Errorf("java chaincode needs a build.gradle, build.gradle.kts or pom.xml")
+		}
+	}
+	return nil
+}
+
+// packageScripts reads the npm scripts of a node project
+func packageScripts(path string) (map[string]string, error) {
+	data, err := os.ReadFile(filepath.Join(path, "package.json"))
+	if err != nil {
+		return nil, fmt.Errorf("node chaincode needs a package.json at the root of the chaincode folder")
+	}
+
+	var pkg struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("package.json is not valid JSON: %v", err)
+	}
+	return pkg.Scripts, nil
+}
+
+// javaBuildFile returns the Gradle or Maven build file of a project
+func javaBuildFile(path string) string {
+	for _, name := range []string{"build.gradle", "build.gradle.kts", "pom.xml"} {
+		if fileExists(path, name) {
+			return name
+		}
+	}
+	return ""
+}
+
+func fileExists(dir, name string) bool {
+	info, err := os.Stat(filepath.Join(dir, name))
+	return err == nil && !info.IsDir()
+}
+
+// peerLanguage is the language peer lifecycle chaincode package expects
+func peerLanguage(language string) string {
+	if language == LanguageTypeScript {
+		return LanguageNode
+	}
+	return language
+}
+
+// buildChaincode runs the build a language needs before packaging and
+// returns the folder to package. TypeScript is compiled in a copy of the
+// source, so the package holds the JavaScript the node builder runs. Java
+// is built to report compile errors before the peers try to. Go modules
+// are vendored into a copy when requested.
+func (d *Deployer) buildChaincode(ctx context.Context, req *DeployRequest) (string, error) {
+	var image, command string
+	var volumes []string
+	switch req.Language {
+	case LanguageGo:
+		if !req.Vendor {
+			return req.Path, nil
+		}
+		image, command = goBuildImage, "go mod vendor"
+		volumes = []string{"fabricx-go-mod:/go/pkg/mod"}
+	case LanguageTypeScript:
+		image = nodeBuildImage
+		command = "(npm ci || npm install) && npm run build && rm -rf node_modules"
+		volumes = []string{"fabricx-npm-cache:/root/.npm"}
+	case LanguageJava:
+		image, command = mavenBuildImage, "mvn -B -q package -DskipTests"
+		volumes = []string{"fabricx-m2:/root/.m2"}
+		if javaBuildFile(req.Path) != "pom.xml" {
+			image, command = gradleBuildImage, "gradle build -x test --no-daemon -q"
+			if fileExists(req.Path, "gradlew") {
+				command = "sh ./gradlew build -x test --no-daemon -q"
+			}
+			volumes = []string{"fabricx-gradle:/home/gradle/.gradle"}
+		}
+	default:
+		return req.Path, nil
+	}
+
+	// Build in a copy so the source folder, and its hash, stay untouched
+	buildDir := filepath.Join(d.network.BasePath, "build", req.Name)
+	if err := os.RemoveAll(buildDir); err != nil {
+		return "", errors.Wrap("buildChaincode", err)
+	}
+	if err := copyTree(req.Path, buildDir); err != nil {
+		return "", errors.WrapWithContext("buildChaincode", err, map[string]interface{}{
+			"path": req.Path,
+		})
+	}
+
+	absBuildDir, err := filepath.Abs(buildDir)
+	if err != nil {
+		return "", errors.Wrap("buildChaincode", err)
+	}
+
+	fmt.Printf("🔨 Building %s chaincode in %s...\n", req.Language, image)
+
+	// Leave the build output owned by the runtime's user, so the next build
+	// can remove it, even when the build fails
+	if uid := os.Getuid(); uid > 0 {
+		command = keepOwner(command, uid, os.Getgid())
+	}
+
+	args := []string{"run", "--rm", "-v", fmt.Sprintf("%s:/chaincode", absBuildDir), "-w", "/chaincode"}
+	for _, volume := range volumes {
+		args = append(args, "-v", volume)
+	}
+	if req.Language == LanguageGo {
+		args = append(args, goEnvArgs()...)
+	}
+	args = append(args, image, "sh", "-c", command)
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		if req.Language == LanguageGo {
+			if modErr := goModulesError(string(output)); modErr != nil {
+				return "", modErr
+			}
+		}
+		return "", errors.WrapWithContext("buildChaincode", errors.ErrChaincodeDeployFailed, map[string]interface{}{
+			"language": req.Language,
+			"error":    err.Error(),
+			"output":   string(output),
+		})
+	}
+
+	fmt.Printf("✓ Chaincode built\n")
+	if req.Language == LanguageJava {
+		// The peers build Java from source; the build only checked it compiles
+		return req.Path, nil
+	}
+	return absBuildDir, nil
+}
+
+// keepOwner wraps a build command so /chaincode is handed back to a user
+// whatever the build's outcome, keeping the build's exit status
+func keepOwner(command string, uid, gid int) string {
+	return fmt.Sprintf("%s; rc=$?; chown -R %d:%d /chaincode; exit $rc", command, uid, gid)
+}
+
+// copyTree copies a chaincode folder, leaving out dependencies and build
+// output that the build recreates
+func copyTree(src, dst string) error {
+	skip := map[string]bool{".git": true, "node_modules": true}
+	skipTop := map[string]bool{"dist": true, "build": true, "target": true, ".gradle": true}
+
+	return filepath.WalkDir(src, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if rel != "." && (skip[entry.Name()] || skipTop[rel]) {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(filepath.Join(dst, rel), 0755)
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dst, rel), data, info.Mode().Perm())
+	})
+}
@@ -0,0 +1,185 @@
+// core/pkg/chaincode/language_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+const tsPackageJSON = `{"scripts": {"build": "tsc", "start": "fabric-chaincode-node start"}}`
+
+// writeProject creates a chaincode folder with the given files
+func writeProject(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestResolveLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string]string
+		language string
+		want     string
+		wantErr  string
+	}{
+		{name: "go module", files: map[string]string{"go.mod": "module mycc"}, want: LanguageGo},
+		{name: "go alias", files: map[string]string{"go.mod": "module mycc"}, language: "go", want: LanguageGo},
+		{name: "javascript", files: map[string]string{"package.json": `{"scripts": {"start": "fabric-chaincode-node start"}}`}, want: LanguageNode},
+		{name: "typescript", files: map[string]string{"package.json": tsPackageJSON, "tsconfig.json": "{}"}, want: LanguageTypeScript},
+		{name: "typescript alias", files: map[string]string{"package.json": tsPackageJSON, "tsconfig.json": "{}"}, language: "ts", want: LanguageTypeScript},
+		{name: "gradle", files: map[string]string{"build.gradle": ""}, want: LanguageJava},
+		{name: "maven", files: map[string]string{"pom.xml": ""}, want: LanguageJava},
+		{name: "unreadable path passes through", language: "java", want: LanguageJava},
+		{name: "no build files", files: map[string]string{"main.go": "package main"}, wantErr: "cannot detect"},
+		{name: "go without module", files: map[string]string{"main.go": "package main"}, language: "golang", wantErr: "go.mod"},
+		{name: "node without start script", files: map[string]string{"package.json": `{"scripts": {}}`}, wantErr: "start script"},
+		{name: "typescript without build script", files: map[string]string{"package.json": `{"scripts": {"start": "fabric-chaincode-node start"}}`, "tsconfig.json": "{}"}, wantErr: "build script"},
+		{name: "invalid package.json", files: map[string]string{"package.json": "{"}, wantErr: "not valid JSON"},
+		{name: "java without build file", files: map[string]string{"go.mod": "module mycc"}, language: "java", wantErr: "pom.xml"},
+		{name: "unknown language", files: map[string]string{"go.mod": "module mycc"}, language: "rust", wantErr: "language must be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := "/chaincode/missing"
+			if tt.files != nil {
+				path = writeProject(t, tt.files)
+			}
+
+			got, err := resolveLanguage(path, tt.language)
+			if tt.wantErr != "" {
+				if !stdErr.Is(err, errors.ErrInvalidConfig) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected ErrInvalidConfig mentioning %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveLanguage() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveLanguage() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeployTypeScript(t *testing.T) {
+	src := writeProject(t, map[string]string{
+		"package.json":            tsPackageJSON,
+		"tsconfig.json":           "{}",
+		"src/index.ts":            "export const contracts = [];",
+		"node_modules/x/index.js": "",
+	})
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "package"):
+			writePackageOutput(t, args, "package bytes")
+		case contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case contains(args, "install"):
+			return []byte(stagedInstallOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	if _, err := deployer.Deploy(context.Background(), &DeployRequest{Name: "token", Path: src}); err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	buildDir, _ := filepath.Abs(filepath.Join(net.BasePath, "build", "token"))
+	var build, pkg []string
+	for _, call := range mockExec.GetCalls() {
+		if contains(call.Args, nodeBuildImage) {
+			build = call.Args
+		}
+		if contains(call.Args, "package") {
+			pkg = call.Args
+		}
+	}
+
+	if build == nil || !contains(build, buildDir+":/chaincode") || !strings.Contains(build[len(build)-1], "npm run build") {
+		t.Fatalf("Expected a build of the copied source, got %v", build)
+	}
+	if pkg == nil || !contains(pkg, buildDir+":/chaincode") || !contains(pkg, "node") {
+		t.Errorf("Expected the built copy packaged as node, got %v", pkg)
+	}
+
+	if _, err := os.Stat(filepath.Join(buildDir, "src", "index.ts")); err != nil {
+		t.Errorf("Expected the source copied into the build folder: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(buildDir, "node_modules")); !os.IsNotExist(err) {
+		t.Error("Expected node_modules left out of the build folder")
+	}
+	if cc := net.Chaincode("token"); cc == nil || cc.Language != LanguageTypeScript {
+		t.Errorf("Expected the chaincode recorded as typescript, got %+v", cc)
+	}
+}
+
+func TestBuildJavaFailure(t *testing.T) {
+	src := writeProject(t, map[string]string{"pom.xml": "<project/>"})
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		if contains(args, mavenBuildImage) {
+			return []byte("[ERROR] COMPILATION ERROR"), fmt.Errorf("exit status 1")
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	_, err := deployer.buildChaincode(context.Background(), &DeployRequest{Name: "mycc", Path: src, Language: LanguageJava})
+	if !stdErr.Is(err, errors.ErrChaincodeDeployFailed) || !strings.Contains(err.Error(), "COMPILATION ERROR") {
+		t.Errorf("Expected the Maven output in the error, got %v", err)
+	}
+}
+
+func TestKeepOwnerOnFailedBuild(t *testing.T) {
+	// A chown on the PATH records what the build container would run
+	bin := t.TempDir()
+	record := filepath.Join(bin, "chowned")
+	script := fmt.Sprintf("#!/bin/sh\necho \"$@\" > %s\n", record)
+	if err := os.WriteFile(filepath.Join(bin, "chown"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("sh", "-c", keepOwner("true && sh -c 'exit 3'", 1000, 1001))
+	cmd.Env = append(os.Environ(), "PATH="+bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+	err := cmd.Run()
+
+	var exitErr *exec.ExitError
+	if !stdErr.As(err, &exitErr) || exitErr.ExitCode() != 3 {
+		t.Errorf("Expected the build's exit status 3, got %v", err)
+	}
+	data, err := os.ReadFile(record)
+	if err != nil || strings.TrimSpace(string(data)) != "-R 1000:1001 /chaincode" {
+		t.Errorf("Expected /chaincode handed back after the failed build, got %q, %v", data, err)
+	}
+}
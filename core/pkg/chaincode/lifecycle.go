@@ -0,0 +1,364 @@
+// core/pkg/chaincode/lifecycle.go
+package chaincode
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/fabric/gateway"
+	"github.com/temmyjay001/core/pkg/fabric/peer/lifecycle"
+	"github.com/temmyjay001/core/pkg/network"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// CommittedDefinition is the chaincode definition committed to a channel
+type CommittedDefinition struct {
+	Name                string              `json:"name,omitempty"`
+	Sequence            int64               `json:"-"`
+	RawSequence         json.RawMessage     `json:"sequence"`
+	Version             string              `json:"version"`
+	EndorsementPlugin   string              `json:"endorsement_plugin"`
+	ValidationPlugin    string              `json:"validation_plugin"`
+	ValidationParameter string              `json:"validation_parameter"`
+	InitRequired        bool                `json:"init_required"`
+	Approvals           map[string]bool     `json:"approvals"`
+	RawCollections      json.RawMessage     `json:"collections"`
+	Collections         []*CollectionConfig `json:"-"`
+}
+
+// queryCommitted returns the committed definition of a chaincode, or nil
+// when the chaincode has never been committed on the default channel
+func (d *Deployer) queryCommitted(ctx context.Context, name string) (*CommittedDefinition, error) {
+	org := d.network.Orgs[0]
+	return queryCommitted(ctx, d.exec, d.network, d.getPeerEnvArgs(org, org.Peers[0]), d.network.Channel.Name, name)
+}
+
+// queryCommitted runs querycommitted on a channel in the cli container with
+// the given peer environment
+func queryCommitted(ctx context.Context, exec executor.Executor, net *network.Network, env []string, channel, name string) (*CommittedDefinition, error) {
+	args := []string{"exec"}
+	args = append(args, env...)
+	args = append(args, "cli",
+		"peer", "lifecycle", "chaincode", "querycommitted",
+		"--channelID", channel,
+		"--name", name,
+		"--output", "json",
+		"--tls", "true",
+		"--cafile", net.OrdererTLSCA(),
+	)
+
+	output, err := exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		if isNotDefined(string(output), name) {
+			return nil, nil
+		}
+		return nil, errors.WrapWithContext("queryCommitted", err, map[string]interface{}{
+			"chaincode": name,
+			"output":    string(output),
+		})
+	}
+
+	def, err := parseCommittedDefinition(output)
+	if err != nil {
+		return nil, errors.WrapWithContext("queryCommitted", err, map[string]interface{}{
+			"chaincode": name,
+			"output":    string(output),
+		})
+	}
+	def.Name = name
+	return def, nil
+}
+
+// lifecycleNamespace is the system chaincode that holds chaincode
+// definitions
+const lifecycleNamespace = "_lifecycle"
+
+// queryDefinition reads the committed definition of a chaincode on the
+// default channel with an evaluation of _lifecycle QueryChaincodeDefinition
+// through a gateway. It returns nil when the chaincode was never committed.
+func (inv *Invoker) queryDefinition(ctx context.Context, client gateway.GatewayClient, id *signingIdentity, name string) (*CommittedDefinition, error) {
+	fail := func(err error) error {
+		return errors.WrapWithContext("queryDefinition", err, map[string]interface{}{
+			"chaincode": name,
+			"channel":   inv.network.Channel.Name,
+		})
+	}
+
+	args, err := proto.Marshal(&lifecycle.QueryChaincodeDefinitionArgs{Name: name})
+	if err != nil {
+		return nil, fail(err)
+	}
+	prop, err := newProposal(id, inv.network.Channel.Name, &InvokeRequest{
+		Chaincode: lifecycleNamespace,
+		Function:  "QueryChaincodeDefinition",
+		Args:      []string{string(args)},
+	})
+	if err != nil {
+		return nil, fail(err)
+	}
+
+	resp, err := inv.evaluate(ctx, client, id, prop)
+	if err != nil {
+		if isNotDefined(gatewayMessage(err), name) {
+			return nil, nil
+		}
+		return nil, gatewayError("queryDefinition", err, map[string]interface{}{
+			"chaincode": name,
+			"channel":   inv.network.Channel.Name,
+		})
+	}
+	if resp.GetStatus() >= 400 {
+		if isNotDefined(resp.Message, name) {
+			return nil, nil
+		}
+		return nil, fail(fmt.Errorf("chaincode response %d, %s", resp.Status, resp.Message))
+	}
+
+	result := &lifecycle.QueryChaincodeDefinitionResult{}
+	if err := proto.Unmarshal(resp.Payload, result); err != nil {
+		return nil, fail(err)
+	}
+	return &CommittedDefinition{
+		Name:                name,
+		Sequence:            result.Sequence,
+		Version:             result.Version,
+		EndorsementPlugin:   result.EndorsementPlugin,
+		ValidationPlugin:    result.ValidationPlugin,
+		ValidationParameter: base64.StdEncoding.EncodeToString(result.ValidationParameter),
+		InitRequired:        result.InitRequired,
+		Approvals:           result.Approvals,
+	}, nil
+}
+
+func parseCommittedDefinition(output []byte) (*CommittedDefinition, error) {
+	// Skip any log lines printed before the JSON document
+	start := strings.Index(string(output), "{")
+	if start < 0 {
+		return nil, fmt.Errorf("no chaincode definition in output")
+	}
+
+	var def CommittedDefinition
+	if err := json.Unmarshal(output[start:], &def); err != nil {
+		return nil, err
+	}
+	if err := def.decode(); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// decode fills in the fields that need more than JSON decoding
+func (def *CommittedDefinition) decode() error {
+	// The peer prints int64 fields either as numbers or as strings
+	seq, err := strconv.ParseInt(strings.Trim(string(def.RawSequence), `"`), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid sequence %s", def.RawSequence)
+	}
+	def.Sequence = seq
+
+	collections, err := decodeCollections(def.RawCollections)
+	if err != nil {
+		return fmt.Errorf("invalid collections: %v", err)
+	}
+	def.Collections = collections
+	return nil
+}
+
+// isNotDefined reports whether peer output says the chaincode does not exist
+// on the channel
+func isNotDefined(output, name string) bool {
+	return strings.Contains(output, fmt.Sprintf("namespace %s is not defined", name))
+}
+
+// EndorsementPolicy decodes the committed validation parameter into either
+// a signature policy or a channel config policy reference
+func (def *CommittedDefinition) EndorsementPolicy() (*Policy, string, error) {
+	raw, err := base64.StdEncoding.DecodeString(def.ValidationParameter)
+	if err != nil {
+		return nil, "", errors.WrapWithContext("EndorsementPolicy", err, map[string]interface{}{
+			"chaincode": def.Name,
+		})
+	}
+
+	policy, ref, err := decodeApplicationPolicy(raw)
+	if err != nil {
+		return nil, "", errors.WrapWithContext("EndorsementPolicy", err, map[string]interface{}{
+			"chaincode": def.Name,
+		})
+	}
+	return policy, ref, nil
+}
+
+// MSP role values of the MSPRole protobuf enum
+var mspRoles = map[uint64]string{0: RoleMember, 1: RoleAdmin, 2: RoleClient, 3: RolePeer, 4: RoleOrderer}
+
+// forEachField walks the top level fields of a protobuf message. Varint
+// fields are passed in x, length-delimited fields in v.
+func forEachField(b []byte, fn func(num protowire.Number, v []byte, x uint64) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		var v []byte
+		var x uint64
+		switch typ {
+		case protowire.BytesType:
+			v, n = protowire.ConsumeBytes(b)
+		case protowire.VarintType:
+			x, n = protowire.ConsumeVarint(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		if err := fn(num, v, x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeApplicationPolicy decodes a peer.ApplicationPolicy message
+func decodeApplicationPolicy(b []byte) (*Policy, string, error) {
+	var policy *Policy
+	ref := ""
+	err := forEachField(b, func(num protowire.Number, v []byte, x uint64) error {
+		var err error
+		switch num {
+		case 1: // signature_policy
+			policy, err = decodeSignaturePolicyEnvelope(v)
+		case 2: // channel_config_policy_reference
+			ref = string(v)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if policy == nil && ref == "" {
+		return nil, "", fmt.Errorf("empty application policy")
+	}
+	return policy, ref, nil
+}
+
+// decodeSignaturePolicyEnvelope decodes a common.SignaturePolicyEnvelope
+func decodeSignaturePolicyEnvelope(b []byte) (*Policy, error) {
+	var rule []byte
+	identities := []*Policy{}
+	err := forEachField(b, func(num protowire.Number, v []byte, x uint64) error {
+		switch num {
+		case 2: // rule
+			rule = v
+		case 3: // identities
+			principal, err := decodeMSPPrincipal(v)
+			if err != nil {
+				return err
+			}
+			identities = append(identities, principal)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeSignaturePolicy(rule, identities)
+}
+
+// decodeMSPPrincipal decodes a role based msp.MSPPrincipal
+func decodeMSPPrincipal(b []byte) (*Policy, error) {
+	var classification uint64
+	var principal []byte
+	err := forEachField(b, func(num protowire.Number, v []byte, x uint64) error {
+		switch num {
+		case 1:
+			classification = x
+		case 2:
+			principal = v
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if classification != 0 {
+		return nil, fmt.Errorf("unsupported principal classification %d", classification)
+	}
+
+	return decodeMSPRole(principal)
+}
+
+// decodeMSPRole decodes an msp.MSPRole
+func decodeMSPRole(principal []byte) (*Policy, error) {
+	role := &Policy{Role: RoleMember}
+	err := forEachField(principal, func(num protowire.Number, v []byte, x uint64) error {
+		switch num {
+		case 1:
+			role.MSPID = string(v)
+		case 2:
+			name, ok := mspRoles[x]
+			if !ok {
+				return fmt.Errorf("unknown MSP role %d", x)
+			}
+			role.Role = name
+		}
+		return nil
+	})
+	return role, err
+}
+
+// decodeSignaturePolicy decodes a common.SignaturePolicy whose signed_by
+// values index into identities
+func decodeSignaturePolicy(b []byte, identities []*Policy) (*Policy, error) {
+	var result *Policy
+	err := forEachField(b, func(num protowire.Number, v []byte, x uint64) error {
+		switch num {
+		case 1: // signed_by
+			if int(x) >= len(identities) {
+				return fmt.Errorf("signed_by %d out of range", x)
+			}
+			principal := *identities[x]
+			result = &principal
+		case 2: // n_out_of
+			node := &Policy{}
+			err := forEachField(v, func(num protowire.Number, v []byte, x uint64) error {
+				switch num {
+				case 1:
+					node.N = int(x)
+				case 2:
+					rule, err := decodeSignaturePolicy(v, identities)
+					if err != nil {
+						return err
+					}
+					node.Rules = append(node.Rules, rule)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			result = node
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, fmt.Errorf("empty signature policy")
+	}
+	return result, nil
+}
@@ -0,0 +1,111 @@
+// core/pkg/chaincode/parallel.go
+package chaincode
+
+import (
+	"context"
+	"sync"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// maxParallel bounds how many peers or orgs a lifecycle step works on at once
+var maxParallel = 4
+
+// peerTarget is a peer with the org it belongs to
+type peerTarget struct {
+	org  *network.Organization
+	peer *network.Peer
+}
+
+// runParallel calls fn for indexes 0..n-1, at most maxParallel at a time,
+// and returns the error of each call. Calls not started before the context
+// is cancelled get its error.
+func runParallel(ctx context.Context, n int, fn func(i int) error) []error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, maxParallel)
+	var wg sync.WaitGroup
+
+	for i := 0; i < n; i++ {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+	return errs
+}
+
+// forEachPeer runs fn on every peer of the network in parallel. The error
+// names every peer that failed, not only the first.
+func (d *Deployer) forEachPeer(ctx context.Context, op string, fn func(org *network.Organization, peer *network.Peer) error) error {
+	targets := []peerTarget{}
+	for _, org := range d.network.Orgs {
+		for _, peer := range org.Peers {
+			targets = append(targets, peerTarget{org: org, peer: peer})
+		}
+	}
+
+	errs := runParallel(ctx, len(targets), func(i int) error {
+		return fn(targets[i].org, targets[i].peer)
+	})
+
+	failed := []string{}
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, targets[i].peer.Name)
+			errs[i] = errors.WrapWithContext(op, err, map[string]interface{}{
+				"peer": targets[i].peer.Name,
+				"org":  targets[i].org.Name,
+			})
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return errors.WrapWithContext(op, errors.Join(errs...), map[string]interface{}{
+		"failed": failed,
+	})
+}
+
+// forEachOrg runs fn for every org of the network in parallel. The error
+// names every org that failed, not only the first.
+func (d *Deployer) forEachOrg(ctx context.Context, op string, fn func(org *network.Organization) error) error {
+	orgs := d.network.Orgs
+	errs := runParallel(ctx, len(orgs), func(i int) error {
+		return fn(orgs[i])
+	})
+
+	failed := []string{}
+	for i, err := range errs {
+		if err != nil {
+			failed = append(failed, orgs[i].Name)
+			errs[i] = errors.WrapWithContext(op, err, map[string]interface{}{
+				"org": orgs[i].Name,
+			})
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return errors.WrapWithContext(op, errors.Join(errs...), map[string]interface{}{
+		"failed": failed,
+	})
+}
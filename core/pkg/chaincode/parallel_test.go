@@ -0,0 +1,150 @@
+// core/pkg/chaincode/parallel_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+func TestParallelLifecycleSteps(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	// Both installs and both approvals must be in flight together to finish
+	var installs, approvals sync.WaitGroup
+	installs.Add(2)
+	approvals.Add(2)
+	wait := func(wg *sync.WaitGroup) error {
+		wg.Done()
+		done := make(chan struct{})
+		go func() { wg.Wait(); close(done) }()
+		select {
+		case <-done:
+			return nil
+		case <-time.After(5 * time.Second):
+			return fmt.Errorf("step ran sequentially")
+		}
+	}
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "package"):
+			writePackageOutput(t, args, "package bytes")
+		case contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case contains(args, "install"):
+			if err := wait(&installs); err != nil {
+				return nil, err
+			}
+			return []byte(stagedInstallOutput), nil
+		case contains(args, "approveformyorg"):
+			if err := wait(&approvals); err != nil {
+				return nil, err
+			}
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	if _, err := deployer.Deploy(context.Background(), &DeployRequest{Name: "mycc", Path: "/chaincode/mycc"}); err != nil {
+		t.Fatalf("Deploy() error = %v", err)
+	}
+
+	copies := 0
+	for _, call := range mockExec.GetCalls() {
+		if contains(call.Args, "cp") {
+			copies++
+		}
+	}
+	if copies != 1 {
+		t.Errorf("Expected the package copied into the cli container once, got %d", copies)
+	}
+}
+
+func TestParallelInstallReportsEveryFailure(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "package"):
+			writePackageOutput(t, args, "package bytes")
+		case contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case contains(args, "install"):
+			return []byte("Error: chaincode install failed: docker build failed"), fmt.Errorf("exit status 1")
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	_, err := deployer.Deploy(context.Background(), &DeployRequest{Name: "mycc", Path: "/chaincode/mycc"})
+	if !stdErr.Is(err, errors.ErrChaincodeDeployFailed) {
+		t.Fatalf("Expected ErrChaincodeDeployFailed, got %v", err)
+	}
+	for _, org := range net.Orgs {
+		for _, peer := range org.Peers {
+			if !strings.Contains(err.Error(), peer.Name) {
+				t.Errorf("Expected %s in the error, got %v", peer.Name, err)
+			}
+		}
+	}
+	for _, call := range mockExec.GetCalls() {
+		if contains(call.Args, "approveformyorg") {
+			t.Fatal("Expected no approval after a failed install")
+		}
+	}
+}
+
+func TestRunParallelBound(t *testing.T) {
+	defer func(n int) { maxParallel = n }(maxParallel)
+	maxParallel = 2
+
+	var running, peak atomic.Int32
+	errs := runParallel(context.Background(), 6, func(i int) error {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if n <= old || peak.CompareAndSwap(old, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		if i == 3 {
+			return fmt.Errorf("call %d failed", i)
+		}
+		return nil
+	})
+
+	if peak.Load() > 2 {
+		t.Errorf("Expected at most 2 calls at once, got %d", peak.Load())
+	}
+	for i, err := range errs {
+		if (err != nil) != (i == 3) {
+			t.Errorf("Unexpected error for call %d: %v", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs = runParallel(ctx, 3, func(i int) error { return nil })
+	for i, err := range errs {
+		if !stdErr.Is(err, context.Canceled) {
+			t.Errorf("Expected call %d to be cancelled, got %v", i, err)
+		}
+	}
+}
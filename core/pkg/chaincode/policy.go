@@ -0,0 +1,244 @@
+// core/pkg/chaincode/policy.go
+package chaincode
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// Principal roles accepted in signature policies
+const (
+	RoleMember  = "member"
+	RoleAdmin   = "admin"
+	RoleClient  = "client"
+	RolePeer    = "peer"
+	RoleOrderer = "orderer"
+)
+
+// Policy is a parsed signature policy expression such as
+// AND('Org1MSP.peer', OutOf(1, 'Org2MSP.member', 'Org3MSP.member'))
+type Policy struct {
+	// Threshold node: N of Rules must be satisfied. AND and OR are
+	// OutOf(len(Rules)) and OutOf(1).
+	N     int
+	Rules []*Policy
+
+	// Principal leaf: MSPID is set and Rules is empty
+	MSPID string
+	Role  string
+}
+
+// ParsePolicy parses a signature policy expression
+func ParsePolicy(expr string) (*Policy, error) {
+	p := &policyParser{input: expr}
+	policy, err := p.parseExpr()
+	if err == nil {
+		p.skipSpace()
+		if p.pos < len(p.input) {
+			err = p.errorf("unexpected %q", p.input[p.pos:])
+		}
+	}
+	if err != nil {
+		return nil, errors.WrapWithContext("ParsePolicy", errors.ErrInvalidConfig, map[string]interface{}{
+			"policy": expr,
+			"reason": err.Error(),
+		})
+	}
+	return policy, nil
+}
+
+// IsPrincipal reports whether the policy is a single principal
+func (p *Policy) IsPrincipal() bool {
+	return p.MSPID != ""
+}
+
+// MSPIDs returns the distinct MSP IDs the policy references, sorted
+func (p *Policy) MSPIDs() []string {
+	seen := map[string]bool{}
+	var walk func(*Policy)
+	walk = func(node *Policy) {
+		if node.IsPrincipal() {
+			seen[node.MSPID] = true
+		}
+		for _, rule := range node.Rules {
+			walk(rule)
+		}
+	}
+	walk(p)
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Validate checks that every principal belongs to one of the given MSPs
+func (p *Policy) Validate(mspIDs []string) error {
+	known := map[string]bool{}
+	for _, id := range mspIDs {
+		known[id] = true
+	}
+
+	unknown := []string{}
+	for _, id := range p.MSPIDs() {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		return errors.WrapWithContext("ValidatePolicy", errors.ErrInvalidConfig, map[string]interface{}{
+			"policy":  p.String(),
+			"reason":  fmt.Sprintf("unknown MSP ID %s", strings.Join(unknown, ", ")),
+			"network": strings.Join(mspIDs, ", "),
+		})
+	}
+	return nil
+}
+
+// String renders the policy in the syntax accepted by peer --signature-policy
+func (p *Policy) String() string {
+	if p.IsPrincipal() {
+		return fmt.Sprintf("'%s.%s'", p.MSPID, p.Role)
+	}
+
+	rules := make([]string, len(p.Rules))
+	for i, rule := range p.Rules {
+		rules[i] = rule.String()
+	}
+
+	switch {
+	case p.N == len(p.Rules) && len(p.Rules) > 1:
+		return fmt.Sprintf("AND(%s)", strings.Join(rules, ","))
+	case p.N == 1:
+		return fmt.Sprintf("OR(%s)", strings.Join(rules, ","))
+	default:
+		return fmt.Sprintf("OutOf(%d,%s)", p.N, strings.Join(rules, ","))
+	}
+}
+
+type policyParser struct {
+	input string
+	pos   int
+}
+
+func (p *policyParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("at position %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *policyParser) skipSpace() {
+	for p.pos < len(p.input) && unicode.IsSpace(rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *policyParser) consume(c byte) bool {
+	p.skipSpace()
+	if p.pos < len(p.input) && p.input[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *policyParser) parseExpr() (*Policy, error) {
+	p.skipSpace()
+	if p.pos >= len(p.input) {
+		return nil, p.errorf("expected expression")
+	}
+
+	if c := p.input[p.pos]; c == '\'' || c == '"' {
+		return p.parsePrincipal(c)
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && unicode.IsLetter(rune(p.input[p.pos])) {
+		p.pos++
+	}
+	op := strings.ToUpper(p.input[start:p.pos])
+	if op != "AND" && op != "OR" && op != "OUTOF" {
+		p.pos = start
+		return nil, p.errorf("expected AND, OR, OutOf or a quoted principal")
+	}
+
+	if !p.consume('(') {
+		return nil, p.errorf("expected ( after %s", p.input[start:p.pos])
+	}
+
+	n := 0
+	if op == "OUTOF" {
+		p.skipSpace()
+		numStart := p.pos
+		for p.pos < len(p.input) && unicode.IsDigit(rune(p.input[p.pos])) {
+			p.pos++
+		}
+		value, err := strconv.Atoi(p.input[numStart:p.pos])
+		if err != nil {
+			return nil, p.errorf("OutOf expects a number as its first argument")
+		}
+		n = value
+		if !p.consume(',') {
+			return nil, p.errorf("expected , after OutOf threshold")
+		}
+	}
+
+	rules := []*Policy{}
+	for {
+		rule, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+
+		if p.consume(',') {
+			continue
+		}
+		if p.consume(')') {
+			break
+		}
+		return nil, p.errorf("expected , or )")
+	}
+
+	switch op {
+	case "AND":
+		n = len(rules)
+	case "OR":
+		n = 1
+	case "OUTOF":
+		if n < 1 || n > len(rules) {
+			return nil, p.errorf("OutOf threshold %d must be between 1 and %d", n, len(rules))
+		}
+	}
+
+	return &Policy{N: n, Rules: rules}, nil
+}
+
+func (p *policyParser) parsePrincipal(quote byte) (*Policy, error) {
+	p.pos++
+	end := strings.IndexByte(p.input[p.pos:], quote)
+	if end < 0 {
+		return nil, p.errorf("unterminated principal")
+	}
+	principal := p.input[p.pos : p.pos+end]
+	p.pos += end + 1
+
+	dot := strings.LastIndex(principal, ".")
+	if dot <= 0 {
+		return nil, fmt.Errorf("principal %q must be of the form 'MSPID.role'", principal)
+	}
+
+	role := principal[dot+1:]
+	switch role {
+	case RoleMember, RoleAdmin, RoleClient, RolePeer, RoleOrderer:
+	default:
+		return nil, fmt.Errorf("principal %q has unknown role %q (expected member, admin, client, peer or orderer)", principal, role)
+	}
+
+	return &Policy{MSPID: principal[:dot], Role: role}, nil
+}
@@ -0,0 +1,216 @@
+// core/pkg/chaincode/policy_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		want    string
+		wantIDs []string
+	}{
+		{
+			name:    "single principal",
+			expr:    "'Org1MSP.peer'",
+			want:    "'Org1MSP.peer'",
+			wantIDs: []string{"Org1MSP"},
+		},
+		{
+			name:    "AND",
+			expr:    "AND('Org1MSP.peer', 'Org2MSP.peer')",
+			want:    "AND('Org1MSP.peer','Org2MSP.peer')",
+			wantIDs: []string{"Org1MSP", "Org2MSP"},
+		},
+		{
+			name:    "nested with OutOf",
+			expr:    `OR('Org1MSP.admin', OutOf(2, "Org2MSP.member", 'Org3MSP.client', 'Org1MSP.member'))`,
+			want:    "OR('Org1MSP.admin',OutOf(2,'Org2MSP.member','Org3MSP.client','Org1MSP.member'))",
+			wantIDs: []string{"Org1MSP", "Org2MSP", "Org3MSP"},
+		},
+		{
+			name:    "lower case operators",
+			expr:    "and('Org1MSP.member', or('Org2MSP.member', 'Org3MSP.member'))",
+			want:    "AND('Org1MSP.member',OR('Org2MSP.member','Org3MSP.member'))",
+			wantIDs: []string{"Org1MSP", "Org2MSP", "Org3MSP"},
+		},
+		{
+			name:    "MSP ID with dots",
+			expr:    "OR('org1.example.com.member')",
+			want:    "OR('org1.example.com.member')",
+			wantIDs: []string{"org1.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParsePolicy(tt.expr)
+			if err != nil {
+				t.Fatalf("ParsePolicy() error = %v", err)
+			}
+
+			if policy.String() != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, policy.String())
+			}
+
+			if strings.Join(policy.MSPIDs(), ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("Expected MSP IDs %v, got %v", tt.wantIDs, policy.MSPIDs())
+			}
+		})
+	}
+}
+
+func TestParsePolicyErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		reason string
+	}{
+		{name: "empty", expr: "", reason: "expected expression"},
+		{name: "unknown operator", expr: "XOR('Org1MSP.member')", reason: "expected AND, OR, OutOf"},
+		{name: "unknown role", expr: "OR('Org1MSP.owner')", reason: `unknown role "owner"`},
+		{name: "missing role", expr: "OR('Org1MSP')", reason: "must be of the form 'MSPID.role'"},
+		{name: "unbalanced", expr: "AND('Org1MSP.member', 'Org2MSP.member'", reason: "expected , or )"},
+		{name: "trailing input", expr: "OR('Org1MSP.member') junk", reason: `unexpected "junk"`},
+		{name: "threshold too high", expr: "OutOf(3, 'Org1MSP.member', 'Org2MSP.member')", reason: "threshold 3 must be between 1 and 2"},
+		{name: "threshold not a number", expr: "OutOf(two, 'Org1MSP.member')", reason: "expects a number"},
+		{name: "unterminated principal", expr: "OR('Org1MSP.member)", reason: "unterminated principal"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParsePolicy(tt.expr)
+			if !stdErr.Is(err, errors.ErrInvalidConfig) {
+				t.Fatalf("Expected ErrInvalidConfig, got %v", err)
+			}
+
+			if !strings.Contains(err.Error(), tt.reason) {
+				t.Errorf("Expected error to mention %q, got: %v", tt.reason, err)
+			}
+		})
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	policy, err := ParsePolicy("AND('Org1MSP.peer', 'Org9MSP.peer')")
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	err = policy.Validate([]string{"Org1MSP", "Org2MSP"})
+	if !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Fatalf("Expected ErrInvalidConfig, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "unknown MSP ID Org9MSP") {
+		t.Errorf("Expected unknown MSP in error, got: %v", err)
+	}
+
+	if err := policy.Validate([]string{"Org1MSP", "Org9MSP"}); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestDeployEndorsementPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *DeployRequest
+		wantArgs []string
+		wantErr  bool
+	}{
+		{
+			name:     "signature policy",
+			req:      &DeployRequest{EndorsementPolicy: "AND('Org1MSP.peer', 'Org2MSP.peer')"},
+			wantArgs: []string{"--signature-policy", "AND('Org1MSP.peer','Org2MSP.peer')"},
+		},
+		{
+			name:     "channel config policy",
+			req:      &DeployRequest{EndorsementPolicy: "/Channel/Application/Endorsement"},
+			wantArgs: []string{"--channel-config-policy", "/Channel/Application/Endorsement"},
+		},
+		{
+			name:     "default",
+			req:      &DeployRequest{},
+			wantArgs: []string{"--signature-policy", "OR('Org1MSP.member','Org2MSP.member')"},
+		},
+		{
+			name:    "unknown MSP",
+			req:     &DeployRequest{EndorsementPolicy: "AND('Org1MSP.peer', 'Org3MSP.peer')"},
+			wantErr: true,
+		},
+		{
+			name:    "malformed channel policy",
+			req:     &DeployRequest{EndorsementPolicy: "/Application/Endorsement"},
+			wantErr: true,
+		},
+		{
+			name:    "policy and orgs",
+			req:     &DeployRequest{EndorsementPolicy: "OR('Org1MSP.peer')", EndorsementPolicyOrgs: []string{"Org1"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				if contains(args, "package") {
+					writePackageOutput(t, args, "package bytes")
+					return []byte("success"), nil
+				}
+				if contains(args, "querycommitted") {
+					return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+				}
+				if contains(args, "queryinstalled") {
+					return []byte("Package ID: mycc_1.0:hash123, Label: mycc_1.0"), nil
+				}
+				return []byte("success"), nil
+			}
+
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+			tt.req.Name = "mycc"
+			tt.req.Path = "/chaincode/mycc"
+			_, err := deployer.Deploy(context.Background(), tt.req)
+			if tt.wantErr {
+				if !stdErr.Is(err, errors.ErrInvalidConfig) {
+					t.Errorf("Expected ErrInvalidConfig, got %v", err)
+				}
+				if len(mockExec.Calls) != 0 {
+					t.Error("Expected policy to be rejected before any docker call")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Deploy() error = %v", err)
+			}
+
+			checked := 0
+			for _, call := range mockExec.Calls {
+				if !contains(call.Args, "approveformyorg") && !contains(call.Args, "commit") {
+					continue
+				}
+				checked++
+				if !strings.Contains(strings.Join(call.Args, " "), strings.Join(tt.wantArgs, " ")) {
+					t.Errorf("Expected %v in %v", tt.wantArgs, call.Args)
+				}
+			}
+			if checked != 3 {
+				t.Errorf("Expected 2 approvals and a commit, got %d", checked)
+			}
+		})
+	}
+}
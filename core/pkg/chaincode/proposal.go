@@ -0,0 +1,311 @@
+// core/pkg/chaincode/proposal.go
+package chaincode
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/fabric/common"
+	"github.com/temmyjay001/core/pkg/fabric/ledger/rwset"
+	"github.com/temmyjay001/core/pkg/fabric/ledger/rwset/kvrwset"
+	"github.com/temmyjay001/core/pkg/fabric/msp"
+	"github.com/temmyjay001/core/pkg/fabric/peer"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// signingIdentity is the certificate and private key of an MSP folder that
+// proposals are signed with
+type signingIdentity struct {
+	mspID string
+	cert  []byte // PEM
+	key   *ecdsa.PrivateKey
+}
+
+// loadSigningIdentity reads the signer's certificate and key from its MSP
+// folder on the host
+func loadSigningIdentity(net *network.Network, signer *network.Signer) (*signingIdentity, error) {
+	mspDir := net.IdentityMSPPath(signer.Org, signer.Name)
+	fail := func(reason string) error {
+		return errors.WrapWithContext("loadSigningIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"org":      signer.Org.Name,
+			"identity": signer.Name,
+			"msp":      mspDir,
+			"reason":   reason,
+		})
+	}
+
+	cert, err := readFirstFile(filepath.Join(mspDir, "signcerts"))
+	if err != nil {
+		return nil, fail(fmt.Sprintf("no signing certificate: %v", err))
+	}
+	keyPEM, err := readFirstFile(filepath.Join(mspDir, "keystore"))
+	if err != nil {
+		return nil, fail(fmt.Sprintf("no private key: %v", err))
+	}
+
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fail("private key is not PEM encoded")
+	}
+	var key *ecdsa.PrivateKey
+	if parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		ecKey, ok := parsed.(*ecdsa.PrivateKey)
+		if !ok {
+			return nil, fail(fmt.Sprintf("unsupported private key type %T, only ECDSA keys can sign", parsed))
+		}
+		key = ecKey
+	} else if key, err = x509.ParseECPrivateKey(block.Bytes); err != nil {
+		return nil, fail(fmt.Sprintf("invalid private key: %v", err))
+	}
+
+	return &signingIdentity{mspID: signer.Org.MSPID, cert: cert, key: key}, nil
+}
+
+// readFirstFile reads the first file of a folder, which is how MSP folders
+// hold their single certificate or key
+func readFirstFile(dir string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			return os.ReadFile(filepath.Join(dir, entry.Name()))
+		}
+	}
+	return nil, fmt.Errorf("%s is empty", dir)
+}
+
+// creator returns the serialized identity proposals carry
+func (id *signingIdentity) creator() ([]byte, error) {
+	return proto.Marshal(&msp.SerializedIdentity{Mspid: id.mspID, IdBytes: id.cert})
+}
+
+// sign signs the SHA-256 digest of msg. Fabric only accepts low-S ECDSA
+// signatures, so S is normalized the way its BCCSP does.
+func (id *signingIdentity) sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, id.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	halfOrder := new(big.Int).Rsh(id.key.Curve.Params().N, 1)
+	if s.Cmp(halfOrder) > 0 {
+		s.Sub(id.key.Curve.Params().N, s)
+	}
+	return asn1.Marshal(struct{ R, S *big.Int }{r, s})
+}
+
+// proposal is a signed chaincode proposal and the transaction ID it carries
+type proposal struct {
+	txID   string
+	signed *peer.SignedProposal
+}
+
+// newProposal builds and signs the proposal of an invoke request. The
+// transaction ID is derived from a random nonce and the creator, as Fabric
+// requires.
+func newProposal(id *signingIdentity, channel string, req *InvokeRequest) (*proposal, error) {
+	creator, err := id.creator()
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, 24)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(append(append([]byte{}, nonce...), creator...))
+	txID := hex.EncodeToString(sum[:])
+
+	chaincodeID := &peer.ChaincodeID{Name: req.Chaincode}
+	extension, err := proto.Marshal(&peer.ChaincodeHeaderExtension{ChaincodeId: chaincodeID})
+	if err != nil {
+		return nil, err
+	}
+	channelHeader, err := proto.Marshal(&common.ChannelHeader{
+		Type:      int32(common.HeaderType_ENDORSER_TRANSACTION),
+		Timestamp: timestamppb.Now(),
+		ChannelId: channel,
+		TxId:      txID,
+		Extension: extension,
+	})
+	if err != nil {
+		return nil, err
+	}
+	signatureHeader, err := proto.Marshal(&common.SignatureHeader{Creator: creator, Nonce: nonce})
+	if err != nil {
+		return nil, err
+	}
+	header, err := proto.Marshal(&common.Header{ChannelHeader: channelHeader, SignatureHeader: signatureHeader})
+	if err != nil {
+		return nil, err
+	}
+
+	args := [][]byte{[]byte(req.Function)}
+	for _, arg := range req.Args {
+		args = append(args, []byte(arg))
+	}
+	input, err := proto.Marshal(&peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			ChaincodeId: chaincodeID,
+			Input:       &peer.ChaincodeInput{Args: args},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	payload, err := proto.Marshal(&peer.ChaincodeProposalPayload{Input: input, TransientMap: req.Transient})
+	if err != nil {
+		return nil, err
+	}
+
+	proposalBytes, err := proto.Marshal(&peer.Proposal{Header: header, Payload: payload})
+	if err != nil {
+		return nil, err
+	}
+	signature, err := id.sign(proposalBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proposal{
+		txID:   txID,
+		signed: &peer.SignedProposal{ProposalBytes: proposalBytes, Signature: signature},
+	}, nil
+}
+
+// dialPeerTLS connects to a peer's published port, verifying it against
+// the TLS CA of its org
+func (inv *Invoker) dialPeerTLS(org *network.Organization, p *network.Peer) (*grpc.ClientConn, error) {
+	creds, err := credentials.NewClientTLSFromFile(inv.network.PeerTLSCAPath(org, p), p.Name)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.NewClient(inv.network.PeerAddress(p), grpc.WithTransportCredentials(creds))
+}
+
+// endorsement is one peer's response to a proposal. err is set when the
+// peer could not be reached or its response could not be decoded.
+type endorsement struct {
+	org      *network.Organization
+	peer     *network.Peer
+	err      error
+	response *peer.ProposalResponse
+	action   *chaincodeAction
+}
+
+// processProposal sends a proposal to every endorser on its own connection
+// and returns their responses in endorser order
+func (inv *Invoker) processProposal(ctx context.Context, endorsers []endorser, prop *proposal) []*endorsement {
+	endorsements := make([]*endorsement, len(endorsers))
+	for i, e := range endorsers {
+		endorsements[i] = &endorsement{org: e.org, peer: e.peer}
+	}
+
+	errs := runParallel(ctx, len(endorsers), func(i int) error {
+		e := endorsements[i]
+		conn, err := inv.dialPeer(e.org, e.peer)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		resp, err := peer.NewEndorserClient(conn).ProcessProposal(ctx, prop.signed)
+		if err != nil {
+			return err
+		}
+		action, err := decodeChaincodeAction(resp)
+		if err != nil {
+			return fmt.Errorf("invalid proposal response: %v", err)
+		}
+		e.response = resp
+		e.action = action
+		return nil
+	})
+	for i, err := range errs {
+		endorsements[i].err = err
+	}
+	return endorsements
+}
+
+// chaincodeAction is what the chaincode did on one endorser
+type chaincodeAction struct {
+	event  *peer.ChaincodeEvent
+	rwsets []*nsRWSet
+}
+
+// nsRWSet is the read-write set of one chaincode namespace
+type nsRWSet struct {
+	namespace   string
+	kv          *kvrwset.KVRWSet
+	collections []*collectionRWSet
+}
+
+// collectionRWSet is the hashed read-write set of a private data
+// collection; endorsers only return hashes of private keys and values
+type collectionRWSet struct {
+	name   string
+	hashed *kvrwset.HashedRWSet
+}
+
+// decodeChaincodeAction unpacks the read-write set and event a peer
+// signed. Responses to failed proposals carry neither and decode to nil.
+func decodeChaincodeAction(resp *peer.ProposalResponse) (*chaincodeAction, error) {
+	if len(resp.Payload) == 0 {
+		return nil, nil
+	}
+
+	payload := &peer.ProposalResponsePayload{}
+	if err := proto.Unmarshal(resp.Payload, payload); err != nil {
+		return nil, err
+	}
+	action := &peer.ChaincodeAction{}
+	if err := proto.Unmarshal(payload.Extension, action); err != nil {
+		return nil, err
+	}
+
+	decoded := &chaincodeAction{}
+	if len(action.Events) > 0 {
+		decoded.event = &peer.ChaincodeEvent{}
+		if err := proto.Unmarshal(action.Events, decoded.event); err != nil {
+			return nil, err
+		}
+	}
+
+	txRWSet := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(action.Results, txRWSet); err != nil {
+		return nil, err
+	}
+	for _, ns := range txRWSet.NsRwset {
+		decodedNs := &nsRWSet{namespace: ns.Namespace, kv: &kvrwset.KVRWSet{}}
+		if err := proto.Unmarshal(ns.Rwset, decodedNs.kv); err != nil {
+			return nil, err
+		}
+		for _, coll := range ns.CollectionHashedRwset {
+			hashed := &kvrwset.HashedRWSet{}
+			if err := proto.Unmarshal(coll.HashedRwset, hashed); err != nil {
+				return nil, err
+			}
+			decodedNs.collections = append(decodedNs.collections, &collectionRWSet{name: coll.CollectionName, hashed: hashed})
+		}
+		decoded.rwsets = append(decoded.rwsets, decodedNs)
+	}
+	return decoded, nil
+}
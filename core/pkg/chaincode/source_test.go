@@ -0,0 +1,389 @@
+// core/pkg/chaincode/source_test.go
+package chaincode
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+// tarGzFiles builds a gzipped tar holding the given files
+func tarGzFiles(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write([]byte(content))
+	}
+	tw.Close()
+	gz.Close()
+	return buf.Bytes()
+}
+
+// lifecyclePackage builds a package the way peer lifecycle chaincode package does
+func lifecyclePackage(t *testing.T, language, label string) []byte {
+	code := tarGzFiles(t, map[string]string{"src/go.mod": "module mycc"})
+	return tarGzFiles(t, map[string]string{
+		"metadata.json": fmt.Sprintf(`{"type":%q,"label":%q}`, language, label),
+		"code.tar.gz":   string(code),
+	})
+}
+
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestSaveUpload(t *testing.T) {
+	pkg := lifecyclePackage(t, "golang", "mycc_1.0")
+
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	for name, content := range map[string]string{"go.mod": "module mycc", "main.go": "package main"} {
+		w, _ := zw.Create(name)
+		w.Write([]byte(content))
+	}
+	zw.Close()
+
+	tests := []struct {
+		name     string
+		kind     string
+		fileName string
+		data     []byte
+		sha      string
+		wantErr  string
+		wantFile string // Relative to the upload path
+	}{
+		{name: "package", kind: UploadPackage, fileName: "mycc.tar.gz", data: pkg, sha: digest(pkg)},
+		{name: "source tar.gz in a folder", kind: UploadSource, fileName: "mycc.tgz", data: tarGzFiles(t, map[string]string{"mycc/go.mod": "module mycc"}), wantFile: "go.mod"},
+		{name: "source zip", kind: UploadSource, fileName: "mycc.zip", data: zipped.Bytes(), wantFile: "main.go"},
+		{name: "corrupted upload", kind: UploadPackage, fileName: "mycc.tar.gz", data: pkg, sha: digest([]byte("other")), wantErr: "SHA-256"},
+		{name: "not a package", kind: UploadPackage, fileName: "mycc.tar.gz", data: tarGzFiles(t, map[string]string{"go.mod": "module mycc"}), wantErr: "metadata.json"},
+		{name: "ccaas package", kind: UploadPackage, fileName: "mycc.tar.gz", data: lifecyclePackage(t, "ccaas", "mycc_1.0"), wantErr: "cannot be deployed"},
+		{name: "escaping archive", kind: UploadSource, fileName: "mycc.tar.gz", data: tarGzFiles(t, map[string]string{"../evil": "x"}), wantErr: "outside the chaincode folder"},
+		{name: "unknown archive", kind: UploadSource, fileName: "mycc.rar", data: []byte("Rar!"), wantErr: "source archives"},
+		{name: "unknown kind", kind: "image", fileName: "mycc", data: pkg, wantErr: "kind must be"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			upload, err := SaveUpload(net, tt.kind, tt.fileName, tt.sha, bytes.NewReader(tt.data))
+			if tt.wantErr != "" {
+				if !stdErr.Is(err, errors.ErrInvalidConfig) || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected ErrInvalidConfig mentioning %q, got %v", tt.wantErr, err)
+				}
+				if entries, _ := os.ReadDir(filepath.Join(net.BasePath, "uploads")); len(entries) != 0 {
+					t.Error("Expected a failed upload to be removed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SaveUpload() error = %v", err)
+			}
+
+			if upload.Size != int64(len(tt.data)) || upload.SHA256 != digest(tt.data) {
+				t.Errorf("Expected size %d and digest %s, got %+v", len(tt.data), digest(tt.data), upload)
+			}
+			if tt.kind == UploadPackage && upload.PackageID != "mycc_1.0:"+digest(pkg) {
+				t.Errorf("Expected package ID mycc_1.0:%s, got %s", digest(pkg), upload.PackageID)
+			}
+			if tt.wantFile != "" {
+				if _, err := os.Stat(filepath.Join(upload.Path, tt.wantFile)); err != nil {
+					t.Errorf("Expected %s in the extracted source: %v", tt.wantFile, err)
+				}
+			}
+
+			found, err := LookupUpload(net, upload.ID)
+			if err != nil || found.Path != upload.Path {
+				t.Errorf("LookupUpload() = %+v, %v", found, err)
+			}
+		})
+	}
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+	for _, id := range []string{"../../etc", "3f2a6c1e-0000-4000-8000-000000000000"} {
+		if _, err := LookupUpload(net, id); !stdErr.Is(err, errors.ErrInvalidConfig) {
+			t.Errorf("Expected unknown upload %s to be rejected, got %v", id, err)
+		}
+	}
+}
+
+func TestExtractArchiveLimits(t *testing.T) {
+	// 8 MiB of zeros compress to a few KiB
+	bomb := strings.Repeat("\x00", 8<<20)
+
+	var zipped bytes.Buffer
+	zw := zip.NewWriter(&zipped)
+	w, _ := zw.Create("zeros")
+	w.Write([]byte(bomb))
+	zw.Close()
+
+	many := map[string]string{}
+	for i := 0; i < 5; i++ {
+		many[fmt.Sprintf("file%d", i)] = "x"
+	}
+
+	tests := []struct {
+		name     string
+		fileName string
+		data     []byte
+		wantErr  string
+	}{
+		{name: "tar.gz bomb", fileName: "mycc.tar.gz", data: tarGzFiles(t, map[string]string{"zeros": bomb}), wantErr: "more than 1 MiB"},
+		{name: "zip bomb", fileName: "mycc.zip", data: zipped.Bytes(), wantErr: "more than 1 MiB"},
+		{name: "too many entries", fileName: "mycc.tar.gz", data: tarGzFiles(t, many), wantErr: "more than 3 entries"},
+		{name: "within limits", fileName: "mycc.tar.gz", data: tarGzFiles(t, map[string]string{"go.mod": "module mycc"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.data) > 64<<10 {
+				t.Fatalf("Expected a small archive, got %d bytes", len(tt.data))
+			}
+			dir := t.TempDir()
+			file := filepath.Join(dir, "upload")
+			if err := os.WriteFile(file, tt.data, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			err := extractArchive(file, tt.fileName, filepath.Join(dir, "source"), &extractLimit{maxBytes: 1 << 20, maxEntries: 3})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("extractArchive() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error mentioning %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPruneUploads(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	pkg := lifecyclePackage(t, "golang", "mycc_1.0")
+	stale, err := SaveUpload(net, UploadPackage, "mycc.tar.gz", "", bytes.NewReader(pkg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-2 * UploadTTL)
+	if err := os.Chtimes(filepath.Join(uploadDir(net, stale.ID), "upload.json"), old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	// Saving another upload removes the stale one
+	fresh, err := SaveUpload(net, UploadPackage, "mycc.tar.gz", "", bytes.NewReader(pkg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LookupUpload(net, stale.ID); !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Errorf("Expected the stale upload removed, got %v", err)
+	}
+	if _, err := LookupUpload(net, fresh.ID); err != nil {
+		t.Errorf("Expected the fresh upload kept, got %v", err)
+	}
+
+	// Using an upload keeps it
+	if err := os.Chtimes(filepath.Join(uploadDir(net, fresh.ID), "upload.json"), old, old); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LookupUpload(net, fresh.ID); err != nil {
+		t.Fatal(err)
+	}
+	if removed, err := PruneUploads(net, UploadTTL); err != nil || removed != 0 {
+		t.Errorf("Expected the used upload kept, removed %d, %v", removed, err)
+	}
+}
+
+func TestDeployPackage(t *testing.T) {
+	pkg := lifecyclePackage(t, "golang", "mycc_prebuilt")
+	packageID := "mycc_prebuilt:" + digest(pkg)
+
+	tests := []struct {
+		name        string
+		packageID   string
+		installID   string
+		wantErr     error
+		wantInstall bool
+	}{
+		{name: "installs the package as is", packageID: packageID, installID: packageID, wantInstall: true},
+		{name: "wrong expected package ID", packageID: "mycc_prebuilt:" + digest([]byte("other")), wantErr: errors.ErrInvalidConfig},
+		{name: "peers report another package", installID: "mycc_prebuilt:" + digest([]byte("other")), wantErr: errors.ErrChaincodeDeployFailed, wantInstall: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			upload, err := SaveUpload(net, UploadPackage, "mycc.tar.gz", "", bytes.NewReader(pkg))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				switch {
+				case contains(args, "querycommitted"):
+					return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+				case contains(args, "install"):
+					return []byte("Chaincode code package identifier: " + tt.installID), nil
+				}
+				return []byte("success"), nil
+			}
+
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+			_, err = deployer.Deploy(context.Background(), &DeployRequest{Name: "mycc", UploadID: upload.ID, PackageID: tt.packageID})
+			if tt.wantErr != nil {
+				if !stdErr.Is(err, tt.wantErr) {
+					t.Errorf("Expected %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Deploy() error = %v", err)
+			}
+
+			installed := false
+			for _, call := range mockExec.GetCalls() {
+				if contains(call.Args, "package") {
+					t.Errorf("Expected a prebuilt package not to be repackaged, got %v", call.Args)
+				}
+				if contains(call.Args, "install") {
+					installed = true
+				}
+			}
+			if installed != tt.wantInstall {
+				t.Errorf("Expected install %v, got %v", tt.wantInstall, installed)
+			}
+			if tt.wantErr == nil {
+				if cc := net.Chaincode("mycc"); cc == nil || cc.PackageID != packageID || cc.Language != LanguageGo {
+					t.Errorf("Expected the package recorded, got %+v", cc)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveSource(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+	deployer := NewDeployer(net, nil, executor.NewMockExecutor())
+
+	tests := []struct {
+		name string
+		req  *DeployRequest
+	}{
+		{name: "no source", req: &DeployRequest{Name: "mycc"}},
+		{name: "two sources", req: &DeployRequest{Name: "mycc", Path: "./mycc", GitURL: "https://example.com/mycc.git"}},
+		{name: "unknown upload", req: &DeployRequest{Name: "mycc", UploadID: "nope"}},
+		{name: "option as git URL", req: &DeployRequest{Name: "mycc", GitURL: "--upload-pack=touch /tmp/x"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := deployer.resolveSource(context.Background(), tt.req); !stdErr.Is(err, errors.ErrInvalidConfig) {
+				t.Errorf("Expected ErrInvalidConfig, got %v", err)
+			}
+		})
+	}
+}
+
+func TestFetchGitSource(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+
+	// A local bare repository stands in for a remote one
+	work := t.TempDir()
+	bare := filepath.Join(t.TempDir(), "mycc.git")
+	git := func(dir string, args ...string) string {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=t", "GIT_AUTHOR_EMAIL=t@example.com", "GIT_COMMITTER_NAME=t", "GIT_COMMITTER_EMAIL=t@example.com")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+	commit := func(content string) string {
+		os.WriteFile(filepath.Join(work, "main.go"), []byte(content), 0644)
+		git(work, "add", "-A")
+		git(work, "commit", "-q", "-m", content)
+		return git(work, "rev-parse", "HEAD")
+	}
+
+	git(work, "init", "-q", "-b", "main")
+	first := commit("package first")
+	git(work, "tag", "v1")
+	commit("package second")
+	git(work, "checkout", "-q", "-b", "feature")
+	commit("package feature")
+	git(work, "checkout", "-q", "main")
+	git(t.TempDir(), "clone", "-q", "--bare", work, bare)
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+	deployer := NewDeployer(net, nil, executor.NewRealExecutor())
+
+	tests := []struct {
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{ref: "", want: "package second"},
+		{ref: "feature", want: "package feature"},
+		{ref: "v1", want: "package first"},
+		{ref: first, want: "package first"},
+		{ref: "missing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run("ref "+tt.ref, func(t *testing.T) {
+			req := &DeployRequest{Name: "mycc", GitURL: bare, GitRef: tt.ref}
+			err := deployer.resolveSource(context.Background(), req)
+			if tt.wantErr {
+				if !stdErr.Is(err, errors.ErrChaincodeDeployFailed) {
+					t.Errorf("Expected ErrChaincodeDeployFailed, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveSource() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(req.Path, "main.go"))
+			if err != nil || string(data) != tt.want {
+				t.Errorf("Expected %q checked out, got %q (%v)", tt.want, data, err)
+			}
+			if _, err := os.Stat(filepath.Join(req.Path, ".git")); !os.IsNotExist(err) {
+				t.Error("Expected the git metadata removed")
+			}
+		})
+	}
+}
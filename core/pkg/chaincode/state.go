@@ -0,0 +1,518 @@
+// core/pkg/chaincode/state.go
+package chaincode
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// InstalledPackage is a chaincode package installed on a peer
+type InstalledPackage struct {
+	PackageID  string
+	Label      string
+	References []string // Definitions using the package, as channel/name:version
+}
+
+// PeerPackages lists the packages installed on one peer
+type PeerPackages struct {
+	Org      string
+	Peer     string
+	Packages []*InstalledPackage
+}
+
+// ChannelChaincodes lists the definitions committed on one channel
+type ChannelChaincodes struct {
+	Channel   string
+	Committed []*CommittedDefinition
+}
+
+// LifecycleState is the chaincode lifecycle state of a network
+type LifecycleState struct {
+	Channels  []*ChannelChaincodes
+	Installed []*PeerPackages
+}
+
+// ChannelDefinition is the lifecycle state of a chaincode on one channel
+type ChannelDefinition struct {
+	Channel   string
+	Committed *CommittedDefinition // nil when never committed on the channel
+	// Pending is the definition approved for the next sequence, with the
+	// commit readiness of every channel member, or nil when nothing is
+	// pending
+	Pending *CommittedDefinition
+}
+
+// ChaincodeState is the lifecycle state of one chaincode
+type ChaincodeState struct {
+	Name      string
+	Channels  []*ChannelDefinition // Only channels the chaincode is defined or approved on
+	Installed []*PeerPackages      // Only packages of this chaincode
+}
+
+// ListChaincodes returns the installed packages of every peer and the
+// definitions committed on every channel
+func (d *Deployer) ListChaincodes(ctx context.Context) (*LifecycleState, error) {
+	state := &LifecycleState{}
+	for _, ch := range d.network.AllChannels() {
+		committed, err := d.queryCommittedAll(ctx, ch)
+		if err != nil {
+			return nil, errors.WrapWithContext("ListChaincodes", err, map[string]interface{}{
+				"channel": ch.Name,
+			})
+		}
+		state.Channels = append(state.Channels, &ChannelChaincodes{
+			Channel:   ch.Name,
+			Committed: committed,
+		})
+	}
+
+	installed, err := d.installedPackages(ctx, "")
+	if err != nil {
+		return nil, errors.Wrap("ListChaincodes", err)
+	}
+	state.Installed = installed
+
+	return state, nil
+}
+
+// ChaincodeDefinition returns, for every channel, the committed definition
+// of a chaincode and the definition pending at the next sequence with the
+// approval of every channel member, and the packages of the chaincode
+// installed on each peer
+func (d *Deployer) ChaincodeDefinition(ctx context.Context, name string) (*ChaincodeState, error) {
+	state := &ChaincodeState{Name: name, Channels: []*ChannelDefinition{}}
+	for _, ch := range d.network.AllChannels() {
+		def, err := d.channelDefinition(ctx, ch, name)
+		if err != nil {
+			return nil, errors.WrapWithContext("ChaincodeDefinition", err, map[string]interface{}{
+				"channel": ch.Name,
+			})
+		}
+		if def.Committed != nil || def.Pending != nil {
+			state.Channels = append(state.Channels, def)
+		}
+	}
+
+	installed, err := d.installedPackages(ctx, name)
+	if err != nil {
+		return nil, errors.Wrap("ChaincodeDefinition", err)
+	}
+	state.Installed = installed
+
+	if len(state.Channels) == 0 && len(installed) == 0 {
+		return nil, errors.WrapWithContext("ChaincodeDefinition", errors.ErrChaincodeNotFound, map[string]interface{}{
+			"chaincode": name,
+		})
+	}
+
+	return state, nil
+}
+
+// channelDefinition returns the committed and pending definitions of a
+// chaincode on one channel
+func (d *Deployer) channelDefinition(ctx context.Context, ch *network.Channel, name string) (*ChannelDefinition, error) {
+	orgs := d.network.ChannelOrgs(ch)
+	env := d.getPeerEnvArgs(orgs[0], orgs[0].Peers[0])
+	committed, err := queryCommitted(ctx, d.exec, d.network, env, ch.Name, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextSequence int64 = 1
+	if committed != nil {
+		nextSequence = committed.Sequence + 1
+	}
+
+	// Any member's approval tells which definition the next commit is for
+	var pending *CommittedDefinition
+	for _, org := range orgs {
+		approved, err := d.queryApproved(ctx, ch, org, name, nextSequence)
+		if err != nil {
+			return nil, errors.WrapWithContext("channelDefinition", err, map[string]interface{}{
+				"org": org.Name,
+			})
+		}
+		if approved != nil {
+			pending = approved
+			break
+		}
+	}
+
+	if pending != nil {
+		req, def, err := pendingDefinition(pending)
+		if err != nil {
+			return nil, err
+		}
+		approvals, err := d.checkCommitReadiness(ctx, ch, req, def)
+		if err != nil {
+			return nil, err
+		}
+		pending.Approvals = approvals
+	}
+
+	return &ChannelDefinition{
+		Channel:   ch.Name,
+		Committed: committed,
+		Pending:   pending,
+	}, nil
+}
+
+// queryCommittedAll returns every definition committed on a channel
+func (d *Deployer) queryCommittedAll(ctx context.Context, ch *network.Channel) ([]*CommittedDefinition, error) {
+	org := d.network.ChannelOrgs(ch)[0]
+	args := []string{"exec"}
+	args = append(args, d.getPeerEnvArgs(org, org.Peers[0])...)
+	args = append(args, "cli",
+		"peer", "lifecycle", "chaincode", "querycommitted",
+		"--channelID", ch.Name,
+		"--output", "json",
+		"--tls", "true",
+		"--cafile", d.network.OrdererTLSCA(),
+	)
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		return nil, errors.WrapWithContext("queryCommittedAll", err, map[string]interface{}{
+			"output": string(output),
+		})
+	}
+
+	var result struct {
+		Definitions []*CommittedDefinition `json:"chaincode_definitions"`
+	}
+	if err := decodePeerJSON(output, &result); err != nil {
+		return nil, errors.WrapWithContext("queryCommittedAll", err, map[string]interface{}{
+			"output": string(output),
+		})
+	}
+
+	for _, def := range result.Definitions {
+		if err := def.decode(); err != nil {
+			return nil, errors.WrapWithContext("queryCommittedAll", err, map[string]interface{}{
+				"chaincode": def.Name,
+			})
+		}
+	}
+	return result.Definitions, nil
+}
+
+// queryApproved returns the definition an org approved on a channel at a
+// sequence, or nil when it approved none
+func (d *Deployer) queryApproved(ctx context.Context, ch *network.Channel, org *network.Organization, name string, sequence int64) (*CommittedDefinition, error) {
+	args := []string{"exec"}
+	args = append(args, d.getPeerEnvArgs(org, org.Peers[0])...)
+	args = append(args, "cli",
+		"peer", "lifecycle", "chaincode", "queryapproved",
+		"--channelID", ch.Name,
+		"--name", name,
+		"--sequence", strconv.FormatInt(sequence, 10),
+		"--output", "json",
+		"--tls", "true",
+		"--cafile", d.network.OrdererTLSCA(),
+	)
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		if strings.Contains(string(output), "could not fetch approved chaincode definition") || isNotDefined(string(output), name) {
+			return nil, nil
+		}
+		return nil, errors.WrapWithContext("queryApproved", err, map[string]interface{}{
+			"chaincode": name,
+			"output":    string(output),
+		})
+	}
+
+	def, err := parseCommittedDefinition(output)
+	if err != nil {
+		return nil, errors.WrapWithContext("queryApproved", err, map[string]interface{}{
+			"chaincode": name,
+			"output":    string(output),
+		})
+	}
+	def.Name = name
+	return def, nil
+}
+
+// pendingDefinition turns a definition read from the peer back into the
+// values approve and commit take
+func pendingDefinition(pending *CommittedDefinition) (*DeployRequest, *definition, error) {
+	policy, ref, err := pending.EndorsementPolicy()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policyArgs := []string{"--channel-config-policy", ref}
+	if policy != nil {
+		policyArgs = []string{"--signature-policy", policy.String()}
+	}
+
+	req := &DeployRequest{Name: pending.Name, Version: pending.Version}
+	// The last deploy of the chaincode left its collections in the cli
+	// container
+	if len(pending.Collections) > 0 {
+		req.CollectionsConfig = collectionsConfigPath(pending.Name)
+	}
+
+	return req, &definition{
+		sequence:     pending.Sequence,
+		policyArgs:   policyArgs,
+		initRequired: pending.InitRequired,
+	}, nil
+}
+
+// checkCommitReadiness reports which members of a channel approved exactly
+// the given definition
+func (d *Deployer) checkCommitReadiness(ctx context.Context, ch *network.Channel, req *DeployRequest, def *definition) (map[string]bool, error) {
+	org := d.network.ChannelOrgs(ch)[0]
+	args := []string{"exec"}
+	args = append(args, d.getPeerEnvArgs(org, org.Peers[0])...)
+	args = append(args, "cli",
+		"peer", "lifecycle", "chaincode", "checkcommitreadiness",
+		"--channelID", ch.Name,
+		"--name", req.Name,
+		"--version", req.Version,
+		"--sequence", strconv.FormatInt(def.sequence, 10),
+		"--output", "json",
+		"--tls", "true",
+		"--cafile", d.network.OrdererTLSCA(),
+	)
+	args = append(args, def.policyArgs...)
+	args = append(args, d.collectionsConfigArgs(req)...)
+	if def.initRequired {
+		args = append(args, "--init-required")
+	}
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		return nil, errors.WrapWithContext("checkCommitReadiness", err, map[string]interface{}{
+			"chaincode": req.Name,
+			"output":    string(output),
+		})
+	}
+
+	var result struct {
+		Approvals map[string]bool `json:"approvals"`
+	}
+	if err := decodePeerJSON(output, &result); err != nil {
+		return nil, errors.WrapWithContext("checkCommitReadiness", err, map[string]interface{}{
+			"output": string(output),
+		})
+	}
+	return result.Approvals, nil
+}
+
+// installedPackages runs queryinstalled on every peer. A non-empty name
+// keeps only the packages of that chaincode.
+func (d *Deployer) installedPackages(ctx context.Context, name string) ([]*PeerPackages, error) {
+	result := []*PeerPackages{}
+	for _, org := range d.network.Orgs {
+		for _, peer := range org.Peers {
+			packages, err := d.queryInstalled(ctx, org, peer)
+			if err != nil {
+				return nil, errors.WrapWithContext("installedPackages", err, map[string]interface{}{
+					"peer": peer.Name,
+				})
+			}
+
+			if name != "" {
+				matching := []*InstalledPackage{}
+				for _, pkg := range packages {
+					if strings.HasPrefix(pkg.Label, name+"_") {
+						matching = append(matching, pkg)
+					}
+				}
+				if len(matching) == 0 {
+					continue
+				}
+				packages = matching
+			}
+
+			result = append(result, &PeerPackages{
+				Org:      org.Name,
+				Peer:     peer.Name,
+				Packages: packages,
+			})
+		}
+	}
+	return result, nil
+}
+
+// queryInstalled lists the packages installed on a peer
+func (d *Deployer) queryInstalled(ctx context.Context, org *network.Organization, peer *network.Peer) ([]*InstalledPackage, error) {
+	args := []string{"exec"}
+	args = append(args, d.getPeerEnvArgs(org, peer)...)
+	args = append(args, "cli",
+		"peer", "lifecycle", "chaincode", "queryinstalled",
+		"--output", "json",
+		"--tls", "true",
+		"--cafile", d.network.OrdererTLSCA(),
+	)
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		return nil, errors.WrapWithContext("queryInstalled", err, map[string]interface{}{
+			"output": string(output),
+		})
+	}
+
+	var result struct {
+		Installed []struct {
+			PackageID  string `json:"package_id"`
+			Label      string `json:"label"`
+			References map[string]struct {
+				Chaincodes []struct {
+					Name    string `json:"name"`
+					Version string `json:"version"`
+				} `json:"chaincodes"`
+			} `json:"references"`
+		} `json:"installed_chaincodes"`
+	}
+	if err := decodePeerJSON(output, &result); err != nil {
+		return nil, errors.WrapWithContext("queryInstalled", err, map[string]interface{}{
+			"output": string(output),
+		})
+	}
+
+	packages := make([]*InstalledPackage, 0, len(result.Installed))
+	for _, installed := range result.Installed {
+		pkg := &InstalledPackage{PackageID: installed.PackageID, Label: installed.Label}
+		for _, ch := range d.network.AllChannels() {
+			for _, cc := range installed.References[ch.Name].Chaincodes {
+				pkg.References = append(pkg.References, fmt.Sprintf("%s/%s:%s", ch.Name, cc.Name, cc.Version))
+			}
+		}
+		packages = append(packages, pkg)
+	}
+	return packages, nil
+}
+
+// decodePeerJSON decodes the JSON document of peer output, skipping any log
+// lines printed before it
+func decodePeerJSON(output []byte, v interface{}) error {
+	start := strings.Index(string(output), "{")
+	if start < 0 {
+		return fmt.Errorf("no JSON document in output")
+	}
+	return json.Unmarshal(output[start:], v)
+}
+
+// jsonSignaturePolicy is a common.SignaturePolicy as the peer prints it,
+// with oneof fields wrapped in their Go names
+type jsonSignaturePolicy struct {
+	Type struct {
+		SignedBy *int `json:"SignedBy"`
+		NOutOf   *struct {
+			N     int                    `json:"n"`
+			Rules []*jsonSignaturePolicy `json:"rules"`
+		} `json:"NOutOf"`
+	} `json:"Type"`
+}
+
+func (p *jsonSignaturePolicy) policy(identities []*Policy) (*Policy, error) {
+	switch {
+	case p.Type.SignedBy != nil:
+		if *p.Type.SignedBy < 0 || *p.Type.SignedBy >= len(identities) {
+			return nil, fmt.Errorf("signed_by %d out of range", *p.Type.SignedBy)
+		}
+		principal := *identities[*p.Type.SignedBy]
+		return &principal, nil
+	case p.Type.NOutOf != nil:
+		node := &Policy{N: p.Type.NOutOf.N}
+		for _, rule := range p.Type.NOutOf.Rules {
+			child, err := rule.policy(identities)
+			if err != nil {
+				return nil, err
+			}
+			node.Rules = append(node.Rules, child)
+		}
+		return node, nil
+	default:
+		return nil, fmt.Errorf("empty signature policy")
+	}
+}
+
+// decodeCollections decodes the collections of a definition printed by the
+// peer. Only static collections are supported.
+func decodeCollections(raw json.RawMessage) ([]*CollectionConfig, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var pkg struct {
+		Config []struct {
+			Payload struct {
+				StaticCollectionConfig *struct {
+					Name             string `json:"name"`
+					MemberOrgsPolicy struct {
+						Payload struct {
+							SignaturePolicy *struct {
+								Rule       *jsonSignaturePolicy `json:"rule"`
+								Identities []struct {
+									PrincipalClassification int    `json:"principal_classification"`
+									Principal               string `json:"principal"`
+								} `json:"identities"`
+							} `json:"SignaturePolicy"`
+						} `json:"Payload"`
+					} `json:"member_orgs_policy"`
+					RequiredPeerCount int    `json:"required_peer_count"`
+					MaximumPeerCount  int    `json:"maximum_peer_count"`
+					BlockToLive       uint64 `json:"block_to_live"`
+					MemberOnlyRead    bool   `json:"member_only_read"`
+					MemberOnlyWrite   bool   `json:"member_only_write"`
+				} `json:"StaticCollectionConfig"`
+			} `json:"Payload"`
+		} `json:"config"`
+	}
+	if err := json.Unmarshal(raw, &pkg); err != nil {
+		return nil, err
+	}
+
+	collections := []*CollectionConfig{}
+	for _, config := range pkg.Config {
+		static := config.Payload.StaticCollectionConfig
+		if static == nil {
+			continue
+		}
+
+		collection := &CollectionConfig{
+			Name:              static.Name,
+			RequiredPeerCount: static.RequiredPeerCount,
+			MaxPeerCount:      static.MaximumPeerCount,
+			BlockToLive:       static.BlockToLive,
+			MemberOnlyRead:    static.MemberOnlyRead,
+			MemberOnlyWrite:   static.MemberOnlyWrite,
+		}
+
+		if sig := static.MemberOrgsPolicy.Payload.SignaturePolicy; sig != nil && sig.Rule != nil {
+			identities := make([]*Policy, len(sig.Identities))
+			for i, identity := range sig.Identities {
+				if identity.PrincipalClassification != 0 {
+					return nil, fmt.Errorf("unsupported principal classification %d", identity.PrincipalClassification)
+				}
+				principal, err := base64.StdEncoding.DecodeString(identity.Principal)
+				if err != nil {
+					return nil, err
+				}
+				if identities[i], err = decodeMSPRole(principal); err != nil {
+					return nil, err
+				}
+			}
+
+			policy, err := sig.Rule.policy(identities)
+			if err != nil {
+				return nil, fmt.Errorf("collection %s: %v", static.Name, err)
+			}
+			collection.Policy = policy.String()
+		}
+
+		collections = append(collections, collection)
+	}
+	return collections, nil
+}
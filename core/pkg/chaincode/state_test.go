@@ -0,0 +1,202 @@
+// core/pkg/chaincode/state_test.go
+package chaincode
+
+import (
+	"context"
+	"encoding/base64"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/network"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const installedOutput = `{
+	"installed_chaincodes": [
+		{
+			"package_id": "mycc_1.0:aaa",
+			"label": "mycc_1.0",
+			"references": {"mychannel": {"chaincodes": [{"name": "mycc", "version": "1.0"}]}}
+		},
+		{"package_id": "mycc_2.0:bbb", "label": "mycc_2.0"},
+		{"package_id": "other_1.0:ccc", "label": "other_1.0"}
+	]
+}`
+
+// encodeMSPRole encodes an msp.MSPRole for a member of an MSP
+func encodeMSPRole(mspID string) string {
+	var role []byte
+	role = protowire.AppendTag(role, 1, protowire.BytesType)
+	role = protowire.AppendString(role, mspID)
+	return base64.StdEncoding.EncodeToString(role)
+}
+
+// committedCollectionsJSON is a collection package as printed by the peer
+func committedCollectionsJSON() string {
+	return fmt.Sprintf(`{"config": [{"Payload": {"StaticCollectionConfig": {
+		"name": "Org1Private",
+		"member_orgs_policy": {"Payload": {"SignaturePolicy": {
+			"rule": {"Type": {"NOutOf": {"n": 1, "rules": [{"Type": {"SignedBy": 0}}, {"Type": {"SignedBy": 1}}]}}},
+			"identities": [{"principal": %q}, {"principal": %q}]
+		}}},
+		"required_peer_count": 1,
+		"maximum_peer_count": 2,
+		"block_to_live": 100,
+		"member_only_read": true
+	}}}]}`, encodeMSPRole("Org1MSP"), encodeMSPRole("Org2MSP"))
+}
+
+func TestChaincodeDefinition(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	committedOutput := fmt.Sprintf(`{"sequence": 1, "version": "1.0", "validation_parameter": %q, "collections": %s, "approvals": {"Org1MSP": true, "Org2MSP": true}}`,
+		encodeSignaturePolicy(t, "OR('Org1MSP.peer','Org2MSP.peer')"), committedCollectionsJSON())
+	approvedOutput := fmt.Sprintf(`{"sequence": 2, "version": "2.0", "validation_parameter": %q}`,
+		encodeSignaturePolicy(t, "AND('Org1MSP.peer','Org2MSP.peer')"))
+
+	// mycc was never defined on the channel of Org2 alone
+	net.Channels = []*network.Channel{net.Channel, {Name: "private", Orgs: []string{"Org2"}}}
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case containsFlag(args, "--channelID", "private") && contains(args, "CORE_PEER_LOCALMSPID=Org1MSP"):
+			return []byte("Error: Org1MSP is not a member of private"), fmt.Errorf("exit status 1")
+		case containsFlag(args, "--channelID", "private") && contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case containsFlag(args, "--channelID", "private") && contains(args, "queryapproved"):
+			return []byte("Error: could not fetch approved chaincode definition"), fmt.Errorf("exit status 1")
+		case contains(args, "querycommitted"):
+			return []byte(committedOutput), nil
+		case contains(args, "queryapproved") && contains(args, "CORE_PEER_LOCALMSPID=Org1MSP"):
+			return []byte(approvedOutput), nil
+		case contains(args, "queryapproved"):
+			return []byte("Error: could not fetch approved chaincode definition (name: 'mycc', sequence: '2')"), fmt.Errorf("exit status 1")
+		case contains(args, "checkcommitreadiness"):
+			return []byte(`{"approvals": {"Org1MSP": true, "Org2MSP": false}}`), nil
+		case contains(args, "queryinstalled"):
+			return []byte(installedOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	state, err := deployer.ChaincodeDefinition(context.Background(), "mycc")
+	if err != nil {
+		t.Fatalf("ChaincodeDefinition() error = %v", err)
+	}
+
+	if len(state.Channels) != 1 || state.Channels[0].Channel != "mychannel" {
+		t.Fatalf("Expected the chaincode on mychannel only, got %+v", state.Channels)
+	}
+	committed, pending := state.Channels[0].Committed, state.Channels[0].Pending
+
+	if committed.Sequence != 1 || len(committed.Collections) != 1 {
+		t.Fatalf("Unexpected committed definition: %+v", committed)
+	}
+	collection := committed.Collections[0]
+	if collection.Name != "Org1Private" || collection.Policy != "OR('Org1MSP.member','Org2MSP.member')" ||
+		collection.MaxPeerCount != 2 || collection.BlockToLive != 100 || !collection.MemberOnlyRead {
+		t.Errorf("Unexpected collection: %+v", collection)
+	}
+
+	if pending == nil || pending.Sequence != 2 || pending.Version != "2.0" {
+		t.Fatalf("Expected a pending definition at sequence 2, got %+v", pending)
+	}
+	if !pending.Approvals["Org1MSP"] || pending.Approvals["Org2MSP"] {
+		t.Errorf("Expected only Org1MSP to approve, got %v", pending.Approvals)
+	}
+
+	for _, call := range mockExec.Calls {
+		if contains(call.Args, "checkcommitreadiness") &&
+			!(containsSequence(call.Args, "2") && contains(call.Args, "AND('Org1MSP.peer','Org2MSP.peer')")) {
+			t.Errorf("Expected readiness of the approved definition, got %v", call.Args)
+		}
+	}
+
+	if len(state.Installed) != 2 {
+		t.Fatalf("Expected packages on 2 peers, got %d", len(state.Installed))
+	}
+	packages := state.Installed[0].Packages
+	if len(packages) != 2 || packages[0].PackageID != "mycc_1.0:aaa" || strings.Join(packages[0].References, ",") != "mychannel/mycc:1.0" {
+		t.Errorf("Unexpected installed packages: %+v", packages)
+	}
+}
+
+func TestChaincodeDefinitionNotFound(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "querycommitted"):
+			return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+		case contains(args, "queryapproved"):
+			return []byte("Error: could not fetch approved chaincode definition"), fmt.Errorf("exit status 1")
+		case contains(args, "queryinstalled"):
+			return []byte(installedOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	_, err := deployer.ChaincodeDefinition(context.Background(), "missing")
+	if !stdErr.Is(err, errors.ErrChaincodeNotFound) {
+		t.Errorf("Expected ErrChaincodeNotFound, got %v", err)
+	}
+}
+
+func TestListChaincodes(t *testing.T) {
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	net.Channels = []*network.Channel{net.Channel, {Name: "private", Orgs: []string{"Org2"}}}
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case containsFlag(args, "--channelID", "private"):
+			if !contains(args, "CORE_PEER_LOCALMSPID=Org2MSP") {
+				return []byte("Error: not a member of private"), fmt.Errorf("exit status 1")
+			}
+			return []byte(`{"chaincode_definitions": [{"name": "secret", "sequence": 1, "version": "1.0"}]}`), nil
+		case contains(args, "querycommitted"):
+			return []byte(`{"chaincode_definitions": [
+				{"name": "mycc", "sequence": 3, "version": "1.0"},
+				{"name": "other", "sequence": "1", "version": "0.1", "init_required": true}
+			]}`), nil
+		case contains(args, "queryinstalled"):
+			return []byte(installedOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	state, err := deployer.ListChaincodes(context.Background())
+	if err != nil {
+		t.Fatalf("ListChaincodes() error = %v", err)
+	}
+
+	if len(state.Channels) != 2 {
+		t.Fatalf("Expected 2 channels, got %+v", state.Channels)
+	}
+	committed := state.Channels[0].Committed
+	if state.Channels[0].Channel != "mychannel" || len(committed) != 2 || committed[0].Sequence != 3 || committed[1].Sequence != 1 || !committed[1].InitRequired {
+		t.Errorf("Unexpected committed definitions: %+v", committed)
+	}
+	committed = state.Channels[1].Committed
+	if state.Channels[1].Channel != "private" || len(committed) != 1 || committed[0].Name != "secret" {
+		t.Errorf("Unexpected committed definitions on private: %+v", committed)
+	}
+	if len(state.Installed) != 2 || len(state.Installed[1].Packages) != 3 || state.Installed[1].Peer != "peer0.org2.example.com" {
+		t.Errorf("Unexpected installed packages: %+v", state.Installed)
+	}
+}
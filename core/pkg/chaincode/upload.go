@@ -0,0 +1,455 @@
+// core/pkg/chaincode/upload.go
+package chaincode
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/network"
+)
+
+// Kinds of upload
+const (
+	UploadPackage = "package" // A lifecycle package made by peer lifecycle chaincode package
+	UploadSource  = "source"  // A .tar.gz, .tar or .zip of a chaincode folder
+)
+
+// MaxUploadSize bounds the size of an uploaded package or archive
+const MaxUploadSize = 512 << 20
+
+// Bounds of an extracted source archive, so a small archive cannot expand
+// to fill the disk
+const (
+	MaxExtractedSize  = 1 << 30
+	MaxArchiveEntries = 100000
+)
+
+// UploadTTL is how long an upload is kept after it was last saved or used
+const UploadTTL = 7 * 24 * time.Hour
+
+// Upload is a package or source archive stored on the runtime host for
+// later deploys
+type Upload struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"`
+	Path      string `json:"path"` // The package file, or the extracted chaincode folder
+	Size      int64  `json:"size"`
+	SHA256    string `json:"sha256"`
+	PackageID string `json:"package_id,omitempty"`
+	Label     string `json:"label,omitempty"`
+	Language  string `json:"language,omitempty"`
+}
+
+// packageMetadata is the metadata.json of a lifecycle package
+type packageMetadata struct {
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+// labelPattern is the label format peers accept
+var labelPattern = regexp.MustCompile(`^[[:alnum:]][[:alnum:]_.+-]*$`)
+
+// SaveUpload stores an upload read from r under the network's folder. The
+// upload is checked against sha256 when given; packages must be valid
+// lifecycle packages and archives are extracted.
+func SaveUpload(net *network.Network, kind, fileName, sha string, r io.Reader) (*Upload, error) {
+	if kind != UploadPackage && kind != UploadSource {
+		return nil, errors.WrapWithContext("SaveUpload", errors.ErrInvalidConfig, map[string]interface{}{
+			"kind":   kind,
+			"reason": "kind must be package or source",
+		})
+	}
+
+	if _, err := PruneUploads(net, UploadTTL); err != nil {
+		return nil, errors.Wrap("SaveUpload", err)
+	}
+
+	upload := &Upload{ID: uuid.New().String(), Kind: kind}
+	dir := uploadDir(net, upload.ID)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap("SaveUpload", err)
+	}
+
+	stored, err := storeUpload(dir, kind, fileName, sha, r, upload)
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, errors.Wrap("SaveUpload", err)
+	}
+	upload.Path = stored
+
+	data, err := json.MarshalIndent(upload, "", "  ")
+	if err == nil {
+		err = os.WriteFile(filepath.Join(dir, "upload.json"), data, 0644)
+	}
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, errors.Wrap("SaveUpload", err)
+	}
+	return upload, nil
+}
+
+// storeUpload writes the upload into dir and returns the path deploys use
+func storeUpload(dir, kind, fileName, sha string, r io.Reader, upload *Upload) (string, error) {
+	file := filepath.Join(dir, "upload")
+	f, err := os.Create(file)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), io.LimitReader(r, MaxUploadSize+1))
+	f.Close()
+	if err != nil {
+		return "", err
+	}
+	if n > MaxUploadSize {
+		return "", errors.WrapWithContext("storeUpload", errors.ErrInvalidConfig, map[string]interface{}{
+			"reason": fmt.Sprintf("uploads are limited to %d MiB", MaxUploadSize>>20),
+		})
+	}
+
+	upload.Size = n
+	upload.SHA256 = hex.EncodeToString(h.Sum(nil))
+	if sha != "" && !strings.EqualFold(sha, upload.SHA256) {
+		return "", errors.WrapWithContext("storeUpload", errors.ErrInvalidConfig, map[string]interface{}{
+			"expected": sha,
+			"received": upload.SHA256,
+			"reason":   "upload does not match its SHA-256, it was truncated or corrupted",
+		})
+	}
+
+	if kind == UploadPackage {
+		meta, err := readPackageMetadata(file)
+		if err != nil {
+			return "", err
+		}
+		pkg := filepath.Join(dir, "package.tar.gz")
+		if err := os.Rename(file, pkg); err != nil {
+			return "", err
+		}
+		upload.Label = meta.Label
+		upload.Language = meta.Type
+		upload.PackageID = fmt.Sprintf("%s:%s", meta.Label, upload.SHA256)
+		return pkg, nil
+	}
+
+	src := filepath.Join(dir, "source")
+	limit := &extractLimit{maxBytes: MaxExtractedSize, maxEntries: MaxArchiveEntries}
+	if err := extractArchive(file, fileName, src, limit); err != nil {
+		return "", errors.WrapWithContext("storeUpload", errors.ErrInvalidConfig, map[string]interface{}{
+			"file":   fileName,
+			"reason": err.Error(),
+		})
+	}
+	os.Remove(file)
+	return archiveRoot(src), nil
+}
+
+// LookupUpload returns a stored upload by ID
+func LookupUpload(net *network.Network, id string) (*Upload, error) {
+	// IDs are UUIDs; anything else could escape the uploads folder
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, errors.WrapWithContext("LookupUpload", errors.ErrInvalidConfig, map[string]interface{}{
+			"upload_id": id,
+			"reason":    "unknown upload",
+		})
+	}
+
+	meta := filepath.Join(uploadDir(net, id), "upload.json")
+	data, err := os.ReadFile(meta)
+	if err != nil {
+		return nil, errors.WrapWithContext("LookupUpload", errors.ErrInvalidConfig, map[string]interface{}{
+			"upload_id": id,
+			"reason":    "unknown upload",
+		})
+	}
+
+	var upload Upload
+	if err := json.Unmarshal(data, &upload); err != nil {
+		return nil, errors.Wrap("LookupUpload", err)
+	}
+
+	// An upload in use is kept for another UploadTTL
+	now := time.Now()
+	os.Chtimes(meta, now, now)
+	return &upload, nil
+}
+
+// PruneUploads removes the uploads not saved or used within maxAge and
+// returns how many were removed
+func PruneUploads(net *network.Network, maxAge time.Duration) (int, error) {
+	root := filepath.Join(net.BasePath, "uploads")
+	entries, err := os.ReadDir(root)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap("PruneUploads", err)
+	}
+
+	removed := 0
+	cutoff := time.Now().Add(-maxAge)
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		dir := filepath.Join(root, entry.Name())
+		// Uploads still being written have no upload.json yet
+		info, err := os.Stat(filepath.Join(dir, "upload.json"))
+		if err != nil || info.ModTime().After(cutoff) {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return removed, errors.WrapWithContext("PruneUploads", err, map[string]interface{}{
+				"upload_id": entry.Name(),
+			})
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+func uploadDir(net *network.Network, id string) string {
+	return filepath.Join(net.BasePath, "uploads", id)
+}
+
+// readPackageMetadata checks a file is a lifecycle package and returns its
+// metadata
+func readPackageMetadata(path string) (*packageMetadata, error) {
+	invalid := func(reason string) error {
+		return errors.WrapWithContext("readPackageMetadata", errors.ErrInvalidConfig, map[string]interface{}{
+			"package": path,
+			"reason":  reason,
+		})
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, invalid(err.Error())
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, invalid("not a chaincode package, expected a .tar.gz made by peer lifecycle chaincode package")
+	}
+
+	var meta *packageMetadata
+	hasCode := false
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, invalid("not a chaincode package: " + err.Error())
+		}
+		switch hdr.Name {
+		case "metadata.json":
+			meta = &packageMetadata{}
+			if err := json.NewDecoder(tr).Decode(meta); err != nil {
+				return nil, invalid("metadata.json is not valid JSON")
+			}
+		case "code.tar.gz":
+			hasCode = true
+		}
+	}
+
+	if meta == nil || !hasCode {
+		return nil, invalid("not a chaincode package, metadata.json or code.tar.gz is missing")
+	}
+	if !labelPattern.MatchString(meta.Label) {
+		return nil, invalid(fmt.Sprintf("invalid package label %q", meta.Label))
+	}
+	switch meta.Type {
+	case LanguageGo, LanguageNode, LanguageJava:
+	default:
+		return nil, invalid(fmt.Sprintf("packages of type %q cannot be deployed, expected golang, node or java", meta.Type))
+	}
+	return meta, nil
+}
+
+// extractLimit bounds the bytes and entries an archive extracts to
+type extractLimit struct {
+	maxBytes   int64
+	maxEntries int
+	bytes      int64
+	entries    int
+}
+
+// entry counts one more archive entry
+func (l *extractLimit) entry() error {
+	l.entries++
+	if l.entries > l.maxEntries {
+		return fmt.Errorf("archive has more than %d entries", l.maxEntries)
+	}
+	return nil
+}
+
+// reader counts the bytes read from an entry against the limit
+func (l *extractLimit) reader(r io.Reader) io.Reader {
+	return &limitedEntry{r: r, limit: l}
+}
+
+type limitedEntry struct {
+	r     io.Reader
+	limit *extractLimit
+}
+
+func (e *limitedEntry) Read(p []byte) (int, error) {
+	n, err := e.r.Read(p)
+	e.limit.bytes += int64(n)
+	if e.limit.bytes > e.limit.maxBytes {
+		return n, fmt.Errorf("archive expands to more than %d MiB", e.limit.maxBytes>>20)
+	}
+	return n, err
+}
+
+// extractArchive unpacks a tar, gzipped tar or zip archive into dst within
+// limit. Only regular files and folders are extracted.
+func extractArchive(file, name, dst string, limit *extractLimit) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	br := bufio.NewReader(f)
+	magic, _ := br.Peek(4)
+	switch {
+	case bytes.HasPrefix(magic, []byte("PK\x03\x04")):
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
+		return extractZip(f, info.Size(), dst, limit)
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		gz, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		return extractTar(tar.NewReader(gz), dst, limit)
+	case strings.HasSuffix(strings.ToLower(name), ".tar"):
+		return extractTar(tar.NewReader(br), dst, limit)
+	}
+	return fmt.Errorf("source archives must be .tar.gz, .tgz, .tar or .zip")
+}
+
+func extractTar(tr *tar.Reader, dst string, limit *extractLimit) error {
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := limit.entry(); err != nil {
+			return err
+		}
+
+		target, err := archivePath(dst, hdr.Name)
+		if err != nil {
+			return err
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := writeArchiveFile(target, limit.reader(tr), os.FileMode(hdr.Mode)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func extractZip(r io.ReaderAt, size int64, dst string, limit *extractLimit) error {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return err
+	}
+
+	for _, zf := range zr.File {
+		if err := limit.entry(); err != nil {
+			return err
+		}
+		target, err := archivePath(dst, zf.Name)
+		if err != nil {
+			return err
+		}
+		if zf.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+			continue
+		}
+		if !zf.Mode().IsRegular() {
+			continue
+		}
+
+		rc, err := zf.Open()
+		if err != nil {
+			return err
+		}
+		err = writeArchiveFile(target, limit.reader(rc), zf.Mode())
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archivePath resolves an archive entry inside dst, refusing entries that
+// would land outside it
+func archivePath(dst, name string) (string, error) {
+	target := filepath.Join(dst, filepath.FromSlash(name))
+	if target != dst && !strings.HasPrefix(target, dst+string(filepath.Separator)) {
+		return "", fmt.Errorf("archive entry %q is outside the chaincode folder", name)
+	}
+	return target, nil
+}
+
+func writeArchiveFile(target string, r io.Reader, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode.Perm()|0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// archiveRoot returns the chaincode folder of an extracted archive: the only
+// folder inside it when the archive wraps the chaincode in one
+func archiveRoot(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 1 || !entries[0].IsDir() {
+		return dir
+	}
+	return filepath.Join(dir, entries[0].Name())
+}
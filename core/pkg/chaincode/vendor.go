@@ -0,0 +1,76 @@
+// core/pkg/chaincode/vendor.go
+package chaincode
+
+import (
+	"os"
+	"regexp"
+
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// goEnv lists the Go settings passed from the runtime to the vendoring
+// container, e.g. GOPROXY=off or an internal proxy for offline builds
+var goEnv = []string{"GOPROXY", "GOPRIVATE", "GONOPROXY", "GONOSUMDB", "GOSUMDB", "GOINSECURE"}
+
+// Problems go mod vendor reports, from the most to the least specific
+var goModProblems = []struct {
+	name    string
+	pattern *regexp.Regexp
+	hint    string
+}{
+	{
+		name:    "checksum_mismatch",
+		pattern: regexp.MustCompile(`(?:verifying (\S+?)(?:/go\.mod)?: checksum mismatch|go: (\S+?): verifying (?:module|go\.mod): checksum mismatch)`),
+		hint:    "go.sum does not match the downloaded module; check the module source, then run go mod tidy",
+	},
+	{
+		name:    "missing_go_sum_entry",
+		pattern: regexp.MustCompile(`(?:go: (\S+?): missing go\.sum entry|missing go\.sum entry for module providing package (\S+))`),
+		hint:    "run go mod tidy in the chaincode folder and commit go.sum",
+	},
+	{
+		name:    "download_failed",
+		pattern: regexp.MustCompile(`(?:go: (\S+?): )?[^\n]*(?:dial tcp|no such host|module lookup disabled|GOPROXY=off)`),
+		hint:    "the module is not in the fabricx-go-mod cache volume; vendor once with network access or set GOPROXY to a reachable proxy",
+	},
+}
+
+func goEnvArgs() []string {
+	var args []string
+	for _, name := range goEnv {
+		if value, ok := os.LookupEnv(name); ok {
+			args = append(args, "-e", name+"="+value)
+		}
+	}
+	return args
+}
+
+// goModulesError turns go mod vendor output into an ErrGoModules error
+// naming the problem and the modules involved, or returns nil when the
+// output shows no module problem
+func goModulesError(output string) error {
+	for _, problem := range goModProblems {
+		matches := problem.pattern.FindAllStringSubmatch(output, -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		modules := []string{}
+		seen := make(map[string]bool)
+		for _, match := range matches {
+			for _, module := range match[1:] {
+				if module != "" && !seen[module] {
+					seen[module] = true
+					modules = append(modules, module)
+				}
+			}
+		}
+
+		return errors.WrapWithContext("vendorChaincode", errors.ErrGoModules, map[string]interface{}{
+			"problem": problem.name,
+			"modules": modules,
+			"hint":    problem.hint,
+		})
+	}
+	return nil
+}
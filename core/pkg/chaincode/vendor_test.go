@@ -0,0 +1,170 @@
+// core/pkg/chaincode/vendor_test.go
+package chaincode
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+func TestGoModulesError(t *testing.T) {
+	tests := []struct {
+		name        string
+		output      string
+		wantProblem string
+		wantModules []string
+	}{
+		{
+			name: "missing go.sum entries",
+			output: "go: github.com/hyperledger/fabric-chaincode-go@v0.0.0-20230731094759-d626e9ab09b9: missing go.sum entry for go.mod file; to add it:\n" +
+				"\tgo mod download github.com/hyperledger/fabric-chaincode-go\n" +
+				"main.go:6:2: missing go.sum entry for module providing package github.com/hyperledger/fabric-contract-api-go/contractapi (imported by mycc); to add:\n",
+			wantProblem: "missing_go_sum_entry",
+			wantModules: []string{
+				"github.com/hyperledger/fabric-chaincode-go@v0.0.0-20230731094759-d626e9ab09b9",
+				"github.com/hyperledger/fabric-contract-api-go/contractapi",
+			},
+		},
+		{
+			name: "checksum mismatch",
+			output: "verifying github.com/golang/protobuf@v1.5.3/go.mod: checksum mismatch\n" +
+				"\tdownloaded: h1:XVQyhkEzPBX5oGGmcbdNsz3cZEt9PDKzFfQNbs5CNWI=\n" +
+				"\tgo.sum:     h1:AAAAhkEzPBX5oGGmcbdNsz3cZEt9PDKzFfQNbs5CNWI=\n",
+			wantProblem: "checksum_mismatch",
+			wantModules: []string{"github.com/golang/protobuf@v1.5.3"},
+		},
+		{
+			name:        "offline without a cached module",
+			output:      "go: github.com/stretchr/testify@v1.8.4: module lookup disabled by GOPROXY=off\n",
+			wantProblem: "download_failed",
+			wantModules: []string{"github.com/stretchr/testify@v1.8.4"},
+		},
+		{
+			name:   "other failure",
+			output: "sh: go: not found\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := goModulesError(tt.output)
+			if tt.wantProblem == "" {
+				if err != nil {
+					t.Errorf("Expected no module error, got %v", err)
+				}
+				return
+			}
+
+			var fxErr *errors.FabricXError
+			if !errors.IsGoModules(err) || !stdErr.As(err, &fxErr) {
+				t.Fatalf("Expected ErrGoModules, got %v", err)
+			}
+			if fxErr.Context["problem"] != tt.wantProblem {
+				t.Errorf("Expected problem %s, got %v", tt.wantProblem, fxErr.Context["problem"])
+			}
+			if !reflect.DeepEqual(fxErr.Context["modules"], tt.wantModules) {
+				t.Errorf("Expected modules %v, got %v", tt.wantModules, fxErr.Context["modules"])
+			}
+		})
+	}
+}
+
+func TestDeployVendored(t *testing.T) {
+	t.Setenv("GOPROXY", "off")
+	src := writeProject(t, map[string]string{"go.mod": "module mycc", "main.go": "package main"})
+
+	tests := []struct {
+		name     string
+		req      *DeployRequest
+		output   string
+		wantErr  error
+		wantCall bool
+	}{
+		{
+			name:     "vendors into the package",
+			req:      &DeployRequest{Name: "mycc", Path: src, Vendor: true},
+			wantCall: true,
+		},
+		{
+			name:     "reports go.sum problems",
+			req:      &DeployRequest{Name: "mycc", Path: src, Version: "2.0", Vendor: true},
+			output:   "go: github.com/google/uuid@v1.3.0: missing go.sum entry for go.mod file; to add it:\n",
+			wantErr:  errors.ErrGoModules,
+			wantCall: true,
+		},
+		{
+			name:    "rejects other languages",
+			req:     &DeployRequest{Name: "mycc", Path: src, Language: LanguageJava, Vendor: true},
+			wantErr: errors.ErrInvalidConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			net := createMockNetwork()
+			defer os.RemoveAll(net.BasePath)
+
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				switch {
+				case contains(args, goBuildImage) && tt.output != "":
+					return []byte(tt.output), fmt.Errorf("exit status 1")
+				case contains(args, "querycommitted"):
+					return []byte(notCommittedOutput(args)), fmt.Errorf("exit status 1")
+				case contains(args, "package"):
+					writePackageOutput(t, args, "package bytes")
+				case contains(args, "install"):
+					return []byte(stagedInstallOutput), nil
+				}
+				return []byte("success"), nil
+			}
+
+			deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+			_, err := deployer.Deploy(context.Background(), tt.req)
+			if tt.wantErr != nil {
+				if !stdErr.Is(err, tt.wantErr) {
+					t.Errorf("Expected %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Deploy() error = %v", err)
+			}
+
+			buildDir, _ := filepath.Abs(filepath.Join(net.BasePath, "build", "mycc"))
+			var vendor, pkg []string
+			for _, call := range mockExec.GetCalls() {
+				if contains(call.Args, goBuildImage) {
+					vendor = call.Args
+				}
+				if contains(call.Args, "package") {
+					pkg = call.Args
+				}
+			}
+
+			if !tt.wantCall {
+				if vendor != nil {
+					t.Errorf("Expected no vendoring, got %v", vendor)
+				}
+				return
+			}
+			if vendor == nil || !strings.HasPrefix(vendor[len(vendor)-1], "go mod vendor") ||
+				!contains(vendor, "fabricx-go-mod:/go/pkg/mod") || !contains(vendor, "GOPROXY=off") {
+				t.Fatalf("Expected go mod vendor with the shared module cache, got %v", vendor)
+			}
+			if tt.wantErr == nil && (pkg == nil || !contains(pkg, buildDir+":/chaincode")) {
+				t.Errorf("Expected the vendored copy packaged, got %v", pkg)
+			}
+			if tt.wantErr != nil && pkg != nil {
+				t.Errorf("Expected no package after vendoring failed, got %v", pkg)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+// core/pkg/chaincode/watcher.go
+package chaincode
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+const fabricCCEnvImage = "hyperledger/fabric-ccenv:2.5"
+
+// Watch event types
+const (
+	WatchEventStarted      = "started"
+	WatchEventChanged      = "changed"
+	WatchEventCheckFailed  = "check_failed"
+	WatchEventDeployed     = "deployed"
+	WatchEventDeployFailed = "deploy_failed"
+)
+
+// WatchEvent reports one step of a watch session
+type WatchEvent struct {
+	Type      string
+	Message   string
+	Output    string        // Build check output
+	Result    *DeployResult // Set on WatchEventDeployed
+	Timestamp time.Time
+}
+
+// WatchOptions tunes how often the source is polled
+type WatchOptions struct {
+	Interval   time.Duration // Poll interval (default 500ms)
+	Debounce   time.Duration // Quiet period before redeploying (default 1s)
+	SkipChecks bool          // Skip go vet and go build before redeploying
+}
+
+// Watch polls the chaincode source and redeploys it after every change
+// until ctx is done. Redeploys use the next sequence, or only restart the
+// service in CCaaS mode. The channel is closed when watching stops.
+func (d *Deployer) Watch(ctx context.Context, req *DeployRequest, opts WatchOptions) <-chan *WatchEvent {
+	if opts.Interval <= 0 {
+		opts.Interval = 500 * time.Millisecond
+	}
+	if opts.Debounce <= 0 {
+		opts.Debounce = time.Second
+	}
+
+	events := make(chan *WatchEvent, 16)
+	go func() {
+		defer close(events)
+
+		emit := func(event *WatchEvent) {
+			event.Timestamp = time.Now()
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		}
+
+		lastHash := hashSource(req.Path)
+		emit(&WatchEvent{Type: WatchEventStarted, Message: fmt.Sprintf("Watching %s", req.Path)})
+
+		ticker := time.NewTicker(opts.Interval)
+		defer ticker.Stop()
+
+		var changedAt time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if hash := hashSource(req.Path); hash != lastHash {
+				lastHash = hash
+				changedAt = time.Now()
+				continue
+			}
+
+			// Wait for the source to settle
+			if changedAt.IsZero() || time.Since(changedAt) < opts.Debounce {
+				continue
+			}
+			changedAt = time.Time{}
+
+			emit(&WatchEvent{Type: WatchEventChanged, Message: "Source changed, redeploying"})
+			d.redeploy(ctx, req, opts, emit)
+		}
+	}()
+
+	return events
+}
+
+// redeploy runs the build checks and deploys one change
+func (d *Deployer) redeploy(ctx context.Context, req *DeployRequest, opts WatchOptions, emit func(*WatchEvent)) {
+	// deploy fills in defaults, so every run starts from the original request.
+	// A policy or collections left out of it are taken from the committed
+	// definition each time, so saving a file never weakens the policy or
+	// drops collections.
+	run := *req
+	language, err := resolveLanguage(run.Path, run.Language)
+	if err != nil {
+		emit(&WatchEvent{Type: WatchEventDeployFailed, Message: err.Error()})
+		return
+	}
+	run.Language = language
+
+	if !opts.SkipChecks && run.Language == LanguageGo {
+		if output, err := d.checkGoSource(ctx, run.Path); err != nil {
+			emit(&WatchEvent{
+				Type:    WatchEventCheckFailed,
+				Message: fmt.Sprintf("Build checks failed: %v", err),
+				Output:  output,
+			})
+			return
+		}
+	}
+
+	result, err := d.deploy(ctx, &run, false)
+	if err != nil {
+		emit(&WatchEvent{Type: WatchEventDeployFailed, Message: err.Error()})
+		return
+	}
+
+	message := fmt.Sprintf("Committed version %s at sequence %d", result.Version, result.Sequence)
+	if result.Restarted {
+		message = fmt.Sprintf("Restarted chaincode service at sequence %d", result.Sequence)
+	}
+	emit(&WatchEvent{Type: WatchEventDeployed, Message: message, Result: result})
+}
+
+// checkGoSource runs go vet and go build in the chaincode build image
+func (d *Deployer) checkGoSource(ctx context.Context, path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	output, err := d.exec.ExecuteCombined(ctx, "docker", "run", "--rm",
+		"-v", fmt.Sprintf("%s:/chaincode", absPath),
+		"-v", "fabricx-go-mod:/go/pkg/mod",
+		"-w", "/chaincode",
+		fabricCCEnvImage,
+		"sh", "-c", "go vet ./... && go build ./...",
+	)
+	return string(output), err
+}
@@ -0,0 +1,169 @@
+// core/pkg/chaincode/watcher_test.go
+package chaincode
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/docker"
+	"github.com/temmyjay001/core/pkg/executor"
+)
+
+// nextEvent waits for the next watch event
+func nextEvent(t *testing.T, events <-chan *WatchEvent) *WatchEvent {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatal("Watch stopped unexpectedly")
+		}
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a watch event")
+	}
+	return nil
+}
+
+func TestWatch(t *testing.T) {
+	const committedOutput = `{"sequence": 2, "version": "1.0"}`
+	const installOutput = "Chaincode code package identifier: mycc_1.0:" +
+		"0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	srcDir := filepath.Join(net.BasePath, "src")
+	if err := os.MkdirAll(srcDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	mainFile := filepath.Join(srcDir, "main.go")
+	if err := os.WriteFile(mainFile, []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(srcDir, "go.mod"), []byte("module mycc"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var brokenBuild atomic.Bool
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		switch {
+		case contains(args, "go vet ./... && go build ./..."):
+			if brokenBuild.Load() {
+				return []byte("./main.go:1:1: syntax error"), fmt.Errorf("exit status 1")
+			}
+		case contains(args, "querycommitted"):
+			return []byte(committedOutput), nil
+		case contains(args, "package"):
+			writePackageOutput(t, args, "package bytes")
+		case contains(args, "install"):
+			return []byte(installOutput), nil
+		}
+		return []byte("success"), nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+	events := deployer.Watch(ctx, &DeployRequest{Name: "mycc", Path: srcDir}, WatchOptions{
+		Interval: 10 * time.Millisecond,
+		Debounce: 30 * time.Millisecond,
+	})
+
+	if event := nextEvent(t, events); event.Type != WatchEventStarted {
+		t.Fatalf("Expected %s, got %+v", WatchEventStarted, event)
+	}
+
+	if err := os.WriteFile(mainFile, []byte("package main\n\nfunc main() {}"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if event := nextEvent(t, events); event.Type != WatchEventChanged {
+		t.Fatalf("Expected %s, got %+v", WatchEventChanged, event)
+	}
+	event := nextEvent(t, events)
+	if event.Type != WatchEventDeployed || event.Result.Sequence != 3 || !event.Result.Reinstalled {
+		t.Fatalf("Expected a deploy at sequence 3, got %+v", event)
+	}
+
+	brokenBuild.Store(true)
+	if err := os.WriteFile(mainFile, []byte("package main\n\nfunc main() {"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	nextEvent(t, events)
+	event = nextEvent(t, events)
+	if event.Type != WatchEventCheckFailed || event.Output != "./main.go:1:1: syntax error" {
+		t.Fatalf("Expected failed build checks, got %+v", event)
+	}
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestRedeployKeepsCommittedDefinition(t *testing.T) {
+	committedOutput := fmt.Sprintf(`{"sequence": 2, "version": "1.0", "validation_parameter": %q, "collections": %s}`,
+		encodeSignaturePolicy(t, "AND('Org1MSP.peer','Org2MSP.peer')"), committedCollectionsJSON())
+
+	net := createMockNetwork()
+	defer os.RemoveAll(net.BasePath)
+
+	srcDir := filepath.Join(net.BasePath, "src")
+	if err := os.MkdirAll(srcDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(srcDir, "main.go"), []byte("package main"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(srcDir, "go.mod"), []byte("module mycc"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		if contains(args, "package") {
+			writePackageOutput(t, args, "package bytes")
+			return []byte("success"), nil
+		}
+		if contains(args, "querycommitted") {
+			return []byte(committedOutput), nil
+		}
+		return []byte("Chaincode code package identifier: mycc_1.0:" +
+			"0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"), nil
+	}
+	deployer := NewDeployer(net, docker.NewManager(mockExec), mockExec)
+
+	// Every save redeploys from the same request without a policy
+	req := &DeployRequest{Name: "mycc", Path: srcDir}
+	for i := 0; i < 2; i++ {
+		var events []*WatchEvent
+		deployer.redeploy(context.Background(), req, WatchOptions{SkipChecks: true}, func(e *WatchEvent) {
+			events = append(events, e)
+		})
+		if len(events) != 1 || events[0].Type != WatchEventDeployed {
+			t.Fatalf("Expected a deploy, got %+v", events)
+		}
+	}
+	if req.EndorsementPolicy != "" || req.CollectionsConfig != "" {
+		t.Errorf("Expected the watched request unchanged, got %+v", req)
+	}
+
+	approvals := 0
+	for _, call := range mockExec.Calls {
+		if !contains(call.Args, "approveformyorg") {
+			continue
+		}
+		approvals++
+		if !containsFlag(call.Args, "--signature-policy", "AND('Org1MSP.peer','Org2MSP.peer')") || !contains(call.Args, "--collections-config") {
+			t.Errorf("Expected the committed policy and collections, got %v", call.Args)
+		}
+	}
+	if approvals != 4 {
+		t.Errorf("Expected 4 approvals, got %d", approvals)
+	}
+}
@@ -0,0 +1,44 @@
+// core/pkg/determinism/analyzer.go
+package determinism
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Analyzer reports non-deterministic code in chaincode transactions. It
+// runs under go vet -vettool or any other go/analysis driver.
+var Analyzer = &analysis.Analyzer{
+	Name: "determinism",
+	Doc: `report chaincode transactions that endorsers can execute differently
+
+Transactions are the exported methods of types embedding
+contractapi.Contract or taking a transaction context, and the Init and
+Invoke of shim chaincode. They and the package functions they call are
+checked for wall clock reads, random numbers, network, file and
+environment access, goroutines, writes to package-level variables or
+contract fields, and map iteration whose order reaches the ledger.`,
+	URL: "https://github.com/temmyjay001/fabricx",
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	var files []*ast.File
+	for _, file := range pass.Files {
+		if !strings.HasSuffix(pass.Fset.File(file.Pos()).Name(), "_test.go") {
+			files = append(files, file)
+		}
+	}
+
+	for _, f := range checkFiles(files, pass.TypesInfo) {
+		pass.Report(analysis.Diagnostic{
+			Pos:      f.pos,
+			Category: f.check,
+			Message:  fmt.Sprintf("%s: %s: %s", f.function, f.severity, f.message),
+		})
+	}
+	return nil, nil
+}
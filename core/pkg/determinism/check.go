@@ -0,0 +1,87 @@
+// core/pkg/determinism/check.go
+package determinism
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// CheckDir checks the Go packages under a chaincode folder without
+// building them, so it needs neither a Go toolchain nor the chaincode's
+// dependencies. Vendored, testdata and hidden folders are skipped.
+func CheckDir(dir string) ([]Diagnostic, error) {
+	info, err := os.Stat(dir)
+	if err != nil || !info.IsDir() {
+		return nil, errors.WrapWithContext("CheckDir", errors.ErrInvalidConfig, map[string]interface{}{
+			"path":   dir,
+			"reason": "chaincode folder not found on the runtime host",
+		})
+	}
+
+	// Group files by folder and package name
+	packages := make(map[string][]*ast.File)
+	fset := token.NewFileSet()
+	err = filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		name := entry.Name()
+		if entry.IsDir() {
+			if path != dir && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return errors.WrapWithContext("CheckDir", errors.ErrInvalidConfig, map[string]interface{}{
+				"file":   path,
+				"reason": err.Error(),
+			})
+		}
+		key := filepath.Dir(path) + ":" + file.Name.Name
+		packages[key] = append(packages[key], file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(packages))
+	for key := range packages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	diagnostics := []Diagnostic{}
+	for _, key := range keys {
+		for _, f := range checkFiles(packages[key], nil) {
+			pos := fset.Position(f.pos)
+			file, err := filepath.Rel(dir, pos.Filename)
+			if err != nil {
+				file = pos.Filename
+			}
+			diagnostics = append(diagnostics, Diagnostic{
+				Check:    f.check,
+				Severity: f.severity,
+				File:     filepath.ToSlash(file),
+				Line:     pos.Line,
+				Column:   pos.Column,
+				Function: f.function,
+				Message:  f.message,
+			})
+		}
+	}
+	return diagnostics, nil
+}
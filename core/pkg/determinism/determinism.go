@@ -0,0 +1,700 @@
+// core/pkg/determinism/determinism.go
+package determinism
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Checks a diagnostic can come from
+const (
+	CheckTime         = "time"          // Wall clock reads
+	CheckRandom       = "random"        // Random numbers
+	CheckIO           = "io"            // Network, file and environment access
+	CheckMapIteration = "map-iteration" // Map order leaking into writes or results
+	CheckGlobalState  = "global-state"  // Package-level variables written by transactions
+	CheckGoroutine    = "goroutine"     // Goroutines started by transactions
+)
+
+// Severities of a diagnostic
+const (
+	SeverityError   = "error"   // Endorsers will produce different results
+	SeverityWarning = "warning" // Endorsers may produce different results
+)
+
+// Diagnostic is a piece of chaincode that can make endorsers disagree
+type Diagnostic struct {
+	Check    string
+	Severity string
+	File     string // Relative to the checked folder
+	Line     int
+	Column   int
+	Function string // Transaction or helper the code is in
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %s (%s)", d.File, d.Line, d.Column, d.Severity, d.Message, d.Check)
+}
+
+// HasErrors reports whether any diagnostic is an error
+func HasErrors(diagnostics []Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// finding is a diagnostic before its position is resolved
+type finding struct {
+	pos      token.Pos
+	check    string
+	severity string
+	function string
+	message  string
+}
+
+// timeFuncs read the wall clock, which differs on every endorser
+var timeFuncs = map[string]bool{"Now": true, "Since": true, "Until": true}
+
+var randomPackages = map[string]bool{"math/rand": true, "math/rand/v2": true, "crypto/rand": true}
+
+// ioPackages are packages every call of which leaves the transaction
+// simulation; os is limited to osFuncs
+var ioPackages = map[string]bool{
+	"net": true, "net/http": true, "net/rpc": true, "net/smtp": true,
+	"os/exec": true, "io/ioutil": true, "syscall": true,
+}
+
+var osFuncs = map[string]bool{
+	"Open": true, "OpenFile": true, "Create": true, "ReadFile": true, "WriteFile": true,
+	"ReadDir": true, "Remove": true, "RemoveAll": true, "Rename": true, "Mkdir": true,
+	"MkdirAll": true, "Stat": true, "Lstat": true, "Getenv": true, "LookupEnv": true,
+	"Environ": true, "Hostname": true, "Getpid": true,
+}
+
+// ledgerWrites are stub methods whose order ends up in the write set or
+// the transaction's events
+var ledgerWrites = map[string]bool{
+	"PutState": true, "DelState": true, "PutPrivateData": true, "DelPrivateData": true,
+	"PurgePrivateData": true, "SetEvent": true, "SetStateValidationParameter": true,
+	"SetPrivateDataValidationParameter": true,
+}
+
+// checker looks for non-determinism in the transactions of one package.
+// info is nil when the package could not be type-checked, e.g. because its
+// dependencies are not downloaded; identifiers are then resolved by name.
+type checker struct {
+	info  *types.Info
+	files map[*ast.FuncDecl]*ast.File
+
+	funcs     map[string]*ast.FuncDecl   // Package functions by name
+	methods   map[string][]*ast.FuncDecl // Methods by name, of any type
+	globals   map[string]bool            // Package-level variables
+	mapFields map[string]bool            // Struct fields of map type
+	contracts map[string]bool            // Types embedding contractapi.Contract
+	findings  []finding
+	checked   map[*ast.FuncDecl]bool
+}
+
+// checkFiles returns the findings in the transactions of a package
+func checkFiles(files []*ast.File, info *types.Info) []finding {
+	c := &checker{
+		info:      info,
+		files:     make(map[*ast.FuncDecl]*ast.File),
+		funcs:     make(map[string]*ast.FuncDecl),
+		methods:   make(map[string][]*ast.FuncDecl),
+		globals:   make(map[string]bool),
+		mapFields: make(map[string]bool),
+		contracts: make(map[string]bool),
+		checked:   make(map[*ast.FuncDecl]bool),
+	}
+
+	for _, file := range files {
+		c.collect(file)
+	}
+
+	// Walk from each transaction into the helpers it calls, in source order
+	var entries []*ast.FuncDecl
+	for fn := range c.files {
+		if c.isTransaction(fn) {
+			entries = append(entries, fn)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Pos() < entries[j].Pos() })
+
+	for _, entry := range entries {
+		c.walk(entry, funcName(entry))
+	}
+
+	sort.SliceStable(c.findings, func(i, j int) bool { return c.findings[i].pos < c.findings[j].pos })
+	return c.findings
+}
+
+// collect indexes the declarations of a file
+func (c *checker) collect(file *ast.File) {
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			c.files[decl] = file
+			if decl.Recv == nil {
+				c.funcs[decl.Name.Name] = decl
+			} else {
+				c.methods[decl.Name.Name] = append(c.methods[decl.Name.Name], decl)
+			}
+
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.ValueSpec:
+					if decl.Tok != token.VAR {
+						continue
+					}
+					for _, name := range spec.Names {
+						if name.Name != "_" {
+							c.globals[name.Name] = true
+						}
+					}
+
+				case *ast.TypeSpec:
+					st, ok := spec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+					for _, field := range st.Fields.List {
+						if _, ok := field.Type.(*ast.MapType); ok {
+							for _, name := range field.Names {
+								c.mapFields[name.Name] = true
+							}
+						}
+						if len(field.Names) == 0 && c.isContractEmbed(file, field.Type) {
+							c.contracts[spec.Name.Name] = true
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// isContractEmbed reports whether an embedded field is contractapi.Contract
+func (c *checker) isContractEmbed(file *ast.File, expr ast.Expr) bool {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Contract" {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && strings.HasSuffix(c.importPath(file, ident), "/contractapi")
+}
+
+// isTransaction reports whether a function is invoked by peers: an
+// exported method of a contract or taking a transaction context, or the
+// Init and Invoke of a shim chaincode
+func (c *checker) isTransaction(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || !fn.Name.IsExported() || fn.Body == nil {
+		return false
+	}
+	if c.contracts[recvName(fn)] {
+		return true
+	}
+	params := fn.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+	param := types.ExprString(params[0].Type)
+	if strings.Contains(param, "TransactionContext") {
+		return true
+	}
+	return (fn.Name.Name == "Init" || fn.Name.Name == "Invoke") && strings.Contains(param, "ChaincodeStubInterface")
+}
+
+// walk checks a function and the package functions it calls
+func (c *checker) walk(fn *ast.FuncDecl, entry string) {
+	if c.checked[fn] || fn.Body == nil {
+		return
+	}
+	c.checked[fn] = true
+
+	name := funcName(fn)
+	if name != entry {
+		name += " (called from " + entry + ")"
+	}
+	c.checkBody(fn, name)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			if callee, ok := c.funcs[fun.Name]; ok && !c.isLocal(fn, fun) {
+				c.walk(callee, entry)
+			}
+		case *ast.SelectorExpr:
+			if ident, ok := fun.X.(*ast.Ident); ok && c.importPath(c.files[fn], ident) != "" {
+				return true
+			}
+			for _, callee := range c.methods[fun.Sel.Name] {
+				c.walk(callee, entry)
+			}
+		}
+		return true
+	})
+}
+
+// checkBody reports the non-deterministic code of one function
+func (c *checker) checkBody(fn *ast.FuncDecl, name string) {
+	file := c.files[fn]
+	locals := localNames(fn)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.GoStmt:
+			c.report(node.Pos(), CheckGoroutine, SeverityError, name,
+				"goroutine started in a transaction; its effects race with the rest of the simulation")
+
+		case *ast.CallExpr:
+			c.checkCall(file, node, name)
+			if ident, ok := node.Fun.(*ast.Ident); ok && ident.Name == "delete" && len(node.Args) > 0 {
+				c.checkGlobalWrite(fn, locals, node.Args[0], name)
+			}
+
+		case *ast.AssignStmt:
+			if node.Tok == token.DEFINE {
+				return true
+			}
+			for _, lhs := range node.Lhs {
+				c.checkGlobalWrite(fn, locals, lhs, name)
+			}
+
+		case *ast.IncDecStmt:
+			c.checkGlobalWrite(fn, locals, node.X, name)
+
+		case *ast.RangeStmt:
+			if c.isMap(fn, locals, node.X) {
+				c.checkMapRange(fn, node, name)
+			}
+		}
+		return true
+	})
+}
+
+// checkCall reports calls into the clock, random sources and I/O
+func (c *checker) checkCall(file *ast.File, call *ast.CallExpr, name string) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+
+	// Find the package at the root of e.g. http.DefaultClient.Get
+	var first *ast.SelectorExpr
+	for expr := ast.Expr(sel); ; {
+		s, ok := expr.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		first = s
+		expr = s.X
+	}
+	ident, ok := first.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	path := c.importPath(file, ident)
+	callee := path + "." + first.Sel.Name
+
+	switch {
+	case path == "time" && timeFuncs[first.Sel.Name] && first == sel:
+		c.report(call.Pos(), CheckTime, SeverityError, name,
+			callee+" differs between endorsers; use the transaction timestamp from ctx.GetStub().GetTxTimestamp()")
+
+	case randomPackages[path]:
+		c.report(call.Pos(), CheckRandom, SeverityError, name,
+			callee+" returns different numbers on each endorser; derive values from the transaction ID or arguments")
+
+	case ioPackages[path] || (path == "os" && osFuncs[first.Sel.Name]):
+		c.report(call.Pos(), CheckIO, SeverityError, name,
+			callee+" reads or writes outside the ledger; endorsers can see different results")
+	}
+}
+
+// checkGlobalWrite reports assignments to package-level variables and to
+// the fields of a contract, which lives as long as the chaincode process
+func (c *checker) checkGlobalWrite(fn *ast.FuncDecl, locals map[string]bool, expr ast.Expr, name string) {
+	ident := rootIdent(expr)
+	if ident == nil || ident.Name == "_" {
+		return
+	}
+
+	global := false
+	if c.info != nil {
+		if v, ok := c.info.Uses[ident].(*types.Var); ok && v.Pkg() != nil && v.Parent() == v.Pkg().Scope() {
+			global = true
+		}
+	} else {
+		global = c.globals[ident.Name] && !locals[ident.Name]
+	}
+	if global {
+		c.report(expr.Pos(), CheckGlobalState, SeverityError, name,
+			fmt.Sprintf("package-level variable %s is written by a transaction; it is not shared between endorsers and is lost on restart, keep state in the ledger", ident.Name))
+		return
+	}
+
+	if expr != ast.Expr(ident) && c.contracts[recvName(fn)] && ident.Name == recvVar(fn) {
+		c.report(expr.Pos(), CheckGlobalState, SeverityError, name,
+			fmt.Sprintf("contract field %s is written by a transaction; it is not shared between endorsers and is lost on restart, keep state in the ledger", types.ExprString(expr)))
+	}
+}
+
+// checkMapRange reports map iterations whose random order reaches the
+// ledger, or a slice that is not sorted afterwards
+func (c *checker) checkMapRange(fn *ast.FuncDecl, loop *ast.RangeStmt, name string) {
+	var write *ast.CallExpr
+	var appended []string
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && ledgerWrites[sel.Sel.Name] && write == nil {
+			write = call
+		}
+		if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "append" && len(call.Args) > 0 {
+			if target := rootIdent(call.Args[0]); target != nil {
+				appended = append(appended, target.Name)
+			}
+		}
+		return true
+	})
+
+	if write != nil {
+		c.report(loop.Pos(), CheckMapIteration, SeverityError, name,
+			"map is iterated in random order and the loop writes to the ledger; iterate over sorted keys")
+		return
+	}
+	for _, slice := range appended {
+		if !sortedAfter(fn, loop, slice) {
+			c.report(loop.Pos(), CheckMapIteration, SeverityWarning, name,
+				fmt.Sprintf("%s is built in random map order; sort it before writing or returning it", slice))
+			return
+		}
+	}
+}
+
+// isMap reports whether an expression is a map, by its type when the
+// package was type-checked and by its declaration otherwise
+func (c *checker) isMap(fn *ast.FuncDecl, locals map[string]bool, expr ast.Expr) bool {
+	if c.info != nil {
+		if t := c.info.TypeOf(expr); t != nil {
+			_, ok := t.Underlying().(*types.Map)
+			return ok
+		}
+	}
+
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.CompositeLit:
+		_, ok := e.Type.(*ast.MapType)
+		return ok
+	case *ast.CallExpr:
+		return isMakeMap(e)
+	case *ast.SelectorExpr:
+		return c.mapFields[e.Sel.Name]
+	case *ast.Ident:
+		if mapLocals(fn)[e.Name] {
+			return true
+		}
+		return !locals[e.Name] && c.globalMap(e.Name)
+	}
+	return false
+}
+
+// globalMap reports whether a package-level variable is declared as a map
+func (c *checker) globalMap(name string) bool {
+	for _, file := range c.files {
+		for _, decl := range file.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, ident := range vs.Names {
+					if ident.Name != name {
+						continue
+					}
+					if _, ok := vs.Type.(*ast.MapType); ok {
+						return true
+					}
+					if i < len(vs.Values) && isMapValue(vs.Values[i]) {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// importPath returns the package an identifier refers to, or "" when it
+// is not an imported package
+func (c *checker) importPath(file *ast.File, ident *ast.Ident) string {
+	if c.info != nil {
+		if pkg, ok := c.info.Uses[ident].(*types.PkgName); ok {
+			return pkg.Imported().Path()
+		}
+		return ""
+	}
+	if file == nil {
+		return ""
+	}
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := importName(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if name == ident.Name {
+			return path
+		}
+	}
+	return ""
+}
+
+// isLocal reports whether an identifier is declared inside a function
+func (c *checker) isLocal(fn *ast.FuncDecl, ident *ast.Ident) bool {
+	if c.info != nil {
+		_, ok := c.info.Uses[ident].(*types.Func)
+		return !ok
+	}
+	return localNames(fn)[ident.Name]
+}
+
+func (c *checker) report(pos token.Pos, check, severity, function, message string) {
+	c.findings = append(c.findings, finding{
+		pos:      pos,
+		check:    check,
+		severity: severity,
+		function: function,
+		message:  message,
+	})
+}
+
+// importName is the default name of an imported package, skipping major
+// version suffixes such as math/rand/v2
+func importName(path string) string {
+	parts := strings.Split(path, "/")
+	name := parts[len(parts)-1]
+	if len(parts) > 1 && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		name = parts[len(parts)-2]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(name, "go-"), "-go")
+}
+
+// localNames returns the names declared inside a function, including its
+// receiver, parameters and results
+func localNames(fn *ast.FuncDecl) map[string]bool {
+	names := make(map[string]bool)
+	addFields := func(fields *ast.FieldList) {
+		if fields == nil {
+			return
+		}
+		for _, field := range fields.List {
+			for _, name := range field.Names {
+				names[name.Name] = true
+			}
+		}
+	}
+	addFields(fn.Recv)
+	addFields(fn.Type.Params)
+	addFields(fn.Type.Results)
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			if node.Tok == token.DEFINE {
+				for _, lhs := range node.Lhs {
+					if ident, ok := lhs.(*ast.Ident); ok {
+						names[ident.Name] = true
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for _, name := range node.Names {
+				names[name.Name] = true
+			}
+		case *ast.RangeStmt:
+			if node.Tok == token.DEFINE {
+				for _, expr := range []ast.Expr{node.Key, node.Value} {
+					if ident, ok := expr.(*ast.Ident); ok {
+						names[ident.Name] = true
+					}
+				}
+			}
+		case *ast.FuncLit:
+			addFields(node.Type.Params)
+			addFields(node.Type.Results)
+		}
+		return true
+	})
+	return names
+}
+
+// mapLocals returns the variables and parameters of a function declared
+// as maps
+func mapLocals(fn *ast.FuncDecl) map[string]bool {
+	maps := make(map[string]bool)
+	for _, fields := range []*ast.FieldList{fn.Recv, fn.Type.Params} {
+		if fields == nil {
+			continue
+		}
+		for _, field := range fields.List {
+			if _, ok := field.Type.(*ast.MapType); ok {
+				for _, name := range field.Names {
+					maps[name.Name] = true
+				}
+			}
+		}
+	}
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.ValueSpec:
+			_, typed := node.Type.(*ast.MapType)
+			for i, name := range node.Names {
+				if typed || (i < len(node.Values) && isMapValue(node.Values[i])) {
+					maps[name.Name] = true
+				}
+			}
+		case *ast.AssignStmt:
+			if len(node.Lhs) != len(node.Rhs) {
+				return true
+			}
+			for i, lhs := range node.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && isMapValue(node.Rhs[i]) {
+					maps[ident.Name] = true
+				}
+			}
+		}
+		return true
+	})
+	return maps
+}
+
+// isMapValue reports whether an expression creates a map
+func isMapValue(expr ast.Expr) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.CompositeLit:
+		_, ok := e.Type.(*ast.MapType)
+		return ok
+	case *ast.CallExpr:
+		return isMakeMap(e)
+	}
+	return false
+}
+
+func isMakeMap(call *ast.CallExpr) bool {
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok || ident.Name != "make" || len(call.Args) == 0 {
+		return false
+	}
+	_, ok = call.Args[0].(*ast.MapType)
+	return ok
+}
+
+// sortedAfter reports whether a slice is passed to the sort or slices
+// package after a loop
+func sortedAfter(fn *ast.FuncDecl, loop *ast.RangeStmt, slice string) bool {
+	sorted := false
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || call.Pos() < loop.End() {
+			return !sorted
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if pkg, ok := sel.X.(*ast.Ident); !ok || (pkg.Name != "sort" && pkg.Name != "slices") {
+			return true
+		}
+		for _, arg := range call.Args {
+			if ident := rootIdent(arg); ident != nil && ident.Name == slice {
+				sorted = true
+			}
+		}
+		return !sorted
+	})
+	return sorted
+}
+
+// rootIdent returns the variable at the root of e.g. a.b[c].d
+func rootIdent(expr ast.Expr) *ast.Ident {
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e
+		case *ast.SelectorExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		case *ast.SliceExpr:
+			expr = e.X
+		default:
+			return nil
+		}
+	}
+}
+
+// recvName returns the type name of a method's receiver
+func recvName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	expr := fn.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	switch e := expr.(type) {
+	case *ast.IndexExpr:
+		expr = e.X
+	case *ast.IndexListExpr:
+		expr = e.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// recvVar returns the name of a method's receiver variable
+func recvVar(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 || len(fn.Recv.List[0].Names) == 0 {
+		return ""
+	}
+	return fn.Recv.List[0].Names[0].Name
+}
+
+func funcName(fn *ast.FuncDecl) string {
+	if recv := recvName(fn); recv != "" {
+		return recv + "." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
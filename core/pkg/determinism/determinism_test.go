@@ -0,0 +1,109 @@
+// core/pkg/determinism/determinism_test.go
+package determinism
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/temmyjay001/core/pkg/templates"
+)
+
+// TestAnalyzer checks the type-checked analysis pass against the want
+// comments of testdata/src/chaincode
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "chaincode")
+}
+
+// TestCheckDir checks the runtime, untyped check reports the same lines as
+// the analysis pass
+func TestCheckDir(t *testing.T) {
+	dir := filepath.Join("testdata", "src", "chaincode")
+	diagnostics, err := CheckDir(dir)
+	if err != nil {
+		t.Fatalf("CheckDir() error = %v", err)
+	}
+
+	source, err := os.ReadFile(filepath.Join(dir, "contract.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := make(map[int]int)
+	wantPattern := regexp.MustCompile("`[^`]*`")
+	for i, line := range strings.Split(string(source), "\n") {
+		if _, comment, ok := strings.Cut(line, "// want "); ok {
+			want[i+1] = len(wantPattern.FindAllString(comment, -1))
+		}
+	}
+
+	got := make(map[int]int)
+	for _, d := range diagnostics {
+		if d.File != "contract.go" {
+			t.Errorf("File = %s, want contract.go", d.File)
+		}
+		got[d.Line]++
+	}
+	for line, n := range want {
+		if got[line] != n {
+			t.Errorf("Line %d has %d diagnostics, want %d", line, got[line], n)
+		}
+	}
+	for line := range got {
+		if _, ok := want[line]; !ok {
+			t.Errorf("Unexpected diagnostic on line %d", line)
+		}
+	}
+
+	for _, d := range diagnostics {
+		wantSeverity := SeverityError
+		if d.Function == "Token.Accounts" {
+			wantSeverity = SeverityWarning
+		}
+		if d.Severity != wantSeverity {
+			t.Errorf("%s: severity = %s, want %s", d, d.Severity, wantSeverity)
+		}
+	}
+	if !HasErrors(diagnostics) {
+		t.Error("HasErrors() = false")
+	}
+}
+
+func TestCheckDirMissingFolder(t *testing.T) {
+	if _, err := CheckDir(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("CheckDir() of a missing folder should fail")
+	}
+}
+
+// TestTemplatesAreDeterministic checks the Go templates endorse the same
+// way on every peer
+func TestTemplatesAreDeterministic(t *testing.T) {
+	list, err := templates.List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	for _, tmpl := range list {
+		t.Run(tmpl.Name, func(t *testing.T) {
+			files, err := templates.Render(tmpl.Name, &templates.Options{})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			dir := filepath.Join(t.TempDir(), tmpl.Name)
+			if err := templates.WriteDir(dir, files); err != nil {
+				t.Fatalf("WriteDir() error = %v", err)
+			}
+
+			diagnostics, err := CheckDir(dir)
+			if err != nil {
+				t.Fatalf("CheckDir() error = %v", err)
+			}
+			for _, d := range diagnostics {
+				t.Errorf("%s", d)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package chaincode
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	mrand "math/rand"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hyperledger/fabric-contract-api-go/contractapi"
+)
+
+var counter int
+var cache = map[string]string{}
+
+type Token struct {
+	contractapi.Contract
+	calls int
+}
+
+func (t *Token) Stamp(ctx contractapi.TransactionContextInterface) error {
+	return ctx.GetStub().PutState("stamp", []byte(time.Now().String())) // want `time.Now differs between endorsers`
+}
+
+func (t *Token) Age(ctx contractapi.TransactionContextInterface, key string) error {
+	return ctx.GetStub().PutState(key, []byte(elapsed().String()))
+}
+
+func elapsed() time.Duration {
+	return time.Since(time.Unix(0, 0)) // want `elapsed \(called from Token.Age\): error: time.Since`
+}
+
+func (t *Token) Lottery(ctx contractapi.TransactionContextInterface) error {
+	buf := make([]byte, 8)
+	rand.Read(buf)                                                   // want `crypto/rand.Read returns different numbers`
+	return ctx.GetStub().PutState(strconv.Itoa(mrand.Intn(10)), buf) // want `math/rand.Intn returns different numbers`
+}
+
+func (t *Token) Price(ctx contractapi.TransactionContextInterface) error {
+	resp, err := http.Get(os.Getenv("PRICE_FEED")) // want `net/http.Get reads or writes outside the ledger` `os.Getenv reads or writes outside the ledger`
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (t *Token) Notify(ctx contractapi.TransactionContextInterface) error {
+	go func() {}() // want `goroutine started in a transaction`
+	return nil
+}
+
+func (t *Token) Count(ctx contractapi.TransactionContextInterface, key string) error {
+	counter++           // want `package-level variable counter is written`
+	cache[key] = "seen" // want `package-level variable cache is written`
+	t.calls++           // want `contract field t.calls is written`
+	return nil
+}
+
+func (t *Token) Airdrop(ctx contractapi.TransactionContextInterface, data string) error {
+	var balances map[string]int
+	if err := json.Unmarshal([]byte(data), &balances); err != nil {
+		return err
+	}
+	for account, amount := range balances { // want `map is iterated in random order and the loop writes to the ledger`
+		if err := ctx.GetStub().PutState(account, []byte(strconv.Itoa(amount))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Token) Accounts(ctx contractapi.TransactionContextInterface, balances map[string]int) []string {
+	var accounts []string
+	for account := range balances { // want `accounts is built in random map order`
+		accounts = append(accounts, account)
+	}
+	return accounts
+}
+
+// SortedAccounts, Timestamp and Counter are deterministic
+func (t *Token) SortedAccounts(ctx contractapi.TransactionContextInterface, balances map[string]int) []string {
+	var accounts []string
+	for account := range balances {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+	return accounts
+}
+
+func (t *Token) Timestamp(ctx contractapi.TransactionContextInterface) string {
+	return ctx.GetStub().GetTxID()
+}
+
+func (t *Token) Counter(ctx contractapi.TransactionContextInterface) int {
+	counter := 0
+	counter++
+	return counter
+}
+
+// warmUp is never called by a transaction
+func warmUp() {
+	counter = int(time.Now().Unix())
+}
@@ -0,0 +1,15 @@
+// Package contractapi stubs the parts of the contract API the tests use
+package contractapi
+
+type Contract struct{}
+
+type Stub interface {
+	GetState(key string) ([]byte, error)
+	PutState(key string, value []byte) error
+	SetEvent(name string, payload []byte) error
+	GetTxID() string
+}
+
+type TransactionContextInterface interface {
+	GetStub() Stub
+}
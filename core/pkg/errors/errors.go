@@ -29,11 +29,53 @@ var (
 	// ErrChaincodeDeployFailed is returned when chaincode deployment fails
 	ErrChaincodeDeployFailed = errors.New("chaincode deployment failed")
 
+	// ErrChaincodeNotFound is returned when a chaincode has no committed definition
+	ErrChaincodeNotFound = errors.New("chaincode not found")
+
 	// ErrTransactionFailed is returned when a transaction fails
 	ErrTransactionFailed = errors.New("transaction failed")
 
+	// ErrEndorsementRejected is returned when an endorser's chaincode returned
+	// an error status, so the transaction was not ordered
+	ErrEndorsementRejected = errors.New("transaction rejected at endorsement")
+
+	// ErrEndorsementMismatch is returned when endorsers returned different
+	// read-write sets or responses, so the transaction was not ordered
+	ErrEndorsementMismatch = errors.New("endorsement responses do not match")
+
+	// ErrTransactionInvalidated is returned when an endorsed transaction was
+	// ordered but the committing peers marked it invalid
+	ErrTransactionInvalidated = errors.New("transaction invalidated")
+
 	// ErrInvalidConfig is returned when configuration is invalid
 	ErrInvalidConfig = errors.New("invalid configuration")
+
+	// ErrIdentityFailed is returned when a Fabric CA identity operation fails
+	ErrIdentityFailed = errors.New("identity operation failed")
+
+	// ErrDefinitionRejected is returned when orgs rejected a staged chaincode
+	// definition and the remaining approvals cannot satisfy the lifecycle policy
+	ErrDefinitionRejected = errors.New("chaincode definition rejected")
+
+	// ErrDefinitionMismatch is returned when an org approved a different
+	// chaincode definition at the staged sequence
+	ErrDefinitionMismatch = errors.New("chaincode definition mismatch")
+
+	// ErrApprovalsMissing is returned when a staged chaincode definition does
+	// not have enough approvals to be committed yet
+	ErrApprovalsMissing = errors.New("chaincode definition approvals missing")
+
+	// ErrGoModules is returned when Go chaincode dependencies cannot be
+	// vendored, e.g. because go.sum is missing entries or does not match
+	ErrGoModules = errors.New("go module dependencies invalid")
+
+	// ErrTemplateNotFound is returned when a chaincode template or one of
+	// its languages does not exist
+	ErrTemplateNotFound = errors.New("chaincode template not found")
+
+	// ErrNondeterministic is returned when a determinism check finds code
+	// that endorsers can execute differently
+	ErrNondeterministic = errors.New("chaincode is not deterministic")
 )
 
 // FabricXError wraps errors with additional context
@@ -98,6 +140,11 @@ func WrapWithContext(op string, err error, context map[string]interface{}) error
 	}
 }
 
+// Join combines the errors of steps that ran in parallel, skipping nils
+func Join(errs ...error) error {
+	return errors.Join(errs...)
+}
+
 // IsBinaryMissing checks if error is due to missing binary
 func IsBinaryMissing(err error) bool {
 	return errors.Is(err, ErrBinaryMissing)
@@ -117,3 +164,53 @@ func IsNetworkNotFound(err error) bool {
 func IsDockerUnavailable(err error) bool {
 	return errors.Is(err, ErrDockerUnavailable)
 }
+
+// IsChaincodeNotFound checks if error is due to a chaincode without a definition
+func IsChaincodeNotFound(err error) bool {
+	return errors.Is(err, ErrChaincodeNotFound)
+}
+
+// IsEndorsementRejected checks if error is due to a transaction failing endorsement
+func IsEndorsementRejected(err error) bool {
+	return errors.Is(err, ErrEndorsementRejected)
+}
+
+// IsEndorsementMismatch checks if error is due to endorsers disagreeing on a transaction
+func IsEndorsementMismatch(err error) bool {
+	return errors.Is(err, ErrEndorsementMismatch)
+}
+
+// IsTransactionInvalidated checks if error is due to an ordered transaction failing validation
+func IsTransactionInvalidated(err error) bool {
+	return errors.Is(err, ErrTransactionInvalidated)
+}
+
+// IsDefinitionRejected checks if error is due to orgs rejecting a staged definition
+func IsDefinitionRejected(err error) bool {
+	return errors.Is(err, ErrDefinitionRejected)
+}
+
+// IsDefinitionMismatch checks if error is due to orgs approving a different definition
+func IsDefinitionMismatch(err error) bool {
+	return errors.Is(err, ErrDefinitionMismatch)
+}
+
+// IsApprovalsMissing checks if error is due to a staged definition lacking approvals
+func IsApprovalsMissing(err error) bool {
+	return errors.Is(err, ErrApprovalsMissing)
+}
+
+// IsGoModules checks if error is due to Go chaincode dependencies failing to vendor
+func IsGoModules(err error) bool {
+	return errors.Is(err, ErrGoModules)
+}
+
+// IsTemplateNotFound checks if error is due to an unknown chaincode template or language
+func IsTemplateNotFound(err error) bool {
+	return errors.Is(err, ErrTemplateNotFound)
+}
+
+// IsNondeterministic checks if error is due to chaincode failing the determinism check
+func IsNondeterministic(err error) bool {
+	return errors.Is(err, ErrNondeterministic)
+}
@@ -4,6 +4,7 @@ package executor
 import (
 	"context"
 	"os/exec"
+	"sync"
 )
 
 // Executor defines the interface for executing commands
@@ -117,6 +118,7 @@ type MockExecutor struct {
 
 	// Recording for verification
 	Calls []Call
+	mu    sync.Mutex
 }
 
 // Call records a command execution
@@ -134,7 +136,7 @@ func NewMockExecutor() *MockExecutor {
 
 // Execute mocks command execution
 func (m *MockExecutor) Execute(ctx context.Context, name string, args ...string) ([]byte, error) {
-	m.Calls = append(m.Calls, Call{Name: name, Args: args})
+	m.record(name, args)
 
 	if m.ExecuteFunc != nil {
 		return m.ExecuteFunc(ctx, name, args...)
@@ -145,7 +147,7 @@ func (m *MockExecutor) Execute(ctx context.Context, name string, args ...string)
 
 // ExecuteCombined mocks combined output execution
 func (m *MockExecutor) ExecuteCombined(ctx context.Context, name string, args ...string) ([]byte, error) {
-	m.Calls = append(m.Calls, Call{Name: name, Args: args})
+	m.record(name, args)
 
 	if m.ExecuteCombinedFunc != nil {
 		return m.ExecuteCombinedFunc(ctx, name, args...)
@@ -156,7 +158,7 @@ func (m *MockExecutor) ExecuteCombined(ctx context.Context, name string, args ..
 
 // ExecuteStream mocks streaming execution
 func (m *MockExecutor) ExecuteStream(ctx context.Context, name string, args ...string) (<-chan string, <-chan error, error) {
-	m.Calls = append(m.Calls, Call{Name: name, Args: args})
+	m.record(name, args)
 
 	if m.ExecuteStreamFunc != nil {
 		return m.ExecuteStreamFunc(ctx, name, args...)
@@ -174,19 +176,30 @@ func (m *MockExecutor) ExecuteStream(ctx context.Context, name string, args ...s
 	return outChan, errChan, nil
 }
 
+// record appends a call; commands may run from several goroutines
+func (m *MockExecutor) record(name string, args []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Calls = append(m.Calls, Call{Name: name, Args: args})
+}
+
 // GetCalls returns all recorded calls
 func (m *MockExecutor) GetCalls() []Call {
-	return m.Calls
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call(nil), m.Calls...)
 }
 
 // Reset clears all recorded calls
 func (m *MockExecutor) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.Calls = make([]Call, 0)
 }
 
 // WasCalledWith checks if executor was called with specific command
 func (m *MockExecutor) WasCalledWith(name string, args ...string) bool {
-	for _, call := range m.Calls {
+	for _, call := range m.GetCalls() {
 		if call.Name == name {
 			if len(args) == 0 {
 				return true
@@ -0,0 +1,572 @@
+// protos/fabric/common/common.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: common/common.proto
+
+package common
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Status mirrors the HTTP status codes peers and orderers reply with
+type Status int32
+
+const (
+	Status_UNKNOWN                  Status = 0
+	Status_SUCCESS                  Status = 200
+	Status_BAD_REQUEST              Status = 400
+	Status_FORBIDDEN                Status = 403
+	Status_NOT_FOUND                Status = 404
+	Status_REQUEST_ENTITY_TOO_LARGE Status = 413
+	Status_INTERNAL_SERVER_ERROR    Status = 500
+	Status_NOT_IMPLEMENTED          Status = 501
+	Status_SERVICE_UNAVAILABLE      Status = 503
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0:   "UNKNOWN",
+		200: "SUCCESS",
+		400: "BAD_REQUEST",
+		403: "FORBIDDEN",
+		404: "NOT_FOUND",
+		413: "REQUEST_ENTITY_TOO_LARGE",
+		500: "INTERNAL_SERVER_ERROR",
+		501: "NOT_IMPLEMENTED",
+		503: "SERVICE_UNAVAILABLE",
+	}
+	Status_value = map[string]int32{
+		"UNKNOWN":                  0,
+		"SUCCESS":                  200,
+		"BAD_REQUEST":              400,
+		"FORBIDDEN":                403,
+		"NOT_FOUND":                404,
+		"REQUEST_ENTITY_TOO_LARGE": 413,
+		"INTERNAL_SERVER_ERROR":    500,
+		"NOT_IMPLEMENTED":          501,
+		"SERVICE_UNAVAILABLE":      503,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_common_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_common_common_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{0}
+}
+
+type HeaderType int32
+
+const (
+	HeaderType_MESSAGE              HeaderType = 0
+	HeaderType_CONFIG               HeaderType = 1
+	HeaderType_CONFIG_UPDATE        HeaderType = 2
+	HeaderType_ENDORSER_TRANSACTION HeaderType = 3
+	HeaderType_ORDERER_TRANSACTION  HeaderType = 4
+	HeaderType_DELIVER_SEEK_INFO    HeaderType = 5
+	HeaderType_CHAINCODE_PACKAGE    HeaderType = 6
+)
+
+// Enum value maps for HeaderType.
+var (
+	HeaderType_name = map[int32]string{
+		0: "MESSAGE",
+		1: "CONFIG",
+		2: "CONFIG_UPDATE",
+		3: "ENDORSER_TRANSACTION",
+		4: "ORDERER_TRANSACTION",
+		5: "DELIVER_SEEK_INFO",
+		6: "CHAINCODE_PACKAGE",
+	}
+	HeaderType_value = map[string]int32{
+		"MESSAGE":              0,
+		"CONFIG":               1,
+		"CONFIG_UPDATE":        2,
+		"ENDORSER_TRANSACTION": 3,
+		"ORDERER_TRANSACTION":  4,
+		"DELIVER_SEEK_INFO":    5,
+		"CHAINCODE_PACKAGE":    6,
+	}
+)
+
+func (x HeaderType) Enum() *HeaderType {
+	p := new(HeaderType)
+	*p = x
+	return p
+}
+
+func (x HeaderType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeaderType) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_common_proto_enumTypes[1].Descriptor()
+}
+
+func (HeaderType) Type() protoreflect.EnumType {
+	return &file_common_common_proto_enumTypes[1]
+}
+
+func (x HeaderType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeaderType.Descriptor instead.
+func (HeaderType) EnumDescriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{1}
+}
+
+type Header struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ChannelHeader   []byte                 `protobuf:"bytes,1,opt,name=channel_header,json=channelHeader,proto3" json:"channel_header,omitempty"`
+	SignatureHeader []byte                 `protobuf:"bytes,2,opt,name=signature_header,json=signatureHeader,proto3" json:"signature_header,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Header) Reset() {
+	*x = Header{}
+	mi := &file_common_common_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Header) ProtoMessage() {}
+
+func (x *Header) ProtoReflect() protoreflect.Message {
+	mi := &file_common_common_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Header.ProtoReflect.Descriptor instead.
+func (*Header) Descriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Header) GetChannelHeader() []byte {
+	if x != nil {
+		return x.ChannelHeader
+	}
+	return nil
+}
+
+func (x *Header) GetSignatureHeader() []byte {
+	if x != nil {
+		return x.SignatureHeader
+	}
+	return nil
+}
+
+type ChannelHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          int32                  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TxId          string                 `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Epoch         uint64                 `protobuf:"varint,6,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Extension     []byte                 `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	TlsCertHash   []byte                 `protobuf:"bytes,8,opt,name=tls_cert_hash,json=tlsCertHash,proto3" json:"tls_cert_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelHeader) Reset() {
+	*x = ChannelHeader{}
+	mi := &file_common_common_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelHeader) ProtoMessage() {}
+
+func (x *ChannelHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_common_common_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelHeader.ProtoReflect.Descriptor instead.
+func (*ChannelHeader) Descriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChannelHeader) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ChannelHeader) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ChannelHeader) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ChannelHeader) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChannelHeader) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ChannelHeader) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ChannelHeader) GetExtension() []byte {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *ChannelHeader) GetTlsCertHash() []byte {
+	if x != nil {
+		return x.TlsCertHash
+	}
+	return nil
+}
+
+type SignatureHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Creator       []byte                 `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Nonce         []byte                 `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignatureHeader) Reset() {
+	*x = SignatureHeader{}
+	mi := &file_common_common_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignatureHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureHeader) ProtoMessage() {}
+
+func (x *SignatureHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_common_common_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignatureHeader.ProtoReflect.Descriptor instead.
+func (*SignatureHeader) Descriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignatureHeader) GetCreator() []byte {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *SignatureHeader) GetNonce() []byte {
+	if x != nil {
+		return x.Nonce
+	}
+	return nil
+}
+
+type Payload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *Header                `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	mi := &file_common_common_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_common_common_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Payload) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Payload) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Envelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payload       []byte                 `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_common_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_common_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Envelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Envelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_common_common_proto protoreflect.FileDescriptor
+
+const file_common_common_proto_rawDesc = "" +
+	"\n" +
+	"\x13common/common.proto\x12\x06common\x1a\x1fgoogle/protobuf/timestamp.proto\"Z\n" +
+	"\x06Header\x12%\n" +
+	"\x0echannel_header\x18\x01 \x01(\fR\rchannelHeader\x12)\n" +
+	"\x10signature_header\x18\x02 \x01(\fR\x0fsignatureHeader\"\x83\x02\n" +
+	"\rChannelHeader\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\x05R\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x04 \x01(\tR\tchannelId\x12\x13\n" +
+	"\x05tx_id\x18\x05 \x01(\tR\x04txId\x12\x14\n" +
+	"\x05epoch\x18\x06 \x01(\x04R\x05epoch\x12\x1c\n" +
+	"\textension\x18\a \x01(\fR\textension\x12\"\n" +
+	"\rtls_cert_hash\x18\b \x01(\fR\vtlsCertHash\"A\n" +
+	"\x0fSignatureHeader\x12\x18\n" +
+	"\acreator\x18\x01 \x01(\fR\acreator\x12\x14\n" +
+	"\x05nonce\x18\x02 \x01(\fR\x05nonce\"E\n" +
+	"\aPayload\x12&\n" +
+	"\x06header\x18\x01 \x01(\v2\x0e.common.HeaderR\x06header\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"B\n" +
+	"\bEnvelope\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature*\xc0\x01\n" +
+	"\x06Status\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\f\n" +
+	"\aSUCCESS\x10\xc8\x01\x12\x10\n" +
+	"\vBAD_REQUEST\x10\x90\x03\x12\x0e\n" +
+	"\tFORBIDDEN\x10\x93\x03\x12\x0e\n" +
+	"\tNOT_FOUND\x10\x94\x03\x12\x1d\n" +
+	"\x18REQUEST_ENTITY_TOO_LARGE\x10\x9d\x03\x12\x1a\n" +
+	"\x15INTERNAL_SERVER_ERROR\x10\xf4\x03\x12\x14\n" +
+	"\x0fNOT_IMPLEMENTED\x10\xf5\x03\x12\x18\n" +
+	"\x13SERVICE_UNAVAILABLE\x10\xf7\x03*\x99\x01\n" +
+	"\n" +
+	"HeaderType\x12\v\n" +
+	"\aMESSAGE\x10\x00\x12\n" +
+	"\n" +
+	"\x06CONFIG\x10\x01\x12\x11\n" +
+	"\rCONFIG_UPDATE\x10\x02\x12\x18\n" +
+	"\x14ENDORSER_TRANSACTION\x10\x03\x12\x17\n" +
+	"\x13ORDERER_TRANSACTION\x10\x04\x12\x15\n" +
+	"\x11DELIVER_SEEK_INFO\x10\x05\x12\x15\n" +
+	"\x11CHAINCODE_PACKAGE\x10\x06B/Z-github.com/temmyjay001/core/pkg/fabric/commonb\x06proto3"
+
+var (
+	file_common_common_proto_rawDescOnce sync.Once
+	file_common_common_proto_rawDescData []byte
+)
+
+func file_common_common_proto_rawDescGZIP() []byte {
+	file_common_common_proto_rawDescOnce.Do(func() {
+		file_common_common_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_common_common_proto_rawDesc), len(file_common_common_proto_rawDesc)))
+	})
+	return file_common_common_proto_rawDescData
+}
+
+var file_common_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_common_common_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_common_common_proto_goTypes = []any{
+	(Status)(0),                   // 0: common.Status
+	(HeaderType)(0),               // 1: common.HeaderType
+	(*Header)(nil),                // 2: common.Header
+	(*ChannelHeader)(nil),         // 3: common.ChannelHeader
+	(*SignatureHeader)(nil),       // 4: common.SignatureHeader
+	(*Payload)(nil),               // 5: common.Payload
+	(*Envelope)(nil),              // 6: common.Envelope
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_common_common_proto_depIdxs = []int32{
+	7, // 0: common.ChannelHeader.timestamp:type_name -> google.protobuf.Timestamp
+	2, // 1: common.Payload.header:type_name -> common.Header
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_common_common_proto_init() }
+func file_common_common_proto_init() {
+	if File_common_common_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_common_proto_rawDesc), len(file_common_common_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_common_common_proto_goTypes,
+		DependencyIndexes: file_common_common_proto_depIdxs,
+		EnumInfos:         file_common_common_proto_enumTypes,
+		MessageInfos:      file_common_common_proto_msgTypes,
+	}.Build()
+	File_common_common_proto = out.File
+	file_common_common_proto_goTypes = nil
+	file_common_common_proto_depIdxs = nil
+}
@@ -0,0 +1,704 @@
+// protos/fabric/gateway/gateway.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: gateway/gateway.proto
+
+package gateway
+
+import (
+	common "github.com/temmyjay001/core/pkg/fabric/common"
+	peer "github.com/temmyjay001/core/pkg/fabric/peer"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EndorseRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId       string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ChannelId           string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ProposedTransaction *peer.SignedProposal   `protobuf:"bytes,3,opt,name=proposed_transaction,json=proposedTransaction,proto3" json:"proposed_transaction,omitempty"`
+	// MSP IDs of the orgs that must endorse; the gateway picks the peers
+	// from the endorsement policy when empty
+	EndorsingOrganizations []string `protobuf:"bytes,4,rep,name=endorsing_organizations,json=endorsingOrganizations,proto3" json:"endorsing_organizations,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *EndorseRequest) Reset() {
+	*x = EndorseRequest{}
+	mi := &file_gateway_gateway_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndorseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndorseRequest) ProtoMessage() {}
+
+func (x *EndorseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndorseRequest.ProtoReflect.Descriptor instead.
+func (*EndorseRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EndorseRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *EndorseRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *EndorseRequest) GetProposedTransaction() *peer.SignedProposal {
+	if x != nil {
+		return x.ProposedTransaction
+	}
+	return nil
+}
+
+func (x *EndorseRequest) GetEndorsingOrganizations() []string {
+	if x != nil {
+		return x.EndorsingOrganizations
+	}
+	return nil
+}
+
+type EndorseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unsigned transaction envelope built from the endorsements
+	PreparedTransaction *common.Envelope `protobuf:"bytes,1,opt,name=prepared_transaction,json=preparedTransaction,proto3" json:"prepared_transaction,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EndorseResponse) Reset() {
+	*x = EndorseResponse{}
+	mi := &file_gateway_gateway_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndorseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndorseResponse) ProtoMessage() {}
+
+func (x *EndorseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndorseResponse.ProtoReflect.Descriptor instead.
+func (*EndorseResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EndorseResponse) GetPreparedTransaction() *common.Envelope {
+	if x != nil {
+		return x.PreparedTransaction
+	}
+	return nil
+}
+
+type SubmitRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId       string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ChannelId           string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PreparedTransaction *common.Envelope       `protobuf:"bytes,3,opt,name=prepared_transaction,json=preparedTransaction,proto3" json:"prepared_transaction,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SubmitRequest) Reset() {
+	*x = SubmitRequest{}
+	mi := &file_gateway_gateway_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitRequest) ProtoMessage() {}
+
+func (x *SubmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitRequest.ProtoReflect.Descriptor instead.
+func (*SubmitRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SubmitRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SubmitRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SubmitRequest) GetPreparedTransaction() *common.Envelope {
+	if x != nil {
+		return x.PreparedTransaction
+	}
+	return nil
+}
+
+type SubmitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitResponse) Reset() {
+	*x = SubmitResponse{}
+	mi := &file_gateway_gateway_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitResponse) ProtoMessage() {}
+
+func (x *SubmitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitResponse.ProtoReflect.Descriptor instead.
+func (*SubmitResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{3}
+}
+
+type SignedCommitStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Marshaled CommitStatusRequest
+	Request       []byte `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Signature     []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedCommitStatusRequest) Reset() {
+	*x = SignedCommitStatusRequest{}
+	mi := &file_gateway_gateway_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedCommitStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedCommitStatusRequest) ProtoMessage() {}
+
+func (x *SignedCommitStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedCommitStatusRequest.ProtoReflect.Descriptor instead.
+func (*SignedCommitStatusRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SignedCommitStatusRequest) GetRequest() []byte {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *SignedCommitStatusRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type CommitStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ChannelId     string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Serialized identity of the client
+	Identity      []byte `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitStatusRequest) Reset() {
+	*x = CommitStatusRequest{}
+	mi := &file_gateway_gateway_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStatusRequest) ProtoMessage() {}
+
+func (x *CommitStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStatusRequest.ProtoReflect.Descriptor instead.
+func (*CommitStatusRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CommitStatusRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CommitStatusRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CommitStatusRequest) GetIdentity() []byte {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type CommitStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        peer.TxValidationCode  `protobuf:"varint,1,opt,name=result,proto3,enum=protos.TxValidationCode" json:"result,omitempty"`
+	BlockNumber   uint64                 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitStatusResponse) Reset() {
+	*x = CommitStatusResponse{}
+	mi := &file_gateway_gateway_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitStatusResponse) ProtoMessage() {}
+
+func (x *CommitStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStatusResponse.ProtoReflect.Descriptor instead.
+func (*CommitStatusResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CommitStatusResponse) GetResult() peer.TxValidationCode {
+	if x != nil {
+		return x.Result
+	}
+	return peer.TxValidationCode(0)
+}
+
+func (x *CommitStatusResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type EvaluateRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId       string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ChannelId           string                 `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ProposedTransaction *peer.SignedProposal   `protobuf:"bytes,3,opt,name=proposed_transaction,json=proposedTransaction,proto3" json:"proposed_transaction,omitempty"`
+	// MSP IDs of the orgs whose peers may evaluate
+	TargetOrganizations []string `protobuf:"bytes,4,rep,name=target_organizations,json=targetOrganizations,proto3" json:"target_organizations,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *EvaluateRequest) Reset() {
+	*x = EvaluateRequest{}
+	mi := &file_gateway_gateway_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateRequest) ProtoMessage() {}
+
+func (x *EvaluateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EvaluateRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *EvaluateRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *EvaluateRequest) GetProposedTransaction() *peer.SignedProposal {
+	if x != nil {
+		return x.ProposedTransaction
+	}
+	return nil
+}
+
+func (x *EvaluateRequest) GetTargetOrganizations() []string {
+	if x != nil {
+		return x.TargetOrganizations
+	}
+	return nil
+}
+
+type EvaluateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *peer.Response         `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateResponse) Reset() {
+	*x = EvaluateResponse{}
+	mi := &file_gateway_gateway_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateResponse) ProtoMessage() {}
+
+func (x *EvaluateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EvaluateResponse) GetResult() *peer.Response {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Details of the google.rpc.Status the gateway returns when peers or
+// orderers fail a request
+type ErrorDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	MspId         string                 `protobuf:"bytes,2,opt,name=msp_id,json=mspId,proto3" json:"msp_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	mi := &file_gateway_gateway_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_gateway_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_gateway_gateway_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ErrorDetail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetMspId() string {
+	if x != nil {
+		return x.MspId
+	}
+	return ""
+}
+
+func (x *ErrorDetail) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_gateway_gateway_proto protoreflect.FileDescriptor
+
+const file_gateway_gateway_proto_rawDesc = "" +
+	"\n" +
+	"\x15gateway/gateway.proto\x12\agateway\x1a\x13common/common.proto\x1a\x13peer/proposal.proto\x1a\x1cpeer/proposal_response.proto\x1a\x16peer/transaction.proto\"\xda\x01\n" +
+	"\x0eEndorseRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12I\n" +
+	"\x14proposed_transaction\x18\x03 \x01(\v2\x16.protos.SignedProposalR\x13proposedTransaction\x127\n" +
+	"\x17endorsing_organizations\x18\x04 \x03(\tR\x16endorsingOrganizations\"V\n" +
+	"\x0fEndorseResponse\x12C\n" +
+	"\x14prepared_transaction\x18\x01 \x01(\v2\x10.common.EnvelopeR\x13preparedTransaction\"\x9a\x01\n" +
+	"\rSubmitRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12C\n" +
+	"\x14prepared_transaction\x18\x03 \x01(\v2\x10.common.EnvelopeR\x13preparedTransaction\"\x10\n" +
+	"\x0eSubmitResponse\"S\n" +
+	"\x19SignedCommitStatusRequest\x12\x18\n" +
+	"\arequest\x18\x01 \x01(\fR\arequest\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\"w\n" +
+	"\x13CommitStatusRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\fR\bidentity\"k\n" +
+	"\x14CommitStatusResponse\x120\n" +
+	"\x06result\x18\x01 \x01(\x0e2\x18.protos.TxValidationCodeR\x06result\x12!\n" +
+	"\fblock_number\x18\x02 \x01(\x04R\vblockNumber\"\xd5\x01\n" +
+	"\x0fEvaluateRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x02 \x01(\tR\tchannelId\x12I\n" +
+	"\x14proposed_transaction\x18\x03 \x01(\v2\x16.protos.SignedProposalR\x13proposedTransaction\x121\n" +
+	"\x14target_organizations\x18\x04 \x03(\tR\x13targetOrganizations\"<\n" +
+	"\x10EvaluateResponse\x12(\n" +
+	"\x06result\x18\x01 \x01(\v2\x10.protos.ResponseR\x06result\"X\n" +
+	"\vErrorDetail\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x15\n" +
+	"\x06msp_id\x18\x02 \x01(\tR\x05mspId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage2\x96\x02\n" +
+	"\aGateway\x12<\n" +
+	"\aEndorse\x12\x17.gateway.EndorseRequest\x1a\x18.gateway.EndorseResponse\x129\n" +
+	"\x06Submit\x12\x16.gateway.SubmitRequest\x1a\x17.gateway.SubmitResponse\x12Q\n" +
+	"\fCommitStatus\x12\".gateway.SignedCommitStatusRequest\x1a\x1d.gateway.CommitStatusResponse\x12?\n" +
+	"\bEvaluate\x12\x18.gateway.EvaluateRequest\x1a\x19.gateway.EvaluateResponseB0Z.github.com/temmyjay001/core/pkg/fabric/gatewayb\x06proto3"
+
+var (
+	file_gateway_gateway_proto_rawDescOnce sync.Once
+	file_gateway_gateway_proto_rawDescData []byte
+)
+
+func file_gateway_gateway_proto_rawDescGZIP() []byte {
+	file_gateway_gateway_proto_rawDescOnce.Do(func() {
+		file_gateway_gateway_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_gateway_gateway_proto_rawDesc), len(file_gateway_gateway_proto_rawDesc)))
+	})
+	return file_gateway_gateway_proto_rawDescData
+}
+
+var file_gateway_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_gateway_gateway_proto_goTypes = []any{
+	(*EndorseRequest)(nil),            // 0: gateway.EndorseRequest
+	(*EndorseResponse)(nil),           // 1: gateway.EndorseResponse
+	(*SubmitRequest)(nil),             // 2: gateway.SubmitRequest
+	(*SubmitResponse)(nil),            // 3: gateway.SubmitResponse
+	(*SignedCommitStatusRequest)(nil), // 4: gateway.SignedCommitStatusRequest
+	(*CommitStatusRequest)(nil),       // 5: gateway.CommitStatusRequest
+	(*CommitStatusResponse)(nil),      // 6: gateway.CommitStatusResponse
+	(*EvaluateRequest)(nil),           // 7: gateway.EvaluateRequest
+	(*EvaluateResponse)(nil),          // 8: gateway.EvaluateResponse
+	(*ErrorDetail)(nil),               // 9: gateway.ErrorDetail
+	(*peer.SignedProposal)(nil),       // 10: protos.SignedProposal
+	(*common.Envelope)(nil),           // 11: common.Envelope
+	(peer.TxValidationCode)(0),        // 12: protos.TxValidationCode
+	(*peer.Response)(nil),             // 13: protos.Response
+}
+var file_gateway_gateway_proto_depIdxs = []int32{
+	10, // 0: gateway.EndorseRequest.proposed_transaction:type_name -> protos.SignedProposal
+	11, // 1: gateway.EndorseResponse.prepared_transaction:type_name -> common.Envelope
+	11, // 2: gateway.SubmitRequest.prepared_transaction:type_name -> common.Envelope
+	12, // 3: gateway.CommitStatusResponse.result:type_name -> protos.TxValidationCode
+	10, // 4: gateway.EvaluateRequest.proposed_transaction:type_name -> protos.SignedProposal
+	13, // 5: gateway.EvaluateResponse.result:type_name -> protos.Response
+	0,  // 6: gateway.Gateway.Endorse:input_type -> gateway.EndorseRequest
+	2,  // 7: gateway.Gateway.Submit:input_type -> gateway.SubmitRequest
+	4,  // 8: gateway.Gateway.CommitStatus:input_type -> gateway.SignedCommitStatusRequest
+	7,  // 9: gateway.Gateway.Evaluate:input_type -> gateway.EvaluateRequest
+	1,  // 10: gateway.Gateway.Endorse:output_type -> gateway.EndorseResponse
+	3,  // 11: gateway.Gateway.Submit:output_type -> gateway.SubmitResponse
+	6,  // 12: gateway.Gateway.CommitStatus:output_type -> gateway.CommitStatusResponse
+	8,  // 13: gateway.Gateway.Evaluate:output_type -> gateway.EvaluateResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_gateway_gateway_proto_init() }
+func file_gateway_gateway_proto_init() {
+	if File_gateway_gateway_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_gateway_gateway_proto_rawDesc), len(file_gateway_gateway_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gateway_gateway_proto_goTypes,
+		DependencyIndexes: file_gateway_gateway_proto_depIdxs,
+		MessageInfos:      file_gateway_gateway_proto_msgTypes,
+	}.Build()
+	File_gateway_gateway_proto = out.File
+	file_gateway_gateway_proto_goTypes = nil
+	file_gateway_gateway_proto_depIdxs = nil
+}
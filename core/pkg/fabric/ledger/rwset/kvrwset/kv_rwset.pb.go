@@ -0,0 +1,944 @@
+// protos/fabric/ledger/rwset/kvrwset/kv_rwset.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: ledger/rwset/kvrwset/kv_rwset.proto
+
+package kvrwset
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type KVRWSet struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Reads            []*KVRead              `protobuf:"bytes,1,rep,name=reads,proto3" json:"reads,omitempty"`
+	RangeQueriesInfo []*RangeQueryInfo      `protobuf:"bytes,2,rep,name=range_queries_info,json=rangeQueriesInfo,proto3" json:"range_queries_info,omitempty"`
+	Writes           []*KVWrite             `protobuf:"bytes,3,rep,name=writes,proto3" json:"writes,omitempty"`
+	MetadataWrites   []*KVMetadataWrite     `protobuf:"bytes,4,rep,name=metadata_writes,json=metadataWrites,proto3" json:"metadata_writes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *KVRWSet) Reset() {
+	*x = KVRWSet{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVRWSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVRWSet) ProtoMessage() {}
+
+func (x *KVRWSet) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVRWSet.ProtoReflect.Descriptor instead.
+func (*KVRWSet) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KVRWSet) GetReads() []*KVRead {
+	if x != nil {
+		return x.Reads
+	}
+	return nil
+}
+
+func (x *KVRWSet) GetRangeQueriesInfo() []*RangeQueryInfo {
+	if x != nil {
+		return x.RangeQueriesInfo
+	}
+	return nil
+}
+
+func (x *KVRWSet) GetWrites() []*KVWrite {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+func (x *KVRWSet) GetMetadataWrites() []*KVMetadataWrite {
+	if x != nil {
+		return x.MetadataWrites
+	}
+	return nil
+}
+
+type HashedRWSet struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	HashedReads    []*KVReadHash          `protobuf:"bytes,1,rep,name=hashed_reads,json=hashedReads,proto3" json:"hashed_reads,omitempty"`
+	HashedWrites   []*KVWriteHash         `protobuf:"bytes,2,rep,name=hashed_writes,json=hashedWrites,proto3" json:"hashed_writes,omitempty"`
+	MetadataWrites []*KVMetadataWriteHash `protobuf:"bytes,3,rep,name=metadata_writes,json=metadataWrites,proto3" json:"metadata_writes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *HashedRWSet) Reset() {
+	*x = HashedRWSet{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashedRWSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashedRWSet) ProtoMessage() {}
+
+func (x *HashedRWSet) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashedRWSet.ProtoReflect.Descriptor instead.
+func (*HashedRWSet) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HashedRWSet) GetHashedReads() []*KVReadHash {
+	if x != nil {
+		return x.HashedReads
+	}
+	return nil
+}
+
+func (x *HashedRWSet) GetHashedWrites() []*KVWriteHash {
+	if x != nil {
+		return x.HashedWrites
+	}
+	return nil
+}
+
+func (x *HashedRWSet) GetMetadataWrites() []*KVMetadataWriteHash {
+	if x != nil {
+		return x.MetadataWrites
+	}
+	return nil
+}
+
+type KVRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version       *Version               `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVRead) Reset() {
+	*x = KVRead{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVRead) ProtoMessage() {}
+
+func (x *KVRead) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVRead.ProtoReflect.Descriptor instead.
+func (*KVRead) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KVRead) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KVRead) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type KVWrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	IsDelete      bool                   `protobuf:"varint,2,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	Value         []byte                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVWrite) Reset() {
+	*x = KVWrite{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWrite) ProtoMessage() {}
+
+func (x *KVWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWrite.ProtoReflect.Descriptor instead.
+func (*KVWrite) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KVWrite) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KVWrite) GetIsDelete() bool {
+	if x != nil {
+		return x.IsDelete
+	}
+	return false
+}
+
+func (x *KVWrite) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type KVMetadataWrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Entries       []*KVMetadataEntry     `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVMetadataWrite) Reset() {
+	*x = KVMetadataWrite{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVMetadataWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVMetadataWrite) ProtoMessage() {}
+
+func (x *KVMetadataWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVMetadataWrite.ProtoReflect.Descriptor instead.
+func (*KVMetadataWrite) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KVMetadataWrite) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KVMetadataWrite) GetEntries() []*KVMetadataEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type KVReadHash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyHash       []byte                 `protobuf:"bytes,1,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	Version       *Version               `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVReadHash) Reset() {
+	*x = KVReadHash{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVReadHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVReadHash) ProtoMessage() {}
+
+func (x *KVReadHash) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVReadHash.ProtoReflect.Descriptor instead.
+func (*KVReadHash) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KVReadHash) GetKeyHash() []byte {
+	if x != nil {
+		return x.KeyHash
+	}
+	return nil
+}
+
+func (x *KVReadHash) GetVersion() *Version {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type KVWriteHash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyHash       []byte                 `protobuf:"bytes,1,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	IsDelete      bool                   `protobuf:"varint,2,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	ValueHash     []byte                 `protobuf:"bytes,3,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+	IsPurge       bool                   `protobuf:"varint,4,opt,name=is_purge,json=isPurge,proto3" json:"is_purge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVWriteHash) Reset() {
+	*x = KVWriteHash{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVWriteHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWriteHash) ProtoMessage() {}
+
+func (x *KVWriteHash) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVWriteHash.ProtoReflect.Descriptor instead.
+func (*KVWriteHash) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *KVWriteHash) GetKeyHash() []byte {
+	if x != nil {
+		return x.KeyHash
+	}
+	return nil
+}
+
+func (x *KVWriteHash) GetIsDelete() bool {
+	if x != nil {
+		return x.IsDelete
+	}
+	return false
+}
+
+func (x *KVWriteHash) GetValueHash() []byte {
+	if x != nil {
+		return x.ValueHash
+	}
+	return nil
+}
+
+func (x *KVWriteHash) GetIsPurge() bool {
+	if x != nil {
+		return x.IsPurge
+	}
+	return false
+}
+
+type KVMetadataWriteHash struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyHash       []byte                 `protobuf:"bytes,1,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	Entries       []*KVMetadataEntry     `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVMetadataWriteHash) Reset() {
+	*x = KVMetadataWriteHash{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVMetadataWriteHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVMetadataWriteHash) ProtoMessage() {}
+
+func (x *KVMetadataWriteHash) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVMetadataWriteHash.ProtoReflect.Descriptor instead.
+func (*KVMetadataWriteHash) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *KVMetadataWriteHash) GetKeyHash() []byte {
+	if x != nil {
+		return x.KeyHash
+	}
+	return nil
+}
+
+func (x *KVMetadataWriteHash) GetEntries() []*KVMetadataEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type KVMetadataEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KVMetadataEntry) Reset() {
+	*x = KVMetadataEntry{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KVMetadataEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVMetadataEntry) ProtoMessage() {}
+
+func (x *KVMetadataEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KVMetadataEntry.ProtoReflect.Descriptor instead.
+func (*KVMetadataEntry) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KVMetadataEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KVMetadataEntry) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// Version is the block and transaction that last wrote a key
+type Version struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockNum      uint64                 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	TxNum         uint64                 `protobuf:"varint,2,opt,name=tx_num,json=txNum,proto3" json:"tx_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Version) Reset() {
+	*x = Version{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Version) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Version) ProtoMessage() {}
+
+func (x *Version) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Version.ProtoReflect.Descriptor instead.
+func (*Version) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Version) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *Version) GetTxNum() uint64 {
+	if x != nil {
+		return x.TxNum
+	}
+	return 0
+}
+
+type RangeQueryInfo struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	StartKey     string                 `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey       string                 `protobuf:"bytes,2,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	ItrExhausted bool                   `protobuf:"varint,3,opt,name=itr_exhausted,json=itrExhausted,proto3" json:"itr_exhausted,omitempty"`
+	// Types that are valid to be assigned to ReadsInfo:
+	//
+	//	*RangeQueryInfo_RawReads
+	//	*RangeQueryInfo_ReadsMerkleHashes
+	ReadsInfo     isRangeQueryInfo_ReadsInfo `protobuf_oneof:"reads_info"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeQueryInfo) Reset() {
+	*x = RangeQueryInfo{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeQueryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeQueryInfo) ProtoMessage() {}
+
+func (x *RangeQueryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeQueryInfo.ProtoReflect.Descriptor instead.
+func (*RangeQueryInfo) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RangeQueryInfo) GetStartKey() string {
+	if x != nil {
+		return x.StartKey
+	}
+	return ""
+}
+
+func (x *RangeQueryInfo) GetEndKey() string {
+	if x != nil {
+		return x.EndKey
+	}
+	return ""
+}
+
+func (x *RangeQueryInfo) GetItrExhausted() bool {
+	if x != nil {
+		return x.ItrExhausted
+	}
+	return false
+}
+
+func (x *RangeQueryInfo) GetReadsInfo() isRangeQueryInfo_ReadsInfo {
+	if x != nil {
+		return x.ReadsInfo
+	}
+	return nil
+}
+
+func (x *RangeQueryInfo) GetRawReads() *QueryReads {
+	if x != nil {
+		if x, ok := x.ReadsInfo.(*RangeQueryInfo_RawReads); ok {
+			return x.RawReads
+		}
+	}
+	return nil
+}
+
+func (x *RangeQueryInfo) GetReadsMerkleHashes() *QueryReadsMerkleSummary {
+	if x != nil {
+		if x, ok := x.ReadsInfo.(*RangeQueryInfo_ReadsMerkleHashes); ok {
+			return x.ReadsMerkleHashes
+		}
+	}
+	return nil
+}
+
+type isRangeQueryInfo_ReadsInfo interface {
+	isRangeQueryInfo_ReadsInfo()
+}
+
+type RangeQueryInfo_RawReads struct {
+	RawReads *QueryReads `protobuf:"bytes,4,opt,name=raw_reads,json=rawReads,proto3,oneof"`
+}
+
+type RangeQueryInfo_ReadsMerkleHashes struct {
+	ReadsMerkleHashes *QueryReadsMerkleSummary `protobuf:"bytes,5,opt,name=reads_merkle_hashes,json=readsMerkleHashes,proto3,oneof"`
+}
+
+func (*RangeQueryInfo_RawReads) isRangeQueryInfo_ReadsInfo() {}
+
+func (*RangeQueryInfo_ReadsMerkleHashes) isRangeQueryInfo_ReadsInfo() {}
+
+type QueryReads struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KvReads       []*KVRead              `protobuf:"bytes,1,rep,name=kv_reads,json=kvReads,proto3" json:"kv_reads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryReads) Reset() {
+	*x = QueryReads{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryReads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryReads) ProtoMessage() {}
+
+func (x *QueryReads) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryReads.ProtoReflect.Descriptor instead.
+func (*QueryReads) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryReads) GetKvReads() []*KVRead {
+	if x != nil {
+		return x.KvReads
+	}
+	return nil
+}
+
+type QueryReadsMerkleSummary struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MaxDegree      uint32                 `protobuf:"varint,1,opt,name=max_degree,json=maxDegree,proto3" json:"max_degree,omitempty"`
+	MaxLevel       uint32                 `protobuf:"varint,2,opt,name=max_level,json=maxLevel,proto3" json:"max_level,omitempty"`
+	MaxLevelHashes [][]byte               `protobuf:"bytes,3,rep,name=max_level_hashes,json=maxLevelHashes,proto3" json:"max_level_hashes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QueryReadsMerkleSummary) Reset() {
+	*x = QueryReadsMerkleSummary{}
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryReadsMerkleSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryReadsMerkleSummary) ProtoMessage() {}
+
+func (x *QueryReadsMerkleSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryReadsMerkleSummary.ProtoReflect.Descriptor instead.
+func (*QueryReadsMerkleSummary) Descriptor() ([]byte, []int) {
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *QueryReadsMerkleSummary) GetMaxDegree() uint32 {
+	if x != nil {
+		return x.MaxDegree
+	}
+	return 0
+}
+
+func (x *QueryReadsMerkleSummary) GetMaxLevel() uint32 {
+	if x != nil {
+		return x.MaxLevel
+	}
+	return 0
+}
+
+func (x *QueryReadsMerkleSummary) GetMaxLevelHashes() [][]byte {
+	if x != nil {
+		return x.MaxLevelHashes
+	}
+	return nil
+}
+
+var File_ledger_rwset_kvrwset_kv_rwset_proto protoreflect.FileDescriptor
+
+const file_ledger_rwset_kvrwset_kv_rwset_proto_rawDesc = "" +
+	"\n" +
+	"#ledger/rwset/kvrwset/kv_rwset.proto\x12\akvrwset\"\xe4\x01\n" +
+	"\aKVRWSet\x12%\n" +
+	"\x05reads\x18\x01 \x03(\v2\x0f.kvrwset.KVReadR\x05reads\x12E\n" +
+	"\x12range_queries_info\x18\x02 \x03(\v2\x17.kvrwset.RangeQueryInfoR\x10rangeQueriesInfo\x12(\n" +
+	"\x06writes\x18\x03 \x03(\v2\x10.kvrwset.KVWriteR\x06writes\x12A\n" +
+	"\x0fmetadata_writes\x18\x04 \x03(\v2\x18.kvrwset.KVMetadataWriteR\x0emetadataWrites\"\xc7\x01\n" +
+	"\vHashedRWSet\x126\n" +
+	"\fhashed_reads\x18\x01 \x03(\v2\x13.kvrwset.KVReadHashR\vhashedReads\x129\n" +
+	"\rhashed_writes\x18\x02 \x03(\v2\x14.kvrwset.KVWriteHashR\fhashedWrites\x12E\n" +
+	"\x0fmetadata_writes\x18\x03 \x03(\v2\x1c.kvrwset.KVMetadataWriteHashR\x0emetadataWrites\"F\n" +
+	"\x06KVRead\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\aversion\x18\x02 \x01(\v2\x10.kvrwset.VersionR\aversion\"N\n" +
+	"\aKVWrite\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
+	"\tis_delete\x18\x02 \x01(\bR\bisDelete\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\fR\x05value\"W\n" +
+	"\x0fKVMetadataWrite\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\aentries\x18\x02 \x03(\v2\x18.kvrwset.KVMetadataEntryR\aentries\"S\n" +
+	"\n" +
+	"KVReadHash\x12\x19\n" +
+	"\bkey_hash\x18\x01 \x01(\fR\akeyHash\x12*\n" +
+	"\aversion\x18\x02 \x01(\v2\x10.kvrwset.VersionR\aversion\"\x7f\n" +
+	"\vKVWriteHash\x12\x19\n" +
+	"\bkey_hash\x18\x01 \x01(\fR\akeyHash\x12\x1b\n" +
+	"\tis_delete\x18\x02 \x01(\bR\bisDelete\x12\x1d\n" +
+	"\n" +
+	"value_hash\x18\x03 \x01(\fR\tvalueHash\x12\x19\n" +
+	"\bis_purge\x18\x04 \x01(\bR\aisPurge\"d\n" +
+	"\x13KVMetadataWriteHash\x12\x19\n" +
+	"\bkey_hash\x18\x01 \x01(\fR\akeyHash\x122\n" +
+	"\aentries\x18\x02 \x03(\v2\x18.kvrwset.KVMetadataEntryR\aentries\";\n" +
+	"\x0fKVMetadataEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\"=\n" +
+	"\aVersion\x12\x1b\n" +
+	"\tblock_num\x18\x01 \x01(\x04R\bblockNum\x12\x15\n" +
+	"\x06tx_num\x18\x02 \x01(\x04R\x05txNum\"\x81\x02\n" +
+	"\x0eRangeQueryInfo\x12\x1b\n" +
+	"\tstart_key\x18\x01 \x01(\tR\bstartKey\x12\x17\n" +
+	"\aend_key\x18\x02 \x01(\tR\x06endKey\x12#\n" +
+	"\ritr_exhausted\x18\x03 \x01(\bR\fitrExhausted\x122\n" +
+	"\traw_reads\x18\x04 \x01(\v2\x13.kvrwset.QueryReadsH\x00R\brawReads\x12R\n" +
+	"\x13reads_merkle_hashes\x18\x05 \x01(\v2 .kvrwset.QueryReadsMerkleSummaryH\x00R\x11readsMerkleHashesB\f\n" +
+	"\n" +
+	"reads_info\"8\n" +
+	"\n" +
+	"QueryReads\x12*\n" +
+	"\bkv_reads\x18\x01 \x03(\v2\x0f.kvrwset.KVReadR\akvReads\"\x7f\n" +
+	"\x17QueryReadsMerkleSummary\x12\x1d\n" +
+	"\n" +
+	"max_degree\x18\x01 \x01(\rR\tmaxDegree\x12\x1b\n" +
+	"\tmax_level\x18\x02 \x01(\rR\bmaxLevel\x12(\n" +
+	"\x10max_level_hashes\x18\x03 \x03(\fR\x0emaxLevelHashesB=Z;github.com/temmyjay001/core/pkg/fabric/ledger/rwset/kvrwsetb\x06proto3"
+
+var (
+	file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescOnce sync.Once
+	file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescData []byte
+)
+
+func file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescGZIP() []byte {
+	file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescOnce.Do(func() {
+		file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ledger_rwset_kvrwset_kv_rwset_proto_rawDesc), len(file_ledger_rwset_kvrwset_kv_rwset_proto_rawDesc)))
+	})
+	return file_ledger_rwset_kvrwset_kv_rwset_proto_rawDescData
+}
+
+var file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_ledger_rwset_kvrwset_kv_rwset_proto_goTypes = []any{
+	(*KVRWSet)(nil),                 // 0: kvrwset.KVRWSet
+	(*HashedRWSet)(nil),             // 1: kvrwset.HashedRWSet
+	(*KVRead)(nil),                  // 2: kvrwset.KVRead
+	(*KVWrite)(nil),                 // 3: kvrwset.KVWrite
+	(*KVMetadataWrite)(nil),         // 4: kvrwset.KVMetadataWrite
+	(*KVReadHash)(nil),              // 5: kvrwset.KVReadHash
+	(*KVWriteHash)(nil),             // 6: kvrwset.KVWriteHash
+	(*KVMetadataWriteHash)(nil),     // 7: kvrwset.KVMetadataWriteHash
+	(*KVMetadataEntry)(nil),         // 8: kvrwset.KVMetadataEntry
+	(*Version)(nil),                 // 9: kvrwset.Version
+	(*RangeQueryInfo)(nil),          // 10: kvrwset.RangeQueryInfo
+	(*QueryReads)(nil),              // 11: kvrwset.QueryReads
+	(*QueryReadsMerkleSummary)(nil), // 12: kvrwset.QueryReadsMerkleSummary
+}
+var file_ledger_rwset_kvrwset_kv_rwset_proto_depIdxs = []int32{
+	2,  // 0: kvrwset.KVRWSet.reads:type_name -> kvrwset.KVRead
+	10, // 1: kvrwset.KVRWSet.range_queries_info:type_name -> kvrwset.RangeQueryInfo
+	3,  // 2: kvrwset.KVRWSet.writes:type_name -> kvrwset.KVWrite
+	4,  // 3: kvrwset.KVRWSet.metadata_writes:type_name -> kvrwset.KVMetadataWrite
+	5,  // 4: kvrwset.HashedRWSet.hashed_reads:type_name -> kvrwset.KVReadHash
+	6,  // 5: kvrwset.HashedRWSet.hashed_writes:type_name -> kvrwset.KVWriteHash
+	7,  // 6: kvrwset.HashedRWSet.metadata_writes:type_name -> kvrwset.KVMetadataWriteHash
+	9,  // 7: kvrwset.KVRead.version:type_name -> kvrwset.Version
+	8,  // 8: kvrwset.KVMetadataWrite.entries:type_name -> kvrwset.KVMetadataEntry
+	9,  // 9: kvrwset.KVReadHash.version:type_name -> kvrwset.Version
+	8,  // 10: kvrwset.KVMetadataWriteHash.entries:type_name -> kvrwset.KVMetadataEntry
+	11, // 11: kvrwset.RangeQueryInfo.raw_reads:type_name -> kvrwset.QueryReads
+	12, // 12: kvrwset.RangeQueryInfo.reads_merkle_hashes:type_name -> kvrwset.QueryReadsMerkleSummary
+	2,  // 13: kvrwset.QueryReads.kv_reads:type_name -> kvrwset.KVRead
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_ledger_rwset_kvrwset_kv_rwset_proto_init() }
+func file_ledger_rwset_kvrwset_kv_rwset_proto_init() {
+	if File_ledger_rwset_kvrwset_kv_rwset_proto != nil {
+		return
+	}
+	file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes[10].OneofWrappers = []any{
+		(*RangeQueryInfo_RawReads)(nil),
+		(*RangeQueryInfo_ReadsMerkleHashes)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ledger_rwset_kvrwset_kv_rwset_proto_rawDesc), len(file_ledger_rwset_kvrwset_kv_rwset_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ledger_rwset_kvrwset_kv_rwset_proto_goTypes,
+		DependencyIndexes: file_ledger_rwset_kvrwset_kv_rwset_proto_depIdxs,
+		MessageInfos:      file_ledger_rwset_kvrwset_kv_rwset_proto_msgTypes,
+	}.Build()
+	File_ledger_rwset_kvrwset_kv_rwset_proto = out.File
+	file_ledger_rwset_kvrwset_kv_rwset_proto_goTypes = nil
+	file_ledger_rwset_kvrwset_kv_rwset_proto_depIdxs = nil
+}
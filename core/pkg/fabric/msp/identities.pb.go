@@ -0,0 +1,138 @@
+// protos/fabric/msp/identities.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: msp/identities.proto
+
+package msp
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SerializedIdentity is the creator of a proposal or the endorser of a
+// response: an MSP ID and a PEM certificate
+type SerializedIdentity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mspid         string                 `protobuf:"bytes,1,opt,name=mspid,proto3" json:"mspid,omitempty"`
+	IdBytes       []byte                 `protobuf:"bytes,2,opt,name=id_bytes,json=idBytes,proto3" json:"id_bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SerializedIdentity) Reset() {
+	*x = SerializedIdentity{}
+	mi := &file_msp_identities_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SerializedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SerializedIdentity) ProtoMessage() {}
+
+func (x *SerializedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_msp_identities_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SerializedIdentity.ProtoReflect.Descriptor instead.
+func (*SerializedIdentity) Descriptor() ([]byte, []int) {
+	return file_msp_identities_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SerializedIdentity) GetMspid() string {
+	if x != nil {
+		return x.Mspid
+	}
+	return ""
+}
+
+func (x *SerializedIdentity) GetIdBytes() []byte {
+	if x != nil {
+		return x.IdBytes
+	}
+	return nil
+}
+
+var File_msp_identities_proto protoreflect.FileDescriptor
+
+const file_msp_identities_proto_rawDesc = "" +
+	"\n" +
+	"\x14msp/identities.proto\x12\x03msp\"E\n" +
+	"\x12SerializedIdentity\x12\x14\n" +
+	"\x05mspid\x18\x01 \x01(\tR\x05mspid\x12\x19\n" +
+	"\bid_bytes\x18\x02 \x01(\fR\aidBytesB,Z*github.com/temmyjay001/core/pkg/fabric/mspb\x06proto3"
+
+var (
+	file_msp_identities_proto_rawDescOnce sync.Once
+	file_msp_identities_proto_rawDescData []byte
+)
+
+func file_msp_identities_proto_rawDescGZIP() []byte {
+	file_msp_identities_proto_rawDescOnce.Do(func() {
+		file_msp_identities_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_msp_identities_proto_rawDesc), len(file_msp_identities_proto_rawDesc)))
+	})
+	return file_msp_identities_proto_rawDescData
+}
+
+var file_msp_identities_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_msp_identities_proto_goTypes = []any{
+	(*SerializedIdentity)(nil), // 0: msp.SerializedIdentity
+}
+var file_msp_identities_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_msp_identities_proto_init() }
+func file_msp_identities_proto_init() {
+	if File_msp_identities_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_msp_identities_proto_rawDesc), len(file_msp_identities_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_msp_identities_proto_goTypes,
+		DependencyIndexes: file_msp_identities_proto_depIdxs,
+		MessageInfos:      file_msp_identities_proto_msgTypes,
+	}.Build()
+	File_msp_identities_proto = out.File
+	file_msp_identities_proto_goTypes = nil
+	file_msp_identities_proto_depIdxs = nil
+}
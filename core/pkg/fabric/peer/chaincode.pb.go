@@ -0,0 +1,405 @@
+// protos/fabric/peer/chaincode.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: peer/chaincode.proto
+
+package peer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChaincodeSpec_Type int32
+
+const (
+	ChaincodeSpec_UNDEFINED ChaincodeSpec_Type = 0
+	ChaincodeSpec_GOLANG    ChaincodeSpec_Type = 1
+	ChaincodeSpec_NODE      ChaincodeSpec_Type = 2
+	ChaincodeSpec_CAR       ChaincodeSpec_Type = 3
+	ChaincodeSpec_JAVA      ChaincodeSpec_Type = 4
+)
+
+// Enum value maps for ChaincodeSpec_Type.
+var (
+	ChaincodeSpec_Type_name = map[int32]string{
+		0: "UNDEFINED",
+		1: "GOLANG",
+		2: "NODE",
+		3: "CAR",
+		4: "JAVA",
+	}
+	ChaincodeSpec_Type_value = map[string]int32{
+		"UNDEFINED": 0,
+		"GOLANG":    1,
+		"NODE":      2,
+		"CAR":       3,
+		"JAVA":      4,
+	}
+)
+
+func (x ChaincodeSpec_Type) Enum() *ChaincodeSpec_Type {
+	p := new(ChaincodeSpec_Type)
+	*p = x
+	return p
+}
+
+func (x ChaincodeSpec_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChaincodeSpec_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_chaincode_proto_enumTypes[0].Descriptor()
+}
+
+func (ChaincodeSpec_Type) Type() protoreflect.EnumType {
+	return &file_peer_chaincode_proto_enumTypes[0]
+}
+
+func (x ChaincodeSpec_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChaincodeSpec_Type.Descriptor instead.
+func (ChaincodeSpec_Type) EnumDescriptor() ([]byte, []int) {
+	return file_peer_chaincode_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type ChaincodeID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version       string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeID) Reset() {
+	*x = ChaincodeID{}
+	mi := &file_peer_chaincode_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeID) ProtoMessage() {}
+
+func (x *ChaincodeID) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_chaincode_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeID.ProtoReflect.Descriptor instead.
+func (*ChaincodeID) Descriptor() ([]byte, []int) {
+	return file_peer_chaincode_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChaincodeID) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ChaincodeID) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChaincodeID) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ChaincodeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Args          [][]byte               `protobuf:"bytes,1,rep,name=args,proto3" json:"args,omitempty"`
+	Decorations   map[string][]byte      `protobuf:"bytes,2,rep,name=decorations,proto3" json:"decorations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IsInit        bool                   `protobuf:"varint,3,opt,name=is_init,json=isInit,proto3" json:"is_init,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeInput) Reset() {
+	*x = ChaincodeInput{}
+	mi := &file_peer_chaincode_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeInput) ProtoMessage() {}
+
+func (x *ChaincodeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_chaincode_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeInput.ProtoReflect.Descriptor instead.
+func (*ChaincodeInput) Descriptor() ([]byte, []int) {
+	return file_peer_chaincode_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChaincodeInput) GetArgs() [][]byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ChaincodeInput) GetDecorations() map[string][]byte {
+	if x != nil {
+		return x.Decorations
+	}
+	return nil
+}
+
+func (x *ChaincodeInput) GetIsInit() bool {
+	if x != nil {
+		return x.IsInit
+	}
+	return false
+}
+
+type ChaincodeSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          ChaincodeSpec_Type     `protobuf:"varint,1,opt,name=type,proto3,enum=protos.ChaincodeSpec_Type" json:"type,omitempty"`
+	ChaincodeId   *ChaincodeID           `protobuf:"bytes,2,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	Input         *ChaincodeInput        `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	Timeout       int32                  `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeSpec) Reset() {
+	*x = ChaincodeSpec{}
+	mi := &file_peer_chaincode_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeSpec) ProtoMessage() {}
+
+func (x *ChaincodeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_chaincode_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeSpec.ProtoReflect.Descriptor instead.
+func (*ChaincodeSpec) Descriptor() ([]byte, []int) {
+	return file_peer_chaincode_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChaincodeSpec) GetType() ChaincodeSpec_Type {
+	if x != nil {
+		return x.Type
+	}
+	return ChaincodeSpec_UNDEFINED
+}
+
+func (x *ChaincodeSpec) GetChaincodeId() *ChaincodeID {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return nil
+}
+
+func (x *ChaincodeSpec) GetInput() *ChaincodeInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ChaincodeSpec) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type ChaincodeInvocationSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChaincodeSpec *ChaincodeSpec         `protobuf:"bytes,1,opt,name=chaincode_spec,json=chaincodeSpec,proto3" json:"chaincode_spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeInvocationSpec) Reset() {
+	*x = ChaincodeInvocationSpec{}
+	mi := &file_peer_chaincode_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeInvocationSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeInvocationSpec) ProtoMessage() {}
+
+func (x *ChaincodeInvocationSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_chaincode_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeInvocationSpec.ProtoReflect.Descriptor instead.
+func (*ChaincodeInvocationSpec) Descriptor() ([]byte, []int) {
+	return file_peer_chaincode_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChaincodeInvocationSpec) GetChaincodeSpec() *ChaincodeSpec {
+	if x != nil {
+		return x.ChaincodeSpec
+	}
+	return nil
+}
+
+var File_peer_chaincode_proto protoreflect.FileDescriptor
+
+const file_peer_chaincode_proto_rawDesc = "" +
+	"\n" +
+	"\x14peer/chaincode.proto\x12\x06protos\"O\n" +
+	"\vChaincodeID\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\"\xc8\x01\n" +
+	"\x0eChaincodeInput\x12\x12\n" +
+	"\x04args\x18\x01 \x03(\fR\x04args\x12I\n" +
+	"\vdecorations\x18\x02 \x03(\v2'.protos.ChaincodeInput.DecorationsEntryR\vdecorations\x12\x17\n" +
+	"\ais_init\x18\x03 \x01(\bR\x06isInit\x1a>\n" +
+	"\x10DecorationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xff\x01\n" +
+	"\rChaincodeSpec\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.protos.ChaincodeSpec.TypeR\x04type\x126\n" +
+	"\fchaincode_id\x18\x02 \x01(\v2\x13.protos.ChaincodeIDR\vchaincodeId\x12,\n" +
+	"\x05input\x18\x03 \x01(\v2\x16.protos.ChaincodeInputR\x05input\x12\x18\n" +
+	"\atimeout\x18\x04 \x01(\x05R\atimeout\">\n" +
+	"\x04Type\x12\r\n" +
+	"\tUNDEFINED\x10\x00\x12\n" +
+	"\n" +
+	"\x06GOLANG\x10\x01\x12\b\n" +
+	"\x04NODE\x10\x02\x12\a\n" +
+	"\x03CAR\x10\x03\x12\b\n" +
+	"\x04JAVA\x10\x04\"W\n" +
+	"\x17ChaincodeInvocationSpec\x12<\n" +
+	"\x0echaincode_spec\x18\x01 \x01(\v2\x15.protos.ChaincodeSpecR\rchaincodeSpecB-Z+github.com/temmyjay001/core/pkg/fabric/peerb\x06proto3"
+
+var (
+	file_peer_chaincode_proto_rawDescOnce sync.Once
+	file_peer_chaincode_proto_rawDescData []byte
+)
+
+func file_peer_chaincode_proto_rawDescGZIP() []byte {
+	file_peer_chaincode_proto_rawDescOnce.Do(func() {
+		file_peer_chaincode_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_peer_chaincode_proto_rawDesc), len(file_peer_chaincode_proto_rawDesc)))
+	})
+	return file_peer_chaincode_proto_rawDescData
+}
+
+var file_peer_chaincode_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_peer_chaincode_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_peer_chaincode_proto_goTypes = []any{
+	(ChaincodeSpec_Type)(0),         // 0: protos.ChaincodeSpec.Type
+	(*ChaincodeID)(nil),             // 1: protos.ChaincodeID
+	(*ChaincodeInput)(nil),          // 2: protos.ChaincodeInput
+	(*ChaincodeSpec)(nil),           // 3: protos.ChaincodeSpec
+	(*ChaincodeInvocationSpec)(nil), // 4: protos.ChaincodeInvocationSpec
+	nil,                             // 5: protos.ChaincodeInput.DecorationsEntry
+}
+var file_peer_chaincode_proto_depIdxs = []int32{
+	5, // 0: protos.ChaincodeInput.decorations:type_name -> protos.ChaincodeInput.DecorationsEntry
+	0, // 1: protos.ChaincodeSpec.type:type_name -> protos.ChaincodeSpec.Type
+	1, // 2: protos.ChaincodeSpec.chaincode_id:type_name -> protos.ChaincodeID
+	2, // 3: protos.ChaincodeSpec.input:type_name -> protos.ChaincodeInput
+	3, // 4: protos.ChaincodeInvocationSpec.chaincode_spec:type_name -> protos.ChaincodeSpec
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_peer_chaincode_proto_init() }
+func file_peer_chaincode_proto_init() {
+	if File_peer_chaincode_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_peer_chaincode_proto_rawDesc), len(file_peer_chaincode_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_peer_chaincode_proto_goTypes,
+		DependencyIndexes: file_peer_chaincode_proto_depIdxs,
+		EnumInfos:         file_peer_chaincode_proto_enumTypes,
+		MessageInfos:      file_peer_chaincode_proto_msgTypes,
+	}.Build()
+	File_peer_chaincode_proto = out.File
+	file_peer_chaincode_proto_goTypes = nil
+	file_peer_chaincode_proto_depIdxs = nil
+}
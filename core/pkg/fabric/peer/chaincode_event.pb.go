@@ -0,0 +1,156 @@
+// protos/fabric/peer/chaincode_event.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: peer/chaincode_event.proto
+
+package peer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChaincodeEvent is the event a transaction set with SetEvent
+type ChaincodeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChaincodeId   string                 `protobuf:"bytes,1,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	TxId          string                 `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	EventName     string                 `protobuf:"bytes,3,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeEvent) Reset() {
+	*x = ChaincodeEvent{}
+	mi := &file_peer_chaincode_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeEvent) ProtoMessage() {}
+
+func (x *ChaincodeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_chaincode_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeEvent.ProtoReflect.Descriptor instead.
+func (*ChaincodeEvent) Descriptor() ([]byte, []int) {
+	return file_peer_chaincode_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChaincodeEvent) GetChaincodeId() string {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return ""
+}
+
+func (x *ChaincodeEvent) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ChaincodeEvent) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *ChaincodeEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_peer_chaincode_event_proto protoreflect.FileDescriptor
+
+const file_peer_chaincode_event_proto_rawDesc = "" +
+	"\n" +
+	"\x1apeer/chaincode_event.proto\x12\x06protos\"\x81\x01\n" +
+	"\x0eChaincodeEvent\x12!\n" +
+	"\fchaincode_id\x18\x01 \x01(\tR\vchaincodeId\x12\x13\n" +
+	"\x05tx_id\x18\x02 \x01(\tR\x04txId\x12\x1d\n" +
+	"\n" +
+	"event_name\x18\x03 \x01(\tR\teventName\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\fR\apayloadB-Z+github.com/temmyjay001/core/pkg/fabric/peerb\x06proto3"
+
+var (
+	file_peer_chaincode_event_proto_rawDescOnce sync.Once
+	file_peer_chaincode_event_proto_rawDescData []byte
+)
+
+func file_peer_chaincode_event_proto_rawDescGZIP() []byte {
+	file_peer_chaincode_event_proto_rawDescOnce.Do(func() {
+		file_peer_chaincode_event_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_peer_chaincode_event_proto_rawDesc), len(file_peer_chaincode_event_proto_rawDesc)))
+	})
+	return file_peer_chaincode_event_proto_rawDescData
+}
+
+var file_peer_chaincode_event_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_peer_chaincode_event_proto_goTypes = []any{
+	(*ChaincodeEvent)(nil), // 0: protos.ChaincodeEvent
+}
+var file_peer_chaincode_event_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_peer_chaincode_event_proto_init() }
+func file_peer_chaincode_event_proto_init() {
+	if File_peer_chaincode_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_peer_chaincode_event_proto_rawDesc), len(file_peer_chaincode_event_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_peer_chaincode_event_proto_goTypes,
+		DependencyIndexes: file_peer_chaincode_event_proto_depIdxs,
+		MessageInfos:      file_peer_chaincode_event_proto_msgTypes,
+	}.Build()
+	File_peer_chaincode_event_proto = out.File
+	file_peer_chaincode_event_proto_goTypes = nil
+	file_peer_chaincode_event_proto_depIdxs = nil
+}
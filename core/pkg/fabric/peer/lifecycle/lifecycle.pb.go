@@ -0,0 +1,237 @@
+// protos/fabric/peer/lifecycle/lifecycle.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: peer/lifecycle/lifecycle.proto
+
+package lifecycle
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryChaincodeDefinitionArgs is the argument of the _lifecycle
+// QueryChaincodeDefinition function
+type QueryChaincodeDefinitionArgs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryChaincodeDefinitionArgs) Reset() {
+	*x = QueryChaincodeDefinitionArgs{}
+	mi := &file_peer_lifecycle_lifecycle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryChaincodeDefinitionArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryChaincodeDefinitionArgs) ProtoMessage() {}
+
+func (x *QueryChaincodeDefinitionArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_lifecycle_lifecycle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryChaincodeDefinitionArgs.ProtoReflect.Descriptor instead.
+func (*QueryChaincodeDefinitionArgs) Descriptor() ([]byte, []int) {
+	return file_peer_lifecycle_lifecycle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryChaincodeDefinitionArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// QueryChaincodeDefinitionResult is the committed definition of a
+// chaincode. The collection config package is not decoded.
+type QueryChaincodeDefinitionResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Sequence            int64                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Version             string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	EndorsementPlugin   string                 `protobuf:"bytes,3,opt,name=endorsement_plugin,json=endorsementPlugin,proto3" json:"endorsement_plugin,omitempty"`
+	ValidationPlugin    string                 `protobuf:"bytes,4,opt,name=validation_plugin,json=validationPlugin,proto3" json:"validation_plugin,omitempty"`
+	ValidationParameter []byte                 `protobuf:"bytes,5,opt,name=validation_parameter,json=validationParameter,proto3" json:"validation_parameter,omitempty"`
+	InitRequired        bool                   `protobuf:"varint,7,opt,name=init_required,json=initRequired,proto3" json:"init_required,omitempty"`
+	Approvals           map[string]bool        `protobuf:"bytes,8,rep,name=approvals,proto3" json:"approvals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *QueryChaincodeDefinitionResult) Reset() {
+	*x = QueryChaincodeDefinitionResult{}
+	mi := &file_peer_lifecycle_lifecycle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryChaincodeDefinitionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryChaincodeDefinitionResult) ProtoMessage() {}
+
+func (x *QueryChaincodeDefinitionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_lifecycle_lifecycle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryChaincodeDefinitionResult.ProtoReflect.Descriptor instead.
+func (*QueryChaincodeDefinitionResult) Descriptor() ([]byte, []int) {
+	return file_peer_lifecycle_lifecycle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryChaincodeDefinitionResult) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *QueryChaincodeDefinitionResult) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *QueryChaincodeDefinitionResult) GetEndorsementPlugin() string {
+	if x != nil {
+		return x.EndorsementPlugin
+	}
+	return ""
+}
+
+func (x *QueryChaincodeDefinitionResult) GetValidationPlugin() string {
+	if x != nil {
+		return x.ValidationPlugin
+	}
+	return ""
+}
+
+func (x *QueryChaincodeDefinitionResult) GetValidationParameter() []byte {
+	if x != nil {
+		return x.ValidationParameter
+	}
+	return nil
+}
+
+func (x *QueryChaincodeDefinitionResult) GetInitRequired() bool {
+	if x != nil {
+		return x.InitRequired
+	}
+	return false
+}
+
+func (x *QueryChaincodeDefinitionResult) GetApprovals() map[string]bool {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+var File_peer_lifecycle_lifecycle_proto protoreflect.FileDescriptor
+
+const file_peer_lifecycle_lifecycle_proto_rawDesc = "" +
+	"\n" +
+	"\x1epeer/lifecycle/lifecycle.proto\x12\tlifecycle\"2\n" +
+	"\x1cQueryChaincodeDefinitionArgs\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xb3\x03\n" +
+	"\x1eQueryChaincodeDefinitionResult\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x03R\bsequence\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12-\n" +
+	"\x12endorsement_plugin\x18\x03 \x01(\tR\x11endorsementPlugin\x12+\n" +
+	"\x11validation_plugin\x18\x04 \x01(\tR\x10validationPlugin\x121\n" +
+	"\x14validation_parameter\x18\x05 \x01(\fR\x13validationParameter\x12#\n" +
+	"\rinit_required\x18\a \x01(\bR\finitRequired\x12V\n" +
+	"\tapprovals\x18\b \x03(\v28.lifecycle.QueryChaincodeDefinitionResult.ApprovalsEntryR\tapprovals\x1a<\n" +
+	"\x0eApprovalsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01J\x04\b\x06\x10\aR\vcollectionsB7Z5github.com/temmyjay001/core/pkg/fabric/peer/lifecycleb\x06proto3"
+
+var (
+	file_peer_lifecycle_lifecycle_proto_rawDescOnce sync.Once
+	file_peer_lifecycle_lifecycle_proto_rawDescData []byte
+)
+
+func file_peer_lifecycle_lifecycle_proto_rawDescGZIP() []byte {
+	file_peer_lifecycle_lifecycle_proto_rawDescOnce.Do(func() {
+		file_peer_lifecycle_lifecycle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_peer_lifecycle_lifecycle_proto_rawDesc), len(file_peer_lifecycle_lifecycle_proto_rawDesc)))
+	})
+	return file_peer_lifecycle_lifecycle_proto_rawDescData
+}
+
+var file_peer_lifecycle_lifecycle_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_peer_lifecycle_lifecycle_proto_goTypes = []any{
+	(*QueryChaincodeDefinitionArgs)(nil),   // 0: lifecycle.QueryChaincodeDefinitionArgs
+	(*QueryChaincodeDefinitionResult)(nil), // 1: lifecycle.QueryChaincodeDefinitionResult
+	nil,                                    // 2: lifecycle.QueryChaincodeDefinitionResult.ApprovalsEntry
+}
+var file_peer_lifecycle_lifecycle_proto_depIdxs = []int32{
+	2, // 0: lifecycle.QueryChaincodeDefinitionResult.approvals:type_name -> lifecycle.QueryChaincodeDefinitionResult.ApprovalsEntry
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_peer_lifecycle_lifecycle_proto_init() }
+func file_peer_lifecycle_lifecycle_proto_init() {
+	if File_peer_lifecycle_lifecycle_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_peer_lifecycle_lifecycle_proto_rawDesc), len(file_peer_lifecycle_lifecycle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_peer_lifecycle_lifecycle_proto_goTypes,
+		DependencyIndexes: file_peer_lifecycle_lifecycle_proto_depIdxs,
+		MessageInfos:      file_peer_lifecycle_lifecycle_proto_msgTypes,
+	}.Build()
+	File_peer_lifecycle_lifecycle_proto = out.File
+	file_peer_lifecycle_lifecycle_proto_goTypes = nil
+	file_peer_lifecycle_lifecycle_proto_depIdxs = nil
+}
@@ -0,0 +1,73 @@
+// protos/fabric/peer/peer.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: peer/peer.proto
+
+package peer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_peer_peer_proto protoreflect.FileDescriptor
+
+const file_peer_peer_proto_rawDesc = "" +
+	"\n" +
+	"\x0fpeer/peer.proto\x12\x06protos\x1a\x13peer/proposal.proto\x1a\x1cpeer/proposal_response.proto2O\n" +
+	"\bEndorser\x12C\n" +
+	"\x0fProcessProposal\x12\x16.protos.SignedProposal\x1a\x18.protos.ProposalResponseB-Z+github.com/temmyjay001/core/pkg/fabric/peerb\x06proto3"
+
+var file_peer_peer_proto_goTypes = []any{
+	(*SignedProposal)(nil),   // 0: protos.SignedProposal
+	(*ProposalResponse)(nil), // 1: protos.ProposalResponse
+}
+var file_peer_peer_proto_depIdxs = []int32{
+	0, // 0: protos.Endorser.ProcessProposal:input_type -> protos.SignedProposal
+	1, // 1: protos.Endorser.ProcessProposal:output_type -> protos.ProposalResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_peer_peer_proto_init() }
+func file_peer_peer_proto_init() {
+	if File_peer_peer_proto != nil {
+		return
+	}
+	file_peer_proposal_proto_init()
+	file_peer_proposal_response_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_peer_peer_proto_rawDesc), len(file_peer_peer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_peer_peer_proto_goTypes,
+		DependencyIndexes: file_peer_peer_proto_depIdxs,
+	}.Build()
+	File_peer_peer_proto = out.File
+	file_peer_peer_proto_goTypes = nil
+	file_peer_peer_proto_depIdxs = nil
+}
@@ -0,0 +1,396 @@
+// protos/fabric/peer/proposal.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: peer/proposal.proto
+
+package peer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SignedProposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProposalBytes []byte                 `protobuf:"bytes,1,opt,name=proposal_bytes,json=proposalBytes,proto3" json:"proposal_bytes,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignedProposal) Reset() {
+	*x = SignedProposal{}
+	mi := &file_peer_proposal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignedProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignedProposal) ProtoMessage() {}
+
+func (x *SignedProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignedProposal.ProtoReflect.Descriptor instead.
+func (*SignedProposal) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SignedProposal) GetProposalBytes() []byte {
+	if x != nil {
+		return x.ProposalBytes
+	}
+	return nil
+}
+
+func (x *SignedProposal) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// Proposal holds a marshaled common.Header and ChaincodeProposalPayload
+type Proposal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        []byte                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Extension     []byte                 `protobuf:"bytes,3,opt,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	mi := &file_peer_proposal_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Proposal) GetHeader() []byte {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Proposal) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *Proposal) GetExtension() []byte {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+type ChaincodeHeaderExtension struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChaincodeId   *ChaincodeID           `protobuf:"bytes,2,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeHeaderExtension) Reset() {
+	*x = ChaincodeHeaderExtension{}
+	mi := &file_peer_proposal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeHeaderExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeHeaderExtension) ProtoMessage() {}
+
+func (x *ChaincodeHeaderExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeHeaderExtension.ProtoReflect.Descriptor instead.
+func (*ChaincodeHeaderExtension) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChaincodeHeaderExtension) GetChaincodeId() *ChaincodeID {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return nil
+}
+
+type ChaincodeProposalPayload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Marshaled ChaincodeInvocationSpec
+	Input         []byte            `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	TransientMap  map[string][]byte `protobuf:"bytes,2,rep,name=TransientMap,proto3" json:"TransientMap,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeProposalPayload) Reset() {
+	*x = ChaincodeProposalPayload{}
+	mi := &file_peer_proposal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeProposalPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeProposalPayload) ProtoMessage() {}
+
+func (x *ChaincodeProposalPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeProposalPayload.ProtoReflect.Descriptor instead.
+func (*ChaincodeProposalPayload) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChaincodeProposalPayload) GetInput() []byte {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ChaincodeProposalPayload) GetTransientMap() map[string][]byte {
+	if x != nil {
+		return x.TransientMap
+	}
+	return nil
+}
+
+// ChaincodeAction is what the chaincode did: its read-write set, event and
+// response
+type ChaincodeAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Marshaled rwset.TxReadWriteSet
+	Results []byte `protobuf:"bytes,1,opt,name=results,proto3" json:"results,omitempty"`
+	// Marshaled ChaincodeEvent
+	Events        []byte       `protobuf:"bytes,2,opt,name=events,proto3" json:"events,omitempty"`
+	Response      *Response    `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	ChaincodeId   *ChaincodeID `protobuf:"bytes,4,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeAction) Reset() {
+	*x = ChaincodeAction{}
+	mi := &file_peer_proposal_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeAction) ProtoMessage() {}
+
+func (x *ChaincodeAction) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeAction.ProtoReflect.Descriptor instead.
+func (*ChaincodeAction) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChaincodeAction) GetResults() []byte {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ChaincodeAction) GetEvents() []byte {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ChaincodeAction) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ChaincodeAction) GetChaincodeId() *ChaincodeID {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return nil
+}
+
+var File_peer_proposal_proto protoreflect.FileDescriptor
+
+const file_peer_proposal_proto_rawDesc = "" +
+	"\n" +
+	"\x13peer/proposal.proto\x12\x06protos\x1a\x14peer/chaincode.proto\x1a\x1cpeer/proposal_response.proto\"U\n" +
+	"\x0eSignedProposal\x12%\n" +
+	"\x0eproposal_bytes\x18\x01 \x01(\fR\rproposalBytes\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\"Z\n" +
+	"\bProposal\x12\x16\n" +
+	"\x06header\x18\x01 \x01(\fR\x06header\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\x12\x1c\n" +
+	"\textension\x18\x03 \x01(\fR\textension\"l\n" +
+	"\x18ChaincodeHeaderExtension\x126\n" +
+	"\fchaincode_id\x18\x02 \x01(\v2\x13.protos.ChaincodeIDR\vchaincodeIdJ\x04\b\x01\x10\x02R\x12payload_visibility\"\xc9\x01\n" +
+	"\x18ChaincodeProposalPayload\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\fR\x05input\x12V\n" +
+	"\fTransientMap\x18\x02 \x03(\v22.protos.ChaincodeProposalPayload.TransientMapEntryR\fTransientMap\x1a?\n" +
+	"\x11TransientMapEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xa9\x01\n" +
+	"\x0fChaincodeAction\x12\x18\n" +
+	"\aresults\x18\x01 \x01(\fR\aresults\x12\x16\n" +
+	"\x06events\x18\x02 \x01(\fR\x06events\x12,\n" +
+	"\bresponse\x18\x03 \x01(\v2\x10.protos.ResponseR\bresponse\x126\n" +
+	"\fchaincode_id\x18\x04 \x01(\v2\x13.protos.ChaincodeIDR\vchaincodeIdB-Z+github.com/temmyjay001/core/pkg/fabric/peerb\x06proto3"
+
+var (
+	file_peer_proposal_proto_rawDescOnce sync.Once
+	file_peer_proposal_proto_rawDescData []byte
+)
+
+func file_peer_proposal_proto_rawDescGZIP() []byte {
+	file_peer_proposal_proto_rawDescOnce.Do(func() {
+		file_peer_proposal_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_peer_proposal_proto_rawDesc), len(file_peer_proposal_proto_rawDesc)))
+	})
+	return file_peer_proposal_proto_rawDescData
+}
+
+var file_peer_proposal_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_peer_proposal_proto_goTypes = []any{
+	(*SignedProposal)(nil),           // 0: protos.SignedProposal
+	(*Proposal)(nil),                 // 1: protos.Proposal
+	(*ChaincodeHeaderExtension)(nil), // 2: protos.ChaincodeHeaderExtension
+	(*ChaincodeProposalPayload)(nil), // 3: protos.ChaincodeProposalPayload
+	(*ChaincodeAction)(nil),          // 4: protos.ChaincodeAction
+	nil,                              // 5: protos.ChaincodeProposalPayload.TransientMapEntry
+	(*ChaincodeID)(nil),              // 6: protos.ChaincodeID
+	(*Response)(nil),                 // 7: protos.Response
+}
+var file_peer_proposal_proto_depIdxs = []int32{
+	6, // 0: protos.ChaincodeHeaderExtension.chaincode_id:type_name -> protos.ChaincodeID
+	5, // 1: protos.ChaincodeProposalPayload.TransientMap:type_name -> protos.ChaincodeProposalPayload.TransientMapEntry
+	7, // 2: protos.ChaincodeAction.response:type_name -> protos.Response
+	6, // 3: protos.ChaincodeAction.chaincode_id:type_name -> protos.ChaincodeID
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_peer_proposal_proto_init() }
+func file_peer_proposal_proto_init() {
+	if File_peer_proposal_proto != nil {
+		return
+	}
+	file_peer_chaincode_proto_init()
+	file_peer_proposal_response_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_peer_proposal_proto_rawDesc), len(file_peer_proposal_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_peer_proposal_proto_goTypes,
+		DependencyIndexes: file_peer_proposal_proto_depIdxs,
+		MessageInfos:      file_peer_proposal_proto_msgTypes,
+	}.Build()
+	File_peer_proposal_proto = out.File
+	file_peer_proposal_proto_goTypes = nil
+	file_peer_proposal_proto_depIdxs = nil
+}
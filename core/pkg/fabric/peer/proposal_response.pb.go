@@ -0,0 +1,347 @@
+// protos/fabric/peer/proposal_response.proto
+//
+// Copyright IBM Corp. All Rights Reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v6.33.0
+// source: peer/proposal_response.proto
+
+package peer
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ProposalResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Version   int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Response  *Response              `protobuf:"bytes,4,opt,name=response,proto3" json:"response,omitempty"`
+	// Marshaled ProposalResponsePayload, the bytes the endorser signed
+	Payload       []byte       `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Endorsement   *Endorsement `protobuf:"bytes,6,opt,name=endorsement,proto3" json:"endorsement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalResponse) Reset() {
+	*x = ProposalResponse{}
+	mi := &file_peer_proposal_response_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalResponse) ProtoMessage() {}
+
+func (x *ProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_response_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalResponse.ProtoReflect.Descriptor instead.
+func (*ProposalResponse) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_response_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProposalResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ProposalResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ProposalResponse) GetResponse() *Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *ProposalResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ProposalResponse) GetEndorsement() *Endorsement {
+	if x != nil {
+		return x.Endorsement
+	}
+	return nil
+}
+
+type Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_peer_proposal_response_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_response_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_response_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Response) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Response) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Response) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type ProposalResponsePayload struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ProposalHash []byte                 `protobuf:"bytes,1,opt,name=proposal_hash,json=proposalHash,proto3" json:"proposal_hash,omitempty"`
+	// Marshaled ChaincodeAction
+	Extension     []byte `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProposalResponsePayload) Reset() {
+	*x = ProposalResponsePayload{}
+	mi := &file_peer_proposal_response_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProposalResponsePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalResponsePayload) ProtoMessage() {}
+
+func (x *ProposalResponsePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_response_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalResponsePayload.ProtoReflect.Descriptor instead.
+func (*ProposalResponsePayload) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_response_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProposalResponsePayload) GetProposalHash() []byte {
+	if x != nil {
+		return x.ProposalHash
+	}
+	return nil
+}
+
+func (x *ProposalResponsePayload) GetExtension() []byte {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+type Endorsement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endorser      []byte                 `protobuf:"bytes,1,opt,name=endorser,proto3" json:"endorser,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Endorsement) Reset() {
+	*x = Endorsement{}
+	mi := &file_peer_proposal_response_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Endorsement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Endorsement) ProtoMessage() {}
+
+func (x *Endorsement) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proposal_response_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Endorsement.ProtoReflect.Descriptor instead.
+func (*Endorsement) Descriptor() ([]byte, []int) {
+	return file_peer_proposal_response_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Endorsement) GetEndorser() []byte {
+	if x != nil {
+		return x.Endorser
+	}
+	return nil
+}
+
+func (x *Endorsement) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_peer_proposal_response_proto protoreflect.FileDescriptor
+
+const file_peer_proposal_response_proto_rawDesc = "" +
+	"\n" +
+	"\x1cpeer/proposal_response.proto\x12\x06protos\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe5\x01\n" +
+	"\x10ProposalResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12,\n" +
+	"\bresponse\x18\x04 \x01(\v2\x10.protos.ResponseR\bresponse\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\fR\apayload\x125\n" +
+	"\vendorsement\x18\x06 \x01(\v2\x13.protos.EndorsementR\vendorsement\"V\n" +
+	"\bResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\apayload\x18\x03 \x01(\fR\apayload\"\\\n" +
+	"\x17ProposalResponsePayload\x12#\n" +
+	"\rproposal_hash\x18\x01 \x01(\fR\fproposalHash\x12\x1c\n" +
+	"\textension\x18\x02 \x01(\fR\textension\"G\n" +
+	"\vEndorsement\x12\x1a\n" +
+	"\bendorser\x18\x01 \x01(\fR\bendorser\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignatureB-Z+github.com/temmyjay001/core/pkg/fabric/peerb\x06proto3"
+
+var (
+	file_peer_proposal_response_proto_rawDescOnce sync.Once
+	file_peer_proposal_response_proto_rawDescData []byte
+)
+
+func file_peer_proposal_response_proto_rawDescGZIP() []byte {
+	file_peer_proposal_response_proto_rawDescOnce.Do(func() {
+		file_peer_proposal_response_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_peer_proposal_response_proto_rawDesc), len(file_peer_proposal_response_proto_rawDesc)))
+	})
+	return file_peer_proposal_response_proto_rawDescData
+}
+
+var file_peer_proposal_response_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_peer_proposal_response_proto_goTypes = []any{
+	(*ProposalResponse)(nil),        // 0: protos.ProposalResponse
+	(*Response)(nil),                // 1: protos.Response
+	(*ProposalResponsePayload)(nil), // 2: protos.ProposalResponsePayload
+	(*Endorsement)(nil),             // 3: protos.Endorsement
+	(*timestamppb.Timestamp)(nil),   // 4: google.protobuf.Timestamp
+}
+var file_peer_proposal_response_proto_depIdxs = []int32{
+	4, // 0: protos.ProposalResponse.timestamp:type_name -> google.protobuf.Timestamp
+	1, // 1: protos.ProposalResponse.response:type_name -> protos.Response
+	3, // 2: protos.ProposalResponse.endorsement:type_name -> protos.Endorsement
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_peer_proposal_response_proto_init() }
+func file_peer_proposal_response_proto_init() {
+	if File_peer_proposal_response_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_peer_proposal_response_proto_rawDesc), len(file_peer_proposal_response_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_peer_proposal_response_proto_goTypes,
+		DependencyIndexes: file_peer_proposal_response_proto_depIdxs,
+		MessageInfos:      file_peer_proposal_response_proto_msgTypes,
+	}.Build()
+	File_peer_proposal_response_proto = out.File
+	file_peer_proposal_response_proto_goTypes = nil
+	file_peer_proposal_response_proto_depIdxs = nil
+}
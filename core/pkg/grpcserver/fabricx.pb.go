@@ -23,14 +23,143 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How the committing peers validated a transaction. Values match Fabric's
+// protos.TxValidationCode.
+type TxValidationCode int32
+
+const (
+	TxValidationCode_VALID                        TxValidationCode = 0
+	TxValidationCode_NIL_ENVELOPE                 TxValidationCode = 1
+	TxValidationCode_BAD_PAYLOAD                  TxValidationCode = 2
+	TxValidationCode_BAD_COMMON_HEADER            TxValidationCode = 3
+	TxValidationCode_BAD_CREATOR_SIGNATURE        TxValidationCode = 4
+	TxValidationCode_INVALID_ENDORSER_TRANSACTION TxValidationCode = 5
+	TxValidationCode_INVALID_CONFIG_TRANSACTION   TxValidationCode = 6
+	TxValidationCode_UNSUPPORTED_TX_PAYLOAD       TxValidationCode = 7
+	TxValidationCode_BAD_PROPOSAL_TXID            TxValidationCode = 8
+	TxValidationCode_DUPLICATE_TXID               TxValidationCode = 9
+	TxValidationCode_ENDORSEMENT_POLICY_FAILURE   TxValidationCode = 10
+	TxValidationCode_MVCC_READ_CONFLICT           TxValidationCode = 11
+	TxValidationCode_PHANTOM_READ_CONFLICT        TxValidationCode = 12
+	TxValidationCode_UNKNOWN_TX_TYPE              TxValidationCode = 13
+	TxValidationCode_TARGET_CHAIN_NOT_FOUND       TxValidationCode = 14
+	TxValidationCode_MARSHAL_TX_ERROR             TxValidationCode = 15
+	TxValidationCode_NIL_TXACTION                 TxValidationCode = 16
+	TxValidationCode_EXPIRED_CHAINCODE            TxValidationCode = 17
+	TxValidationCode_CHAINCODE_VERSION_CONFLICT   TxValidationCode = 18
+	TxValidationCode_BAD_HEADER_EXTENSION         TxValidationCode = 19
+	TxValidationCode_BAD_CHANNEL_HEADER           TxValidationCode = 20
+	TxValidationCode_BAD_RESPONSE_PAYLOAD         TxValidationCode = 21
+	TxValidationCode_BAD_RWSET                    TxValidationCode = 22
+	TxValidationCode_ILLEGAL_WRITESET             TxValidationCode = 23
+	TxValidationCode_INVALID_WRITESET             TxValidationCode = 24
+	TxValidationCode_INVALID_CHAINCODE            TxValidationCode = 25
+	TxValidationCode_NOT_VALIDATED                TxValidationCode = 254
+	TxValidationCode_INVALID_OTHER_REASON         TxValidationCode = 255
+)
+
+// Enum value maps for TxValidationCode.
+var (
+	TxValidationCode_name = map[int32]string{
+		0:   "VALID",
+		1:   "NIL_ENVELOPE",
+		2:   "BAD_PAYLOAD",
+		3:   "BAD_COMMON_HEADER",
+		4:   "BAD_CREATOR_SIGNATURE",
+		5:   "INVALID_ENDORSER_TRANSACTION",
+		6:   "INVALID_CONFIG_TRANSACTION",
+		7:   "UNSUPPORTED_TX_PAYLOAD",
+		8:   "BAD_PROPOSAL_TXID",
+		9:   "DUPLICATE_TXID",
+		10:  "ENDORSEMENT_POLICY_FAILURE",
+		11:  "MVCC_READ_CONFLICT",
+		12:  "PHANTOM_READ_CONFLICT",
+		13:  "UNKNOWN_TX_TYPE",
+		14:  "TARGET_CHAIN_NOT_FOUND",
+		15:  "MARSHAL_TX_ERROR",
+		16:  "NIL_TXACTION",
+		17:  "EXPIRED_CHAINCODE",
+		18:  "CHAINCODE_VERSION_CONFLICT",
+		19:  "BAD_HEADER_EXTENSION",
+		20:  "BAD_CHANNEL_HEADER",
+		21:  "BAD_RESPONSE_PAYLOAD",
+		22:  "BAD_RWSET",
+		23:  "ILLEGAL_WRITESET",
+		24:  "INVALID_WRITESET",
+		25:  "INVALID_CHAINCODE",
+		254: "NOT_VALIDATED",
+		255: "INVALID_OTHER_REASON",
+	}
+	TxValidationCode_value = map[string]int32{
+		"VALID":                        0,
+		"NIL_ENVELOPE":                 1,
+		"BAD_PAYLOAD":                  2,
+		"BAD_COMMON_HEADER":            3,
+		"BAD_CREATOR_SIGNATURE":        4,
+		"INVALID_ENDORSER_TRANSACTION": 5,
+		"INVALID_CONFIG_TRANSACTION":   6,
+		"UNSUPPORTED_TX_PAYLOAD":       7,
+		"BAD_PROPOSAL_TXID":            8,
+		"DUPLICATE_TXID":               9,
+		"ENDORSEMENT_POLICY_FAILURE":   10,
+		"MVCC_READ_CONFLICT":           11,
+		"PHANTOM_READ_CONFLICT":        12,
+		"UNKNOWN_TX_TYPE":              13,
+		"TARGET_CHAIN_NOT_FOUND":       14,
+		"MARSHAL_TX_ERROR":             15,
+		"NIL_TXACTION":                 16,
+		"EXPIRED_CHAINCODE":            17,
+		"CHAINCODE_VERSION_CONFLICT":   18,
+		"BAD_HEADER_EXTENSION":         19,
+		"BAD_CHANNEL_HEADER":           20,
+		"BAD_RESPONSE_PAYLOAD":         21,
+		"BAD_RWSET":                    22,
+		"ILLEGAL_WRITESET":             23,
+		"INVALID_WRITESET":             24,
+		"INVALID_CHAINCODE":            25,
+		"NOT_VALIDATED":                254,
+		"INVALID_OTHER_REASON":         255,
+	}
+)
+
+func (x TxValidationCode) Enum() *TxValidationCode {
+	p := new(TxValidationCode)
+	*p = x
+	return p
+}
+
+func (x TxValidationCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TxValidationCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_protos_fabricx_proto_enumTypes[0].Descriptor()
+}
+
+func (TxValidationCode) Type() protoreflect.EnumType {
+	return &file_protos_fabricx_proto_enumTypes[0]
+}
+
+func (x TxValidationCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TxValidationCode.Descriptor instead.
+func (TxValidationCode) EnumDescriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{0}
+}
+
 type InitNetworkRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkName   string                 `protobuf:"bytes,1,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`
-	NumOrgs       int32                  `protobuf:"varint,2,opt,name=num_orgs,json=numOrgs,proto3" json:"num_orgs,omitempty"`
-	ChannelName   string                 `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
-	Config        map[string]string      `protobuf:"bytes,4,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NetworkName    string                 `protobuf:"bytes,1,opt,name=network_name,json=networkName,proto3" json:"network_name,omitempty"`
+	NumOrgs        int32                  `protobuf:"varint,2,opt,name=num_orgs,json=numOrgs,proto3" json:"num_orgs,omitempty"`
+	ChannelName    string                 `protobuf:"bytes,3,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Config         map[string]string      `protobuf:"bytes,4,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Topology       string                 `protobuf:"bytes,5,opt,name=topology,proto3" json:"topology,omitempty"`                                   // YAML or JSON topology document; overrides num_orgs and channel_name
+	CryptoProvider string                 `protobuf:"bytes,6,opt,name=crypto_provider,json=cryptoProvider,proto3" json:"crypto_provider,omitempty"` // "cryptogen" (default) or "ca"
+	SeparateTlsCa  bool                   `protobuf:"varint,7,opt,name=separate_tls_ca,json=separateTlsCa,proto3" json:"separate_tls_ca,omitempty"` // Run a dedicated TLS CA per org when crypto_provider is "ca"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *InitNetworkRequest) Reset() {
@@ -91,6 +220,27 @@ func (x *InitNetworkRequest) GetConfig() map[string]string {
 	return nil
 }
 
+func (x *InitNetworkRequest) GetTopology() string {
+	if x != nil {
+		return x.Topology
+	}
+	return ""
+}
+
+func (x *InitNetworkRequest) GetCryptoProvider() string {
+	if x != nil {
+		return x.CryptoProvider
+	}
+	return ""
+}
+
+func (x *InitNetworkRequest) GetSeparateTlsCa() bool {
+	if x != nil {
+		return x.SeparateTlsCa
+	}
+	return false
+}
+
 type InitNetworkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -167,8 +317,22 @@ type DeployChaincodeRequest struct {
 	Version               string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
 	Language              string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
 	EndorsementPolicyOrgs []string               `protobuf:"bytes,6,rep,name=endorsement_policy_orgs,json=endorsementPolicyOrgs,proto3" json:"endorsement_policy_orgs,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	CollectionsConfig     string                 `protobuf:"bytes,7,opt,name=collections_config,json=collectionsConfig,proto3" json:"collections_config,omitempty"` // Inline JSON or a path relative to chaincode_path
+	EndorsementPolicy     string                 `protobuf:"bytes,8,opt,name=endorsement_policy,json=endorsementPolicy,proto3" json:"endorsement_policy,omitempty"` // e.g. AND('Org1MSP.peer','Org2MSP.peer') or /Channel/Application/Endorsement
+	Mode                  string                 `protobuf:"bytes,9,opt,name=mode,proto3" json:"mode,omitempty"`                                                    // "package" (default) or "ccaas" to run the chaincode as a service
+	Debug                 bool                   `protobuf:"varint,10,opt,name=debug,proto3" json:"debug,omitempty"`                                                // Run Go chaincode under Delve (implies ccaas)
+	DebugPort             int32                  `protobuf:"varint,11,opt,name=debug_port,json=debugPort,proto3" json:"debug_port,omitempty"`                       // Host port for Delve, picked automatically when 0
+	Stage                 bool                   `protobuf:"varint,12,opt,name=stage,proto3" json:"stage,omitempty"`                                                // Stage the definition for each org to approve instead of approving for all
+	Vendor                bool                   `protobuf:"varint,13,opt,name=vendor,proto3" json:"vendor,omitempty"`                                              // Vendor Go modules into the package so peers build without internet access
+	// Instead of chaincode_path, which must be on the runtime host (a .tar.gz
+	// lifecycle package there is installed as is):
+	UploadId         string `protobuf:"bytes,14,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`                         // A package or source archive sent with UploadChaincode
+	GitUrl           string `protobuf:"bytes,15,opt,name=git_url,json=gitUrl,proto3" json:"git_url,omitempty"`                               // A git repository, checked out at git_ref
+	GitRef           string `protobuf:"bytes,16,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`                               // Branch, tag or commit; the default branch when empty
+	PackageId        string `protobuf:"bytes,17,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`                      // Expected package ID, verified before install
+	DeterminismCheck string `protobuf:"bytes,18,opt,name=determinism_check,json=determinismCheck,proto3" json:"determinism_check,omitempty"` // "warn" or "enforce" to check Go chaincode for non-determinism first
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *DeployChaincodeRequest) Reset() {
@@ -243,11 +407,100 @@ func (x *DeployChaincodeRequest) GetEndorsementPolicyOrgs() []string {
 	return nil
 }
 
+func (x *DeployChaincodeRequest) GetCollectionsConfig() string {
+	if x != nil {
+		return x.CollectionsConfig
+	}
+	return ""
+}
+
+func (x *DeployChaincodeRequest) GetEndorsementPolicy() string {
+	if x != nil {
+		return x.EndorsementPolicy
+	}
+	return ""
+}
+
+func (x *DeployChaincodeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *DeployChaincodeRequest) GetDebug() bool {
+	if x != nil {
+		return x.Debug
+	}
+	return false
+}
+
+func (x *DeployChaincodeRequest) GetDebugPort() int32 {
+	if x != nil {
+		return x.DebugPort
+	}
+	return 0
+}
+
+func (x *DeployChaincodeRequest) GetStage() bool {
+	if x != nil {
+		return x.Stage
+	}
+	return false
+}
+
+func (x *DeployChaincodeRequest) GetVendor() bool {
+	if x != nil {
+		return x.Vendor
+	}
+	return false
+}
+
+func (x *DeployChaincodeRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *DeployChaincodeRequest) GetGitUrl() string {
+	if x != nil {
+		return x.GitUrl
+	}
+	return ""
+}
+
+func (x *DeployChaincodeRequest) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+func (x *DeployChaincodeRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *DeployChaincodeRequest) GetDeterminismCheck() string {
+	if x != nil {
+		return x.DeterminismCheck
+	}
+	return ""
+}
+
 type DeployChaincodeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	ChaincodeId   string                 `protobuf:"bytes,3,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Success       bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ChaincodeId   string                   `protobuf:"bytes,3,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	DebugPort     int32                    `protobuf:"varint,4,opt,name=debug_port,json=debugPort,proto3" json:"debug_port,omitempty"` // Host port Delve listens on when debugging
+	Staged        bool                     `protobuf:"varint,5,opt,name=staged,proto3" json:"staged,omitempty"`                        // True when the definition waits for the orgs to approve it
+	Reason        string                   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                         // "go_modules" when Go dependencies could not be vendored, "nondeterministic" when the determinism check failed
+	PackageId     string                   `protobuf:"bytes,7,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Diagnostics   []*DeterminismDiagnostic `protobuf:"bytes,8,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"` // Findings of the determinism check
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -303,31 +556,79 @@ func (x *DeployChaincodeResponse) GetChaincodeId() string {
 	return ""
 }
 
-type InvokeTransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
-	FunctionName  string                 `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
-	Args          []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
-	Transient     bool                   `protobuf:"varint,5,opt,name=transient,proto3" json:"transient,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DeployChaincodeResponse) GetDebugPort() int32 {
+	if x != nil {
+		return x.DebugPort
+	}
+	return 0
 }
 
-func (x *InvokeTransactionRequest) Reset() {
-	*x = InvokeTransactionRequest{}
+func (x *DeployChaincodeResponse) GetStaged() bool {
+	if x != nil {
+		return x.Staged
+	}
+	return false
+}
+
+func (x *DeployChaincodeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeployChaincodeResponse) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *DeployChaincodeResponse) GetDiagnostics() []*DeterminismDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type UpgradeChaincodeRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId             string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName         string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	ChaincodePath         string                 `protobuf:"bytes,3,opt,name=chaincode_path,json=chaincodePath,proto3" json:"chaincode_path,omitempty"`
+	Version               string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"` // Defaults to the committed version
+	Language              string                 `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	EndorsementPolicyOrgs []string               `protobuf:"bytes,6,rep,name=endorsement_policy_orgs,json=endorsementPolicyOrgs,proto3" json:"endorsement_policy_orgs,omitempty"`
+	CollectionsConfig     string                 `protobuf:"bytes,7,opt,name=collections_config,json=collectionsConfig,proto3" json:"collections_config,omitempty"`
+	EndorsementPolicy     string                 `protobuf:"bytes,8,opt,name=endorsement_policy,json=endorsementPolicy,proto3" json:"endorsement_policy,omitempty"`
+	Mode                  string                 `protobuf:"bytes,9,opt,name=mode,proto3" json:"mode,omitempty"`
+	Debug                 bool                   `protobuf:"varint,10,opt,name=debug,proto3" json:"debug,omitempty"`
+	DebugPort             int32                  `protobuf:"varint,11,opt,name=debug_port,json=debugPort,proto3" json:"debug_port,omitempty"`
+	Stage                 bool                   `protobuf:"varint,12,opt,name=stage,proto3" json:"stage,omitempty"`
+	Vendor                bool                   `protobuf:"varint,13,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	UploadId              string                 `protobuf:"bytes,14,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	GitUrl                string                 `protobuf:"bytes,15,opt,name=git_url,json=gitUrl,proto3" json:"git_url,omitempty"`
+	GitRef                string                 `protobuf:"bytes,16,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
+	PackageId             string                 `protobuf:"bytes,17,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	DeterminismCheck      string                 `protobuf:"bytes,18,opt,name=determinism_check,json=determinismCheck,proto3" json:"determinism_check,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpgradeChaincodeRequest) Reset() {
+	*x = UpgradeChaincodeRequest{}
 	mi := &file_protos_fabricx_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InvokeTransactionRequest) String() string {
+func (x *UpgradeChaincodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvokeTransactionRequest) ProtoMessage() {}
+func (*UpgradeChaincodeRequest) ProtoMessage() {}
 
-func (x *InvokeTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *UpgradeChaincodeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_fabricx_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -339,139 +640,172 @@ func (x *InvokeTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvokeTransactionRequest.ProtoReflect.Descriptor instead.
-func (*InvokeTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpgradeChaincodeRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeChaincodeRequest) Descriptor() ([]byte, []int) {
 	return file_protos_fabricx_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *InvokeTransactionRequest) GetNetworkId() string {
+func (x *UpgradeChaincodeRequest) GetNetworkId() string {
 	if x != nil {
 		return x.NetworkId
 	}
 	return ""
 }
 
-func (x *InvokeTransactionRequest) GetChaincodeName() string {
+func (x *UpgradeChaincodeRequest) GetChaincodeName() string {
 	if x != nil {
 		return x.ChaincodeName
 	}
 	return ""
 }
 
-func (x *InvokeTransactionRequest) GetFunctionName() string {
+func (x *UpgradeChaincodeRequest) GetChaincodePath() string {
 	if x != nil {
-		return x.FunctionName
+		return x.ChaincodePath
 	}
 	return ""
 }
 
-func (x *InvokeTransactionRequest) GetArgs() []string {
+func (x *UpgradeChaincodeRequest) GetVersion() string {
 	if x != nil {
-		return x.Args
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *InvokeTransactionRequest) GetTransient() bool {
+func (x *UpgradeChaincodeRequest) GetLanguage() string {
 	if x != nil {
-		return x.Transient
+		return x.Language
 	}
-	return false
+	return ""
 }
 
-type InvokeTransactionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpgradeChaincodeRequest) GetEndorsementPolicyOrgs() []string {
+	if x != nil {
+		return x.EndorsementPolicyOrgs
+	}
+	return nil
 }
 
-func (x *InvokeTransactionResponse) Reset() {
-	*x = InvokeTransactionResponse{}
-	mi := &file_protos_fabricx_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpgradeChaincodeRequest) GetCollectionsConfig() string {
+	if x != nil {
+		return x.CollectionsConfig
+	}
+	return ""
 }
 
-func (x *InvokeTransactionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpgradeChaincodeRequest) GetEndorsementPolicy() string {
+	if x != nil {
+		return x.EndorsementPolicy
+	}
+	return ""
 }
 
-func (*InvokeTransactionResponse) ProtoMessage() {}
+func (x *UpgradeChaincodeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
 
-func (x *InvokeTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[5]
+func (x *UpgradeChaincodeRequest) GetDebug() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Debug
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use InvokeTransactionResponse.ProtoReflect.Descriptor instead.
-func (*InvokeTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{5}
+func (x *UpgradeChaincodeRequest) GetDebugPort() int32 {
+	if x != nil {
+		return x.DebugPort
+	}
+	return 0
 }
 
-func (x *InvokeTransactionResponse) GetSuccess() bool {
+func (x *UpgradeChaincodeRequest) GetStage() bool {
 	if x != nil {
-		return x.Success
+		return x.Stage
 	}
 	return false
 }
 
-func (x *InvokeTransactionResponse) GetMessage() string {
+func (x *UpgradeChaincodeRequest) GetVendor() bool {
 	if x != nil {
-		return x.Message
+		return x.Vendor
+	}
+	return false
+}
+
+func (x *UpgradeChaincodeRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
 	}
 	return ""
 }
 
-func (x *InvokeTransactionResponse) GetTransactionId() string {
+func (x *UpgradeChaincodeRequest) GetGitUrl() string {
 	if x != nil {
-		return x.TransactionId
+		return x.GitUrl
 	}
 	return ""
 }
 
-func (x *InvokeTransactionResponse) GetPayload() []byte {
+func (x *UpgradeChaincodeRequest) GetGitRef() string {
 	if x != nil {
-		return x.Payload
+		return x.GitRef
 	}
-	return nil
+	return ""
 }
 
-type QueryLedgerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
-	FunctionName  string                 `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
-	Args          []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+func (x *UpgradeChaincodeRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *UpgradeChaincodeRequest) GetDeterminismCheck() string {
+	if x != nil {
+		return x.DeterminismCheck
+	}
+	return ""
+}
+
+type UpgradeChaincodeResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Success       bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ChaincodeId   string                   `protobuf:"bytes,3,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	OldVersion    string                   `protobuf:"bytes,4,opt,name=old_version,json=oldVersion,proto3" json:"old_version,omitempty"`
+	NewVersion    string                   `protobuf:"bytes,5,opt,name=new_version,json=newVersion,proto3" json:"new_version,omitempty"`
+	OldSequence   int64                    `protobuf:"varint,6,opt,name=old_sequence,json=oldSequence,proto3" json:"old_sequence,omitempty"`
+	NewSequence   int64                    `protobuf:"varint,7,opt,name=new_sequence,json=newSequence,proto3" json:"new_sequence,omitempty"`
+	Reinstalled   bool                     `protobuf:"varint,8,opt,name=reinstalled,proto3" json:"reinstalled,omitempty"` // False when the chaincode was unchanged and the installed package was reused
+	Restarted     bool                     `protobuf:"varint,9,opt,name=restarted,proto3" json:"restarted,omitempty"`     // True when only the chaincode service was restarted (ccaas mode)
+	DebugPort     int32                    `protobuf:"varint,10,opt,name=debug_port,json=debugPort,proto3" json:"debug_port,omitempty"`
+	Staged        bool                     `protobuf:"varint,11,opt,name=staged,proto3" json:"staged,omitempty"`
+	Reason        string                   `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	PackageId     string                   `protobuf:"bytes,13,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Diagnostics   []*DeterminismDiagnostic `protobuf:"bytes,14,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryLedgerRequest) Reset() {
-	*x = QueryLedgerRequest{}
-	mi := &file_protos_fabricx_proto_msgTypes[6]
+func (x *UpgradeChaincodeResponse) Reset() {
+	*x = UpgradeChaincodeResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryLedgerRequest) String() string {
+func (x *UpgradeChaincodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLedgerRequest) ProtoMessage() {}
+func (*UpgradeChaincodeResponse) ProtoMessage() {}
 
-func (x *QueryLedgerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[6]
+func (x *UpgradeChaincodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,63 +816,4445 @@ func (x *QueryLedgerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryLedgerRequest.ProtoReflect.Descriptor instead.
-func (*QueryLedgerRequest) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpgradeChaincodeResponse.ProtoReflect.Descriptor instead.
+func (*UpgradeChaincodeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpgradeChaincodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpgradeChaincodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpgradeChaincodeResponse) GetChaincodeId() string {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return ""
+}
+
+func (x *UpgradeChaincodeResponse) GetOldVersion() string {
+	if x != nil {
+		return x.OldVersion
+	}
+	return ""
+}
+
+func (x *UpgradeChaincodeResponse) GetNewVersion() string {
+	if x != nil {
+		return x.NewVersion
+	}
+	return ""
+}
+
+func (x *UpgradeChaincodeResponse) GetOldSequence() int64 {
+	if x != nil {
+		return x.OldSequence
+	}
+	return 0
+}
+
+func (x *UpgradeChaincodeResponse) GetNewSequence() int64 {
+	if x != nil {
+		return x.NewSequence
+	}
+	return 0
+}
+
+func (x *UpgradeChaincodeResponse) GetReinstalled() bool {
+	if x != nil {
+		return x.Reinstalled
+	}
+	return false
+}
+
+func (x *UpgradeChaincodeResponse) GetRestarted() bool {
+	if x != nil {
+		return x.Restarted
+	}
+	return false
+}
+
+func (x *UpgradeChaincodeResponse) GetDebugPort() int32 {
+	if x != nil {
+		return x.DebugPort
+	}
+	return 0
+}
+
+func (x *UpgradeChaincodeResponse) GetStaged() bool {
+	if x != nil {
+		return x.Staged
+	}
+	return false
+}
+
+func (x *UpgradeChaincodeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpgradeChaincodeResponse) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *UpgradeChaincodeResponse) GetDiagnostics() []*DeterminismDiagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type InvokeTransactionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId        string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName    string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	FunctionName     string                 `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	Args             []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Org              string                 `protobuf:"bytes,6,opt,name=org,proto3" json:"org,omitempty"`                                                                                       // Signing org name or MSP ID; defaults to the first org
+	Identity         string                 `protobuf:"bytes,7,opt,name=identity,proto3" json:"identity,omitempty"`                                                                             // Signing identity, e.g. User1 or User1@org2.example.com; defaults to Admin
+	Transient        map[string][]byte      `protobuf:"bytes,8,rep,name=transient,proto3" json:"transient,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Private data passed to the chaincode, never stored on the ledger
+	Collection       string                 `protobuf:"bytes,9,opt,name=collection,proto3" json:"collection,omitempty"`                                                                         // Only endorse on peers of this collection's member orgs
+	EndorsingOrgs    []string               `protobuf:"bytes,10,rep,name=endorsing_orgs,json=endorsingOrgs,proto3" json:"endorsing_orgs,omitempty"`                                             // Endorse on the first peer of each org (name or MSP ID)
+	EndorsingPeers   []string               `protobuf:"bytes,11,rep,name=endorsing_peers,json=endorsingPeers,proto3" json:"endorsing_peers,omitempty"`                                          // Endorse on these peers, e.g. peer1.org1.example.com
+	AutoEndorse      bool                   `protobuf:"varint,12,opt,name=auto_endorse,json=autoEndorse,proto3" json:"auto_endorse,omitempty"`                                                  // Endorse on a minimal set of peers satisfying the committed policy
+	CheckDeterminism bool                   `protobuf:"varint,13,opt,name=check_determinism,json=checkDeterminism,proto3" json:"check_determinism,omitempty"`                                   // Endorse on every peer separately and compare the responses instead of submitting
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InvokeTransactionRequest) Reset() {
+	*x = InvokeTransactionRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeTransactionRequest) ProtoMessage() {}
+
+func (x *InvokeTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeTransactionRequest.ProtoReflect.Descriptor instead.
+func (*InvokeTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InvokeTransactionRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *InvokeTransactionRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *InvokeTransactionRequest) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *InvokeTransactionRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *InvokeTransactionRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *InvokeTransactionRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *InvokeTransactionRequest) GetTransient() map[string][]byte {
+	if x != nil {
+		return x.Transient
+	}
+	return nil
+}
+
+func (x *InvokeTransactionRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *InvokeTransactionRequest) GetEndorsingOrgs() []string {
+	if x != nil {
+		return x.EndorsingOrgs
+	}
+	return nil
+}
+
+func (x *InvokeTransactionRequest) GetEndorsingPeers() []string {
+	if x != nil {
+		return x.EndorsingPeers
+	}
+	return nil
+}
+
+func (x *InvokeTransactionRequest) GetAutoEndorse() bool {
+	if x != nil {
+		return x.AutoEndorse
+	}
+	return false
+}
+
+func (x *InvokeTransactionRequest) GetCheckDeterminism() bool {
+	if x != nil {
+		return x.CheckDeterminism
+	}
+	return false
+}
+
+type InvokeTransactionResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Success          bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message          string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TransactionId    string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Payload          []byte                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Determinism      *DeterminismReport     `protobuf:"bytes,5,opt,name=determinism,proto3" json:"determinism,omitempty"`                                                            // Set with check_determinism
+	BlockNumber      uint64                 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`                                        // Block the transaction was committed in
+	ValidationCode   TxValidationCode       `protobuf:"varint,7,opt,name=validation_code,json=validationCode,proto3,enum=fabricx.TxValidationCode" json:"validation_code,omitempty"` // NOT_VALIDATED unless a peer validated the transaction
+	EndorsingPeers   []string               `protobuf:"bytes,8,rep,name=endorsing_peers,json=endorsingPeers,proto3" json:"endorsing_peers,omitempty"`
+	ChaincodeStatus  int32                  `protobuf:"varint,9,opt,name=chaincode_status,json=chaincodeStatus,proto3" json:"chaincode_status,omitempty"` // The response the endorsers agreed on, or of the endorser that rejected the transaction
+	ChaincodeMessage string                 `protobuf:"bytes,10,opt,name=chaincode_message,json=chaincodeMessage,proto3" json:"chaincode_message,omitempty"`
+	Reason           string                 `protobuf:"bytes,11,opt,name=reason,proto3" json:"reason,omitempty"`        // "endorsement_rejected" or "endorsement_mismatch" when the transaction was not ordered, "invalidated" when the peers marked it invalid
+	Retryable        bool                   `protobuf:"varint,12,opt,name=retryable,proto3" json:"retryable,omitempty"` // Invalidated by MVCC_READ_CONFLICT or PHANTOM_READ_CONFLICT; endorsing it again may succeed
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InvokeTransactionResponse) Reset() {
+	*x = InvokeTransactionResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvokeTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvokeTransactionResponse) ProtoMessage() {}
+
+func (x *InvokeTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvokeTransactionResponse.ProtoReflect.Descriptor instead.
+func (*InvokeTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InvokeTransactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InvokeTransactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InvokeTransactionResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *InvokeTransactionResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *InvokeTransactionResponse) GetDeterminism() *DeterminismReport {
+	if x != nil {
+		return x.Determinism
+	}
+	return nil
+}
+
+func (x *InvokeTransactionResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *InvokeTransactionResponse) GetValidationCode() TxValidationCode {
+	if x != nil {
+		return x.ValidationCode
+	}
+	return TxValidationCode_VALID
+}
+
+func (x *InvokeTransactionResponse) GetEndorsingPeers() []string {
+	if x != nil {
+		return x.EndorsingPeers
+	}
+	return nil
+}
+
+func (x *InvokeTransactionResponse) GetChaincodeStatus() int32 {
+	if x != nil {
+		return x.ChaincodeStatus
+	}
+	return 0
+}
+
+func (x *InvokeTransactionResponse) GetChaincodeMessage() string {
+	if x != nil {
+		return x.ChaincodeMessage
+	}
+	return ""
+}
+
+func (x *InvokeTransactionResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InvokeTransactionResponse) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+// Proposal responses of every endorser of the same proposal, compared
+type DeterminismReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consistent    bool                   `protobuf:"varint,1,opt,name=consistent,proto3" json:"consistent,omitempty"`
+	Endorsers     []*EndorserResponse    `protobuf:"bytes,2,rep,name=endorsers,proto3" json:"endorsers,omitempty"`
+	Divergences   []*Divergence          `protobuf:"bytes,3,rep,name=divergences,proto3" json:"divergences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeterminismReport) Reset() {
+	*x = DeterminismReport{}
+	mi := &file_protos_fabricx_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeterminismReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeterminismReport) ProtoMessage() {}
+
+func (x *DeterminismReport) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeterminismReport.ProtoReflect.Descriptor instead.
+func (*DeterminismReport) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeterminismReport) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *DeterminismReport) GetEndorsers() []*EndorserResponse {
+	if x != nil {
+		return x.Endorsers
+	}
+	return nil
+}
+
+func (x *DeterminismReport) GetDivergences() []*Divergence {
+	if x != nil {
+		return x.Divergences
+	}
+	return nil
+}
+
+type EndorserResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Peer          string                   `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Org           string                   `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	Status        int32                    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Payload       []byte                   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Error         string                   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"` // Set when the peer could not be reached
+	Event         *ChaincodeEvent          `protobuf:"bytes,7,opt,name=event,proto3" json:"event,omitempty"`
+	Rwsets        []*NamespaceReadWriteSet `protobuf:"bytes,8,rep,name=rwsets,proto3" json:"rwsets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndorserResponse) Reset() {
+	*x = EndorserResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndorserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndorserResponse) ProtoMessage() {}
+
+func (x *EndorserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndorserResponse.ProtoReflect.Descriptor instead.
+func (*EndorserResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EndorserResponse) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *EndorserResponse) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *EndorserResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *EndorserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EndorserResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *EndorserResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EndorserResponse) GetEvent() *ChaincodeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EndorserResponse) GetRwsets() []*NamespaceReadWriteSet {
+	if x != nil {
+		return x.Rwsets
+	}
+	return nil
+}
+
+// Endorses a transaction without ordering it; fields match InvokeTransactionRequest
+type EndorseTransactionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId      string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName  string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	FunctionName   string                 `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	Args           []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Org            string                 `protobuf:"bytes,5,opt,name=org,proto3" json:"org,omitempty"`
+	Identity       string                 `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	Transient      map[string][]byte      `protobuf:"bytes,7,rep,name=transient,proto3" json:"transient,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Collection     string                 `protobuf:"bytes,8,opt,name=collection,proto3" json:"collection,omitempty"`
+	EndorsingOrgs  []string               `protobuf:"bytes,9,rep,name=endorsing_orgs,json=endorsingOrgs,proto3" json:"endorsing_orgs,omitempty"`
+	EndorsingPeers []string               `protobuf:"bytes,10,rep,name=endorsing_peers,json=endorsingPeers,proto3" json:"endorsing_peers,omitempty"`
+	AutoEndorse    bool                   `protobuf:"varint,11,opt,name=auto_endorse,json=autoEndorse,proto3" json:"auto_endorse,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *EndorseTransactionRequest) Reset() {
+	*x = EndorseTransactionRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndorseTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndorseTransactionRequest) ProtoMessage() {}
+
+func (x *EndorseTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndorseTransactionRequest.ProtoReflect.Descriptor instead.
+func (*EndorseTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EndorseTransactionRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *EndorseTransactionRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *EndorseTransactionRequest) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *EndorseTransactionRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *EndorseTransactionRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *EndorseTransactionRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *EndorseTransactionRequest) GetTransient() map[string][]byte {
+	if x != nil {
+		return x.Transient
+	}
+	return nil
+}
+
+func (x *EndorseTransactionRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *EndorseTransactionRequest) GetEndorsingOrgs() []string {
+	if x != nil {
+		return x.EndorsingOrgs
+	}
+	return nil
+}
+
+func (x *EndorseTransactionRequest) GetEndorsingPeers() []string {
+	if x != nil {
+		return x.EndorsingPeers
+	}
+	return nil
+}
+
+func (x *EndorseTransactionRequest) GetAutoEndorse() bool {
+	if x != nil {
+		return x.AutoEndorse
+	}
+	return false
+}
+
+type EndorseTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Endorsers     []*EndorserResponse    `protobuf:"bytes,4,rep,name=endorsers,proto3" json:"endorsers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EndorseTransactionResponse) Reset() {
+	*x = EndorseTransactionResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EndorseTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndorseTransactionResponse) ProtoMessage() {}
+
+func (x *EndorseTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndorseTransactionResponse.ProtoReflect.Descriptor instead.
+func (*EndorseTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EndorseTransactionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EndorseTransactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EndorseTransactionResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *EndorseTransactionResponse) GetEndorsers() []*EndorserResponse {
+	if x != nil {
+		return x.Endorsers
+	}
+	return nil
+}
+
+type ChaincodeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChaincodeEvent) Reset() {
+	*x = ChaincodeEvent{}
+	mi := &file_protos_fabricx_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeEvent) ProtoMessage() {}
+
+func (x *ChaincodeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeEvent.ProtoReflect.Descriptor instead.
+func (*ChaincodeEvent) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChaincodeEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChaincodeEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// What the chaincode read and wrote in one namespace, its own or one it called
+type NamespaceReadWriteSet struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Namespace     string                    `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Reads         []*KeyRead                `protobuf:"bytes,2,rep,name=reads,proto3" json:"reads,omitempty"`
+	Writes        []*KeyWrite               `protobuf:"bytes,3,rep,name=writes,proto3" json:"writes,omitempty"`
+	RangeQueries  []*RangeQuery             `protobuf:"bytes,4,rep,name=range_queries,json=rangeQueries,proto3" json:"range_queries,omitempty"`
+	Collections   []*CollectionReadWriteSet `protobuf:"bytes,5,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceReadWriteSet) Reset() {
+	*x = NamespaceReadWriteSet{}
+	mi := &file_protos_fabricx_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceReadWriteSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceReadWriteSet) ProtoMessage() {}
+
+func (x *NamespaceReadWriteSet) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceReadWriteSet.ProtoReflect.Descriptor instead.
+func (*NamespaceReadWriteSet) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NamespaceReadWriteSet) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespaceReadWriteSet) GetReads() []*KeyRead {
+	if x != nil {
+		return x.Reads
+	}
+	return nil
+}
+
+func (x *NamespaceReadWriteSet) GetWrites() []*KeyWrite {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+func (x *NamespaceReadWriteSet) GetRangeQueries() []*RangeQuery {
+	if x != nil {
+		return x.RangeQueries
+	}
+	return nil
+}
+
+func (x *NamespaceReadWriteSet) GetCollections() []*CollectionReadWriteSet {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+// Block and transaction that last wrote a key
+type KeyVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockNum      uint64                 `protobuf:"varint,1,opt,name=block_num,json=blockNum,proto3" json:"block_num,omitempty"`
+	TxNum         uint64                 `protobuf:"varint,2,opt,name=tx_num,json=txNum,proto3" json:"tx_num,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyVersion) Reset() {
+	*x = KeyVersion{}
+	mi := &file_protos_fabricx_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyVersion) ProtoMessage() {}
+
+func (x *KeyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyVersion.ProtoReflect.Descriptor instead.
+func (*KeyVersion) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KeyVersion) GetBlockNum() uint64 {
+	if x != nil {
+		return x.BlockNum
+	}
+	return 0
+}
+
+func (x *KeyVersion) GetTxNum() uint64 {
+	if x != nil {
+		return x.TxNum
+	}
+	return 0
+}
+
+type KeyRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Version       *KeyVersion            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Unset when the key was not in the world state
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyRead) Reset() {
+	*x = KeyRead{}
+	mi := &file_protos_fabricx_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRead) ProtoMessage() {}
+
+func (x *KeyRead) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyRead.ProtoReflect.Descriptor instead.
+func (*KeyRead) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KeyRead) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyRead) GetVersion() *KeyVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type KeyWrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         []byte                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	IsDelete      bool                   `protobuf:"varint,3,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyWrite) Reset() {
+	*x = KeyWrite{}
+	mi := &file_protos_fabricx_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyWrite) ProtoMessage() {}
+
+func (x *KeyWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyWrite.ProtoReflect.Descriptor instead.
+func (*KeyWrite) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *KeyWrite) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyWrite) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KeyWrite) GetIsDelete() bool {
+	if x != nil {
+		return x.IsDelete
+	}
+	return false
+}
+
+type RangeQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartKey      string                 `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	EndKey        string                 `protobuf:"bytes,2,opt,name=end_key,json=endKey,proto3" json:"end_key,omitempty"`
+	Exhausted     bool                   `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	Reads         []*KeyRead             `protobuf:"bytes,4,rep,name=reads,proto3" json:"reads,omitempty"`
+	MerkleHashes  [][]byte               `protobuf:"bytes,5,rep,name=merkle_hashes,json=merkleHashes,proto3" json:"merkle_hashes,omitempty"` // Set instead of reads for large results
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RangeQuery) Reset() {
+	*x = RangeQuery{}
+	mi := &file_protos_fabricx_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RangeQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeQuery) ProtoMessage() {}
+
+func (x *RangeQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeQuery.ProtoReflect.Descriptor instead.
+func (*RangeQuery) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RangeQuery) GetStartKey() string {
+	if x != nil {
+		return x.StartKey
+	}
+	return ""
+}
+
+func (x *RangeQuery) GetEndKey() string {
+	if x != nil {
+		return x.EndKey
+	}
+	return ""
+}
+
+func (x *RangeQuery) GetExhausted() bool {
+	if x != nil {
+		return x.Exhausted
+	}
+	return false
+}
+
+func (x *RangeQuery) GetReads() []*KeyRead {
+	if x != nil {
+		return x.Reads
+	}
+	return nil
+}
+
+func (x *RangeQuery) GetMerkleHashes() [][]byte {
+	if x != nil {
+		return x.MerkleHashes
+	}
+	return nil
+}
+
+// Private data is only known to endorsers by hash
+type CollectionReadWriteSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    string                 `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Reads         []*HashedKeyRead       `protobuf:"bytes,2,rep,name=reads,proto3" json:"reads,omitempty"`
+	Writes        []*HashedKeyWrite      `protobuf:"bytes,3,rep,name=writes,proto3" json:"writes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionReadWriteSet) Reset() {
+	*x = CollectionReadWriteSet{}
+	mi := &file_protos_fabricx_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionReadWriteSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionReadWriteSet) ProtoMessage() {}
+
+func (x *CollectionReadWriteSet) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionReadWriteSet.ProtoReflect.Descriptor instead.
+func (*CollectionReadWriteSet) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CollectionReadWriteSet) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *CollectionReadWriteSet) GetReads() []*HashedKeyRead {
+	if x != nil {
+		return x.Reads
+	}
+	return nil
+}
+
+func (x *CollectionReadWriteSet) GetWrites() []*HashedKeyWrite {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+type HashedKeyRead struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyHash       []byte                 `protobuf:"bytes,1,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	Version       *KeyVersion            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HashedKeyRead) Reset() {
+	*x = HashedKeyRead{}
+	mi := &file_protos_fabricx_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashedKeyRead) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashedKeyRead) ProtoMessage() {}
+
+func (x *HashedKeyRead) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashedKeyRead.ProtoReflect.Descriptor instead.
+func (*HashedKeyRead) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HashedKeyRead) GetKeyHash() []byte {
+	if x != nil {
+		return x.KeyHash
+	}
+	return nil
+}
+
+func (x *HashedKeyRead) GetVersion() *KeyVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type HashedKeyWrite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyHash       []byte                 `protobuf:"bytes,1,opt,name=key_hash,json=keyHash,proto3" json:"key_hash,omitempty"`
+	ValueHash     []byte                 `protobuf:"bytes,2,opt,name=value_hash,json=valueHash,proto3" json:"value_hash,omitempty"`
+	IsDelete      bool                   `protobuf:"varint,3,opt,name=is_delete,json=isDelete,proto3" json:"is_delete,omitempty"`
+	IsPurge       bool                   `protobuf:"varint,4,opt,name=is_purge,json=isPurge,proto3" json:"is_purge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HashedKeyWrite) Reset() {
+	*x = HashedKeyWrite{}
+	mi := &file_protos_fabricx_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashedKeyWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashedKeyWrite) ProtoMessage() {}
+
+func (x *HashedKeyWrite) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashedKeyWrite.ProtoReflect.Descriptor instead.
+func (*HashedKeyWrite) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HashedKeyWrite) GetKeyHash() []byte {
+	if x != nil {
+		return x.KeyHash
+	}
+	return nil
+}
+
+func (x *HashedKeyWrite) GetValueHash() []byte {
+	if x != nil {
+		return x.ValueHash
+	}
+	return nil
+}
+
+func (x *HashedKeyWrite) GetIsDelete() bool {
+	if x != nil {
+		return x.IsDelete
+	}
+	return false
+}
+
+func (x *HashedKeyWrite) GetIsPurge() bool {
+	if x != nil {
+		return x.IsPurge
+	}
+	return false
+}
+
+// A part of the proposal response endorsers disagree on
+type Divergence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // response, payload, event, read, write, range-query, private-read, private-write or rwset
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Collection    string                 `protobuf:"bytes,3,opt,name=collection,proto3" json:"collection,omitempty"`
+	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`                                                                                 // Private keys are hex SHA-256 hashes
+	Values        map[string]string      `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Peer name -> what the peer produced
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Divergence) Reset() {
+	*x = Divergence{}
+	mi := &file_protos_fabricx_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Divergence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Divergence) ProtoMessage() {}
+
+func (x *Divergence) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Divergence.ProtoReflect.Descriptor instead.
+func (*Divergence) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Divergence) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Divergence) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Divergence) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *Divergence) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Divergence) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type QueryLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	FunctionName  string                 `protobuf:"bytes,3,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	Args          []string               `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Org           string                 `protobuf:"bytes,5,opt,name=org,proto3" json:"org,omitempty"`
+	Identity      string                 `protobuf:"bytes,6,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryLedgerRequest) Reset() {
+	*x = QueryLedgerRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLedgerRequest) ProtoMessage() {}
+
+func (x *QueryLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryLedgerRequest.ProtoReflect.Descriptor instead.
+func (*QueryLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueryLedgerRequest) GetNetworkId() string {
 	if x != nil {
-		return x.NetworkId
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *QueryLedgerRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *QueryLedgerRequest) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *QueryLedgerRequest) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *QueryLedgerRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *QueryLedgerRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type QueryLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryLedgerResponse) Reset() {
+	*x = QueryLedgerResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLedgerResponse) ProtoMessage() {}
+
+func (x *QueryLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryLedgerResponse.ProtoReflect.Descriptor instead.
+func (*QueryLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryLedgerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QueryLedgerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QueryLedgerResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type StopNetworkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Cleanup       bool                   `protobuf:"varint,2,opt,name=cleanup,proto3" json:"cleanup,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopNetworkRequest) Reset() {
+	*x = StopNetworkRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopNetworkRequest) ProtoMessage() {}
+
+func (x *StopNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopNetworkRequest.ProtoReflect.Descriptor instead.
+func (*StopNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StopNetworkRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *StopNetworkRequest) GetCleanup() bool {
+	if x != nil {
+		return x.Cleanup
+	}
+	return false
+}
+
+type StopNetworkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopNetworkResponse) Reset() {
+	*x = StopNetworkResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopNetworkResponse) ProtoMessage() {}
+
+func (x *StopNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopNetworkResponse.ProtoReflect.Descriptor instead.
+func (*StopNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StopNetworkResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *StopNetworkResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type NetworkStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkStatusRequest) Reset() {
+	*x = NetworkStatusRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkStatusRequest) ProtoMessage() {}
+
+func (x *NetworkStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkStatusRequest.ProtoReflect.Descriptor instead.
+func (*NetworkStatusRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *NetworkStatusRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+type NetworkStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Running       bool                   `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Peers         []*PeerStatus          `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	Orderers      []*OrdererStatus       `protobuf:"bytes,4,rep,name=orderers,proto3" json:"orderers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkStatusResponse) Reset() {
+	*x = NetworkStatusResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkStatusResponse) ProtoMessage() {}
+
+func (x *NetworkStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkStatusResponse.ProtoReflect.Descriptor instead.
+func (*NetworkStatusResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *NetworkStatusResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *NetworkStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NetworkStatusResponse) GetPeers() []*PeerStatus {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *NetworkStatusResponse) GetOrderers() []*OrdererStatus {
+	if x != nil {
+		return x.Orderers
+	}
+	return nil
+}
+
+type PeerStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Org           string                 `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerStatus) Reset() {
+	*x = PeerStatus{}
+	mi := &file_protos_fabricx_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerStatus) ProtoMessage() {}
+
+func (x *PeerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerStatus.ProtoReflect.Descriptor instead.
+func (*PeerStatus) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PeerStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeerStatus) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *PeerStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PeerStatus) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type OrdererStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrdererStatus) Reset() {
+	*x = OrdererStatus{}
+	mi := &file_protos_fabricx_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrdererStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdererStatus) ProtoMessage() {}
+
+func (x *OrdererStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdererStatus.ProtoReflect.Descriptor instead.
+func (*OrdererStatus) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OrdererStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrdererStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrdererStatus) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type StreamLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ContainerName string                 `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StreamLogsRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetContainerName() string {
+	if x != nil {
+		return x.ContainerName
+	}
+	return ""
+}
+
+type LogMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Container     string                 `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogMessage) Reset() {
+	*x = LogMessage{}
+	mi := &file_protos_fabricx_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMessage) ProtoMessage() {}
+
+func (x *LogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
+func (*LogMessage) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *LogMessage) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *LogMessage) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+func (x *LogMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type WatchChaincodeRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId             string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName         string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	ChaincodePath         string                 `protobuf:"bytes,3,opt,name=chaincode_path,json=chaincodePath,proto3" json:"chaincode_path,omitempty"`
+	Language              string                 `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	EndorsementPolicyOrgs []string               `protobuf:"bytes,5,rep,name=endorsement_policy_orgs,json=endorsementPolicyOrgs,proto3" json:"endorsement_policy_orgs,omitempty"`
+	EndorsementPolicy     string                 `protobuf:"bytes,6,opt,name=endorsement_policy,json=endorsementPolicy,proto3" json:"endorsement_policy,omitempty"`
+	CollectionsConfig     string                 `protobuf:"bytes,7,opt,name=collections_config,json=collectionsConfig,proto3" json:"collections_config,omitempty"`
+	Mode                  string                 `protobuf:"bytes,8,opt,name=mode,proto3" json:"mode,omitempty"`                                 // "package" (default) or "ccaas"
+	DebounceMs            int64                  `protobuf:"varint,9,opt,name=debounce_ms,json=debounceMs,proto3" json:"debounce_ms,omitempty"`  // Quiet period before redeploying (default 1000)
+	SkipChecks            bool                   `protobuf:"varint,10,opt,name=skip_checks,json=skipChecks,proto3" json:"skip_checks,omitempty"` // Skip go vet and go build before redeploying
+	Vendor                bool                   `protobuf:"varint,11,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *WatchChaincodeRequest) Reset() {
+	*x = WatchChaincodeRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchChaincodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChaincodeRequest) ProtoMessage() {}
+
+func (x *WatchChaincodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChaincodeRequest.ProtoReflect.Descriptor instead.
+func (*WatchChaincodeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WatchChaincodeRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *WatchChaincodeRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *WatchChaincodeRequest) GetChaincodePath() string {
+	if x != nil {
+		return x.ChaincodePath
+	}
+	return ""
+}
+
+func (x *WatchChaincodeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *WatchChaincodeRequest) GetEndorsementPolicyOrgs() []string {
+	if x != nil {
+		return x.EndorsementPolicyOrgs
+	}
+	return nil
+}
+
+func (x *WatchChaincodeRequest) GetEndorsementPolicy() string {
+	if x != nil {
+		return x.EndorsementPolicy
+	}
+	return ""
+}
+
+func (x *WatchChaincodeRequest) GetCollectionsConfig() string {
+	if x != nil {
+		return x.CollectionsConfig
+	}
+	return ""
+}
+
+func (x *WatchChaincodeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *WatchChaincodeRequest) GetDebounceMs() int64 {
+	if x != nil {
+		return x.DebounceMs
+	}
+	return 0
+}
+
+func (x *WatchChaincodeRequest) GetSkipChecks() bool {
+	if x != nil {
+		return x.SkipChecks
+	}
+	return false
+}
+
+func (x *WatchChaincodeRequest) GetVendor() bool {
+	if x != nil {
+		return x.Vendor
+	}
+	return false
+}
+
+type WatchEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // started, changed, check_failed, deployed or deploy_failed
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Output        string                 `protobuf:"bytes,4,opt,name=output,proto3" json:"output,omitempty"` // Build check output
+	Version       string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Sequence      int64                  `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Restarted     bool                   `protobuf:"varint,7,opt,name=restarted,proto3" json:"restarted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	mi := &file_protos_fabricx_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *WatchEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *WatchEvent) GetRestarted() bool {
+	if x != nil {
+		return x.Restarted
+	}
+	return false
+}
+
+type ExportTopologyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "yaml" (default) or "json"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTopologyRequest) Reset() {
+	*x = ExportTopologyRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTopologyRequest) ProtoMessage() {}
+
+func (x *ExportTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTopologyRequest.ProtoReflect.Descriptor instead.
+func (*ExportTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportTopologyRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *ExportTopologyRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportTopologyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Topology      string                 `protobuf:"bytes,3,opt,name=topology,proto3" json:"topology,omitempty"`
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportTopologyResponse) Reset() {
+	*x = ExportTopologyResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportTopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportTopologyResponse) ProtoMessage() {}
+
+func (x *ExportTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportTopologyResponse.ProtoReflect.Descriptor instead.
+func (*ExportTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportTopologyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExportTopologyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportTopologyResponse) GetTopology() string {
+	if x != nil {
+		return x.Topology
+	}
+	return ""
+}
+
+func (x *ExportTopologyResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type RegisterIdentityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId      string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Org            string                 `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"` // Organization name or MSP ID
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Secret         string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"` // Generated by the CA when empty
+	Type           string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`     // client (default), admin, peer or orderer
+	Affiliation    string                 `protobuf:"bytes,6,opt,name=affiliation,proto3" json:"affiliation,omitempty"`
+	Attributes     map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Added to the enrollment certificate
+	MaxEnrollments int32                  `protobuf:"varint,8,opt,name=max_enrollments,json=maxEnrollments,proto3" json:"max_enrollments,omitempty"`                                            // 0 uses the CA default, -1 means unlimited
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterIdentityRequest) Reset() {
+	*x = RegisterIdentityRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterIdentityRequest) ProtoMessage() {}
+
+func (x *RegisterIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterIdentityRequest.ProtoReflect.Descriptor instead.
+func (*RegisterIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RegisterIdentityRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *RegisterIdentityRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *RegisterIdentityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegisterIdentityRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *RegisterIdentityRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RegisterIdentityRequest) GetAffiliation() string {
+	if x != nil {
+		return x.Affiliation
+	}
+	return ""
+}
+
+func (x *RegisterIdentityRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *RegisterIdentityRequest) GetMaxEnrollments() int32 {
+	if x != nil {
+		return x.MaxEnrollments
+	}
+	return 0
+}
+
+type RegisterIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterIdentityResponse) Reset() {
+	*x = RegisterIdentityResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterIdentityResponse) ProtoMessage() {}
+
+func (x *RegisterIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterIdentityResponse.ProtoReflect.Descriptor instead.
+func (*RegisterIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RegisterIdentityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterIdentityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterIdentityResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type EnrollIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Org           string                 `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	Attributes    []string               `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty"` // Attributes to request; empty keeps the registered ones
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollIdentityRequest) Reset() {
+	*x = EnrollIdentityRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollIdentityRequest) ProtoMessage() {}
+
+func (x *EnrollIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollIdentityRequest.ProtoReflect.Descriptor instead.
+func (*EnrollIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EnrollIdentityRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *EnrollIdentityRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *EnrollIdentityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnrollIdentityRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollIdentityRequest) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type EnrollIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MspPath       string                 `protobuf:"bytes,3,opt,name=msp_path,json=mspPath,proto3" json:"msp_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollIdentityResponse) Reset() {
+	*x = EnrollIdentityResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollIdentityResponse) ProtoMessage() {}
+
+func (x *EnrollIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollIdentityResponse.ProtoReflect.Descriptor instead.
+func (*EnrollIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EnrollIdentityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EnrollIdentityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EnrollIdentityResponse) GetMspPath() string {
+	if x != nil {
+		return x.MspPath
+	}
+	return ""
+}
+
+type RevokeIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Org           string                 `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeIdentityRequest) Reset() {
+	*x = RevokeIdentityRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeIdentityRequest) ProtoMessage() {}
+
+func (x *RevokeIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeIdentityRequest.ProtoReflect.Descriptor instead.
+func (*RevokeIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RevokeIdentityRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *RevokeIdentityRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *RevokeIdentityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RevokeIdentityRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RevokeIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeIdentityResponse) Reset() {
+	*x = RevokeIdentityResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeIdentityResponse) ProtoMessage() {}
+
+func (x *RevokeIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeIdentityResponse.ProtoReflect.Descriptor instead.
+func (*RevokeIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RevokeIdentityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeIdentityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Org           string                 `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesRequest) Reset() {
+	*x = ListIdentitiesRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListIdentitiesRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *ListIdentitiesRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+type ListIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Identities    []*IdentityInfo        `protobuf:"bytes,3,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIdentitiesResponse) Reset() {
+	*x = ListIdentitiesResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListIdentitiesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListIdentitiesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListIdentitiesResponse) GetIdentities() []*IdentityInfo {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+type IdentityInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Affiliation    string                 `protobuf:"bytes,3,opt,name=affiliation,proto3" json:"affiliation,omitempty"`
+	MaxEnrollments int32                  `protobuf:"varint,4,opt,name=max_enrollments,json=maxEnrollments,proto3" json:"max_enrollments,omitempty"`
+	Attributes     map[string]string      `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IdentityInfo) Reset() {
+	*x = IdentityInfo{}
+	mi := &file_protos_fabricx_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityInfo) ProtoMessage() {}
+
+func (x *IdentityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityInfo.ProtoReflect.Descriptor instead.
+func (*IdentityInfo) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *IdentityInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetAffiliation() string {
+	if x != nil {
+		return x.Affiliation
+	}
+	return ""
+}
+
+func (x *IdentityInfo) GetMaxEnrollments() int32 {
+	if x != nil {
+		return x.MaxEnrollments
+	}
+	return 0
+}
+
+func (x *IdentityInfo) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GetCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"` // Empty for all chaincodes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionsRequest) Reset() {
+	*x = GetCollectionsRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsRequest) ProtoMessage() {}
+
+func (x *GetCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetCollectionsRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *GetCollectionsRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+type GetCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Collections   []*CollectionInfo      `protobuf:"bytes,3,rep,name=collections,proto3" json:"collections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionsResponse) Reset() {
+	*x = GetCollectionsResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionsResponse) ProtoMessage() {}
+
+func (x *GetCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetCollectionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetCollectionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCollectionsResponse) GetCollections() []*CollectionInfo {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type CollectionInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ChaincodeName     string                 `protobuf:"bytes,1,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Policy            string                 `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`
+	MemberOrgs        []string               `protobuf:"bytes,4,rep,name=member_orgs,json=memberOrgs,proto3" json:"member_orgs,omitempty"`
+	Peers             []string               `protobuf:"bytes,5,rep,name=peers,proto3" json:"peers,omitempty"` // Peers that hold the collection's private data
+	RequiredPeerCount int32                  `protobuf:"varint,6,opt,name=required_peer_count,json=requiredPeerCount,proto3" json:"required_peer_count,omitempty"`
+	MaxPeerCount      int32                  `protobuf:"varint,7,opt,name=max_peer_count,json=maxPeerCount,proto3" json:"max_peer_count,omitempty"`
+	BlockToLive       uint64                 `protobuf:"varint,8,opt,name=block_to_live,json=blockToLive,proto3" json:"block_to_live,omitempty"`
+	MemberOnlyRead    bool                   `protobuf:"varint,9,opt,name=member_only_read,json=memberOnlyRead,proto3" json:"member_only_read,omitempty"`
+	MemberOnlyWrite   bool                   `protobuf:"varint,10,opt,name=member_only_write,json=memberOnlyWrite,proto3" json:"member_only_write,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CollectionInfo) Reset() {
+	*x = CollectionInfo{}
+	mi := &file_protos_fabricx_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionInfo) ProtoMessage() {}
+
+func (x *CollectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionInfo.ProtoReflect.Descriptor instead.
+func (*CollectionInfo) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CollectionInfo) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *CollectionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionInfo) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *CollectionInfo) GetMemberOrgs() []string {
+	if x != nil {
+		return x.MemberOrgs
+	}
+	return nil
+}
+
+func (x *CollectionInfo) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *CollectionInfo) GetRequiredPeerCount() int32 {
+	if x != nil {
+		return x.RequiredPeerCount
+	}
+	return 0
+}
+
+func (x *CollectionInfo) GetMaxPeerCount() int32 {
+	if x != nil {
+		return x.MaxPeerCount
+	}
+	return 0
+}
+
+func (x *CollectionInfo) GetBlockToLive() uint64 {
+	if x != nil {
+		return x.BlockToLive
+	}
+	return 0
+}
+
+func (x *CollectionInfo) GetMemberOnlyRead() bool {
+	if x != nil {
+		return x.MemberOnlyRead
+	}
+	return false
+}
+
+func (x *CollectionInfo) GetMemberOnlyWrite() bool {
+	if x != nil {
+		return x.MemberOnlyWrite
+	}
+	return false
+}
+
+type ListChaincodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChaincodesRequest) Reset() {
+	*x = ListChaincodesRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChaincodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChaincodesRequest) ProtoMessage() {}
+
+func (x *ListChaincodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChaincodesRequest.ProtoReflect.Descriptor instead.
+func (*ListChaincodesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListChaincodesRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+type ListChaincodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Installed     []*PeerPackages        `protobuf:"bytes,5,rep,name=installed,proto3" json:"installed,omitempty"`
+	Channels      []*ChannelChaincodes   `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels,omitempty"` // Committed definitions of every channel
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListChaincodesResponse) Reset() {
+	*x = ListChaincodesResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListChaincodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListChaincodesResponse) ProtoMessage() {}
+
+func (x *ListChaincodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListChaincodesResponse.ProtoReflect.Descriptor instead.
+func (*ListChaincodesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListChaincodesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListChaincodesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListChaincodesResponse) GetInstalled() []*PeerPackages {
+	if x != nil {
+		return x.Installed
+	}
+	return nil
+}
+
+func (x *ListChaincodesResponse) GetChannels() []*ChannelChaincodes {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ChannelChaincodes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Committed     []*ChaincodeDefinition `protobuf:"bytes,2,rep,name=committed,proto3" json:"committed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelChaincodes) Reset() {
+	*x = ChannelChaincodes{}
+	mi := &file_protos_fabricx_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChaincodes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChaincodes) ProtoMessage() {}
+
+func (x *ChannelChaincodes) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChaincodes.ProtoReflect.Descriptor instead.
+func (*ChannelChaincodes) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ChannelChaincodes) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelChaincodes) GetCommitted() []*ChaincodeDefinition {
+	if x != nil {
+		return x.Committed
+	}
+	return nil
+}
+
+type GetChaincodeDefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChaincodeDefinitionRequest) Reset() {
+	*x = GetChaincodeDefinitionRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChaincodeDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChaincodeDefinitionRequest) ProtoMessage() {}
+
+func (x *GetChaincodeDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChaincodeDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*GetChaincodeDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetChaincodeDefinitionRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *GetChaincodeDefinitionRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+type GetChaincodeDefinitionResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Success       bool                          `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Installed     []*PeerPackages               `protobuf:"bytes,5,rep,name=installed,proto3" json:"installed,omitempty"` // Only packages of this chaincode
+	Channels      []*ChannelChaincodeDefinition `protobuf:"bytes,6,rep,name=channels,proto3" json:"channels,omitempty"`   // Channels the chaincode is committed or approved on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChaincodeDefinitionResponse) Reset() {
+	*x = GetChaincodeDefinitionResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChaincodeDefinitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChaincodeDefinitionResponse) ProtoMessage() {}
+
+func (x *GetChaincodeDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChaincodeDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*GetChaincodeDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetChaincodeDefinitionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetChaincodeDefinitionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetChaincodeDefinitionResponse) GetInstalled() []*PeerPackages {
+	if x != nil {
+		return x.Installed
+	}
+	return nil
+}
+
+func (x *GetChaincodeDefinitionResponse) GetChannels() []*ChannelChaincodeDefinition {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type ChannelChaincodeDefinition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Committed     *ChaincodeDefinition   `protobuf:"bytes,2,opt,name=committed,proto3" json:"committed,omitempty"` // Unset when the chaincode was never committed on the channel
+	Pending       *ChaincodeDefinition   `protobuf:"bytes,3,opt,name=pending,proto3" json:"pending,omitempty"`     // Definition approved for the next sequence, unset when none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChannelChaincodeDefinition) Reset() {
+	*x = ChannelChaincodeDefinition{}
+	mi := &file_protos_fabricx_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChannelChaincodeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelChaincodeDefinition) ProtoMessage() {}
+
+func (x *ChannelChaincodeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelChaincodeDefinition.ProtoReflect.Descriptor instead.
+func (*ChannelChaincodeDefinition) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ChannelChaincodeDefinition) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ChannelChaincodeDefinition) GetCommitted() *ChaincodeDefinition {
+	if x != nil {
+		return x.Committed
+	}
+	return nil
+}
+
+func (x *ChannelChaincodeDefinition) GetPending() *ChaincodeDefinition {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+type ChaincodeDefinition struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version           string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Sequence          int64                  `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	EndorsementPolicy string                 `protobuf:"bytes,4,opt,name=endorsement_policy,json=endorsementPolicy,proto3" json:"endorsement_policy,omitempty"`
+	Collections       []*CollectionInfo      `protobuf:"bytes,5,rep,name=collections,proto3" json:"collections,omitempty"`
+	InitRequired      bool                   `protobuf:"varint,6,opt,name=init_required,json=initRequired,proto3" json:"init_required,omitempty"`
+	Approvals         map[string]bool        `protobuf:"bytes,7,rep,name=approvals,proto3" json:"approvals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // MSP ID -> approved
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChaincodeDefinition) Reset() {
+	*x = ChaincodeDefinition{}
+	mi := &file_protos_fabricx_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaincodeDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeDefinition) ProtoMessage() {}
+
+func (x *ChaincodeDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeDefinition.ProtoReflect.Descriptor instead.
+func (*ChaincodeDefinition) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ChaincodeDefinition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChaincodeDefinition) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ChaincodeDefinition) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ChaincodeDefinition) GetEndorsementPolicy() string {
+	if x != nil {
+		return x.EndorsementPolicy
+	}
+	return ""
+}
+
+func (x *ChaincodeDefinition) GetCollections() []*CollectionInfo {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ChaincodeDefinition) GetInitRequired() bool {
+	if x != nil {
+		return x.InitRequired
+	}
+	return false
+}
+
+func (x *ChaincodeDefinition) GetApprovals() map[string]bool {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type PeerPackages struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Org           string                 `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`
+	Peer          string                 `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	Packages      []*InstalledPackage    `protobuf:"bytes,3,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerPackages) Reset() {
+	*x = PeerPackages{}
+	mi := &file_protos_fabricx_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerPackages) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerPackages) ProtoMessage() {}
+
+func (x *PeerPackages) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerPackages.ProtoReflect.Descriptor instead.
+func (*PeerPackages) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *PeerPackages) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *PeerPackages) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *PeerPackages) GetPackages() []*InstalledPackage {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type InstalledPackage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	References    []string               `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"` // Definitions using the package, as channel/name:version
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstalledPackage) Reset() {
+	*x = InstalledPackage{}
+	mi := &file_protos_fabricx_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstalledPackage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledPackage) ProtoMessage() {}
+
+func (x *InstalledPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledPackage.ProtoReflect.Descriptor instead.
+func (*InstalledPackage) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *InstalledPackage) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *InstalledPackage) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *InstalledPackage) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type ApproveChaincodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	Org           string                 `protobuf:"bytes,3,opt,name=org,proto3" json:"org,omitempty"` // Org approving the staged definition, e.g. Org1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveChaincodeRequest) Reset() {
+	*x = ApproveChaincodeRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveChaincodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveChaincodeRequest) ProtoMessage() {}
+
+func (x *ApproveChaincodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveChaincodeRequest.ProtoReflect.Descriptor instead.
+func (*ApproveChaincodeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ApproveChaincodeRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *ApproveChaincodeRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *ApproveChaincodeRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+type ApproveChaincodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveChaincodeResponse) Reset() {
+	*x = ApproveChaincodeResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveChaincodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveChaincodeResponse) ProtoMessage() {}
+
+func (x *ApproveChaincodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveChaincodeResponse.ProtoReflect.Descriptor instead.
+func (*ApproveChaincodeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ApproveChaincodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApproveChaincodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RejectChaincodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	Org           string                 `protobuf:"bytes,3,opt,name=org,proto3" json:"org,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectChaincodeRequest) Reset() {
+	*x = RejectChaincodeRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectChaincodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectChaincodeRequest) ProtoMessage() {}
+
+func (x *RejectChaincodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectChaincodeRequest.ProtoReflect.Descriptor instead.
+func (*RejectChaincodeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RejectChaincodeRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *RejectChaincodeRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+func (x *RejectChaincodeRequest) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *RejectChaincodeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectChaincodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectChaincodeResponse) Reset() {
+	*x = RejectChaincodeResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectChaincodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectChaincodeResponse) ProtoMessage() {}
+
+func (x *RejectChaincodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectChaincodeResponse.ProtoReflect.Descriptor instead.
+func (*RejectChaincodeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RejectChaincodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RejectChaincodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CheckCommitReadinessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCommitReadinessRequest) Reset() {
+	*x = CheckCommitReadinessRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCommitReadinessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCommitReadinessRequest) ProtoMessage() {}
+
+func (x *CheckCommitReadinessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCommitReadinessRequest.ProtoReflect.Descriptor instead.
+func (*CheckCommitReadinessRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CheckCommitReadinessRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *CheckCommitReadinessRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+type CheckCommitReadinessResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Success         bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Version         string                 `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Sequence        int64                  `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Approvals       map[string]bool        `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`  // MSP ID -> approved
+	Rejections      map[string]string      `protobuf:"bytes,6,rep,name=rejections,proto3" json:"rejections,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // MSP ID -> reason
+	Mismatched      []string               `protobuf:"bytes,7,rep,name=mismatched,proto3" json:"mismatched,omitempty"`                                                                           // MSP IDs that approved a different definition
+	LifecyclePolicy string                 `protobuf:"bytes,8,opt,name=lifecycle_policy,json=lifecyclePolicy,proto3" json:"lifecycle_policy,omitempty"`
+	Ready           bool                   `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"` // Approvals satisfy the LifecycleEndorsement policy
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckCommitReadinessResponse) Reset() {
+	*x = CheckCommitReadinessResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCommitReadinessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCommitReadinessResponse) ProtoMessage() {}
+
+func (x *CheckCommitReadinessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCommitReadinessResponse.ProtoReflect.Descriptor instead.
+func (*CheckCommitReadinessResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CheckCommitReadinessResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CheckCommitReadinessResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckCommitReadinessResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CheckCommitReadinessResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CheckCommitReadinessResponse) GetApprovals() map[string]bool {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *CheckCommitReadinessResponse) GetRejections() map[string]string {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+func (x *CheckCommitReadinessResponse) GetMismatched() []string {
+	if x != nil {
+		return x.Mismatched
+	}
+	return nil
+}
+
+func (x *CheckCommitReadinessResponse) GetLifecyclePolicy() string {
+	if x != nil {
+		return x.LifecyclePolicy
+	}
+	return ""
+}
+
+func (x *CheckCommitReadinessResponse) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+type CommitChaincodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitChaincodeRequest) Reset() {
+	*x = CommitChaincodeRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitChaincodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitChaincodeRequest) ProtoMessage() {}
+
+func (x *CommitChaincodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitChaincodeRequest.ProtoReflect.Descriptor instead.
+func (*CommitChaincodeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CommitChaincodeRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *CommitChaincodeRequest) GetChaincodeName() string {
+	if x != nil {
+		return x.ChaincodeName
+	}
+	return ""
+}
+
+type CommitChaincodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	ChaincodeId   string                 `protobuf:"bytes,3,opt,name=chaincode_id,json=chaincodeId,proto3" json:"chaincode_id,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Sequence      int64                  `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"` // "rejected", "mismatch" or "missing_approvals" when the commit was refused
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitChaincodeResponse) Reset() {
+	*x = CommitChaincodeResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitChaincodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitChaincodeResponse) ProtoMessage() {}
+
+func (x *CommitChaincodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitChaincodeResponse.ProtoReflect.Descriptor instead.
+func (*CommitChaincodeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CommitChaincodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CommitChaincodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CommitChaincodeResponse) GetChaincodeId() string {
+	if x != nil {
+		return x.ChaincodeId
+	}
+	return ""
+}
+
+func (x *CommitChaincodeResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CommitChaincodeResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CommitChaincodeResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// UploadChaincodeRequest carries one chunk of an upload. The first message
+// sets the metadata; every message may carry data.
+type UploadChaincodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // "package" for a lifecycle .tar.gz, "source" for a .tar.gz, .tar or .zip of the chaincode folder
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Sha256        string                 `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"` // Hex digest of the whole file, verified when set
+	Data          []byte                 `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChaincodeRequest) Reset() {
+	*x = UploadChaincodeRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChaincodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChaincodeRequest) ProtoMessage() {}
+
+func (x *UploadChaincodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChaincodeRequest.ProtoReflect.Descriptor instead.
+func (*UploadChaincodeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UploadChaincodeRequest) GetNetworkId() string {
+	if x != nil {
+		return x.NetworkId
+	}
+	return ""
+}
+
+func (x *UploadChaincodeRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UploadChaincodeRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *UploadChaincodeRequest) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *UploadChaincodeRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UploadChaincodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	UploadId      string                 `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"` // Pass as upload_id to DeployChaincode or UpgradeChaincode
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256        string                 `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	PackageId     string                 `protobuf:"bytes,6,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"` // Set for packages
+	Label         string                 `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	Language      string                 `protobuf:"bytes,8,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChaincodeResponse) Reset() {
+	*x = UploadChaincodeResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChaincodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChaincodeResponse) ProtoMessage() {}
+
+func (x *UploadChaincodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadChaincodeResponse.ProtoReflect.Descriptor instead.
+func (*UploadChaincodeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UploadChaincodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UploadChaincodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UploadChaincodeResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *UploadChaincodeResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *UploadChaincodeResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
 	}
 	return ""
 }
 
-func (x *QueryLedgerRequest) GetChaincodeName() string {
+func (x *UploadChaincodeResponse) GetPackageId() string {
 	if x != nil {
-		return x.ChaincodeName
+		return x.PackageId
 	}
 	return ""
 }
 
-func (x *QueryLedgerRequest) GetFunctionName() string {
+func (x *UploadChaincodeResponse) GetLabel() string {
 	if x != nil {
-		return x.FunctionName
+		return x.Label
 	}
 	return ""
 }
 
-func (x *QueryLedgerRequest) GetArgs() []string {
+func (x *UploadChaincodeResponse) GetLanguage() string {
 	if x != nil {
-		return x.Args
+		return x.Language
 	}
-	return nil
+	return ""
 }
 
-type QueryLedgerResponse struct {
+type ListTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTemplatesRequest) Reset() {
+	*x = ListTemplatesRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTemplatesRequest) ProtoMessage() {}
+
+func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{67}
+}
+
+type ListTemplatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	Templates     []*ChaincodeTemplate   `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryLedgerResponse) Reset() {
-	*x = QueryLedgerResponse{}
-	mi := &file_protos_fabricx_proto_msgTypes[7]
+func (x *ListTemplatesResponse) Reset() {
+	*x = ListTemplatesResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryLedgerResponse) String() string {
+func (x *ListTemplatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLedgerResponse) ProtoMessage() {}
+func (*ListTemplatesResponse) ProtoMessage() {}
 
-func (x *QueryLedgerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[7]
+func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,55 +5265,62 @@ func (x *QueryLedgerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryLedgerResponse.ProtoReflect.Descriptor instead.
-func (*QueryLedgerResponse) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *QueryLedgerResponse) GetSuccess() bool {
+func (x *ListTemplatesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *QueryLedgerResponse) GetMessage() string {
+func (x *ListTemplatesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *QueryLedgerResponse) GetPayload() []byte {
+func (x *ListTemplatesResponse) GetTemplates() []*ChaincodeTemplate {
 	if x != nil {
-		return x.Payload
+		return x.Templates
 	}
 	return nil
 }
 
-type StopNetworkRequest struct {
+type ChaincodeTemplate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	Cleanup       bool                   `protobuf:"varint,2,opt,name=cleanup,proto3" json:"cleanup,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Pass as template to ScaffoldChaincode
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Languages     []string               `protobuf:"bytes,4,rep,name=languages,proto3" json:"languages,omitempty"` // "go", "typescript"
+	Functions     []*TemplateFunction    `protobuf:"bytes,5,rep,name=functions,proto3" json:"functions,omitempty"`
+	Events        []*TemplateEvent       `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+	ModulePath    string                 `protobuf:"bytes,7,opt,name=module_path,json=modulePath,proto3" json:"module_path,omitempty"` // Defaults of ScaffoldChaincode
+	ContractName  string                 `protobuf:"bytes,8,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	PackageName   string                 `protobuf:"bytes,9,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopNetworkRequest) Reset() {
-	*x = StopNetworkRequest{}
-	mi := &file_protos_fabricx_proto_msgTypes[8]
+func (x *ChaincodeTemplate) Reset() {
+	*x = ChaincodeTemplate{}
+	mi := &file_protos_fabricx_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopNetworkRequest) String() string {
+func (x *ChaincodeTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopNetworkRequest) ProtoMessage() {}
+func (*ChaincodeTemplate) ProtoMessage() {}
 
-func (x *StopNetworkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[8]
+func (x *ChaincodeTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,48 +5331,99 @@ func (x *StopNetworkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopNetworkRequest.ProtoReflect.Descriptor instead.
-func (*StopNetworkRequest) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ChaincodeTemplate.ProtoReflect.Descriptor instead.
+func (*ChaincodeTemplate) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *StopNetworkRequest) GetNetworkId() string {
+func (x *ChaincodeTemplate) GetName() string {
 	if x != nil {
-		return x.NetworkId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StopNetworkRequest) GetCleanup() bool {
+func (x *ChaincodeTemplate) GetTitle() string {
 	if x != nil {
-		return x.Cleanup
+		return x.Title
 	}
-	return false
+	return ""
 }
 
-type StopNetworkResponse struct {
+func (x *ChaincodeTemplate) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ChaincodeTemplate) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *ChaincodeTemplate) GetFunctions() []*TemplateFunction {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+func (x *ChaincodeTemplate) GetEvents() []*TemplateEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ChaincodeTemplate) GetModulePath() string {
+	if x != nil {
+		return x.ModulePath
+	}
+	return ""
+}
+
+func (x *ChaincodeTemplate) GetContractName() string {
+	if x != nil {
+		return x.ContractName
+	}
+	return ""
+}
+
+func (x *ChaincodeTemplate) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+type TemplateFunction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Args          []string               `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Query         bool                   `protobuf:"varint,4,opt,name=query,proto3" json:"query,omitempty"` // Reads the ledger only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopNetworkResponse) Reset() {
-	*x = StopNetworkResponse{}
-	mi := &file_protos_fabricx_proto_msgTypes[9]
+func (x *TemplateFunction) Reset() {
+	*x = TemplateFunction{}
+	mi := &file_protos_fabricx_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopNetworkResponse) String() string {
+func (x *TemplateFunction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopNetworkResponse) ProtoMessage() {}
+func (*TemplateFunction) ProtoMessage() {}
 
-func (x *StopNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[9]
+func (x *TemplateFunction) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,47 +5434,118 @@ func (x *StopNetworkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopNetworkResponse.ProtoReflect.Descriptor instead.
-func (*StopNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use TemplateFunction.ProtoReflect.Descriptor instead.
+func (*TemplateFunction) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *StopNetworkResponse) GetSuccess() bool {
+func (x *TemplateFunction) GetName() string {
 	if x != nil {
-		return x.Success
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateFunction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TemplateFunction) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *TemplateFunction) GetQuery() bool {
+	if x != nil {
+		return x.Query
 	}
 	return false
 }
 
-func (x *StopNetworkResponse) GetMessage() string {
+type TemplateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateEvent) Reset() {
+	*x = TemplateEvent{}
+	mi := &file_protos_fabricx_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateEvent) ProtoMessage() {}
+
+func (x *TemplateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[71]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateEvent.ProtoReflect.Descriptor instead.
+func (*TemplateEvent) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *TemplateEvent) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type NetworkStatusRequest struct {
+func (x *TemplateEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ScaffoldChaincodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	Template      string                 `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`                             // "go" (default) or "typescript"
+	ModulePath    string                 `protobuf:"bytes,3,opt,name=module_path,json=modulePath,proto3" json:"module_path,omitempty"`       // Go module path
+	ContractName  string                 `protobuf:"bytes,4,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"` // Contract type or class name
+	PackageName   string                 `protobuf:"bytes,5,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`    // Go package of the contract, or npm package name
+	TargetDir     string                 `protobuf:"bytes,6,opt,name=target_dir,json=targetDir,proto3" json:"target_dir,omitempty"`          // Empty or missing folder on the runtime host; the files are returned as an archive when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkStatusRequest) Reset() {
-	*x = NetworkStatusRequest{}
-	mi := &file_protos_fabricx_proto_msgTypes[10]
+func (x *ScaffoldChaincodeRequest) Reset() {
+	*x = ScaffoldChaincodeRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkStatusRequest) String() string {
+func (x *ScaffoldChaincodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkStatusRequest) ProtoMessage() {}
+func (*ScaffoldChaincodeRequest) ProtoMessage() {}
 
-func (x *NetworkStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[10]
+func (x *ScaffoldChaincodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -711,43 +5556,83 @@ func (x *NetworkStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkStatusRequest.ProtoReflect.Descriptor instead.
-func (*NetworkStatusRequest) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ScaffoldChaincodeRequest.ProtoReflect.Descriptor instead.
+func (*ScaffoldChaincodeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *NetworkStatusRequest) GetNetworkId() string {
+func (x *ScaffoldChaincodeRequest) GetTemplate() string {
 	if x != nil {
-		return x.NetworkId
+		return x.Template
 	}
 	return ""
 }
 
-type NetworkStatusResponse struct {
+func (x *ScaffoldChaincodeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ScaffoldChaincodeRequest) GetModulePath() string {
+	if x != nil {
+		return x.ModulePath
+	}
+	return ""
+}
+
+func (x *ScaffoldChaincodeRequest) GetContractName() string {
+	if x != nil {
+		return x.ContractName
+	}
+	return ""
+}
+
+func (x *ScaffoldChaincodeRequest) GetPackageName() string {
+	if x != nil {
+		return x.PackageName
+	}
+	return ""
+}
+
+func (x *ScaffoldChaincodeRequest) GetTargetDir() string {
+	if x != nil {
+		return x.TargetDir
+	}
+	return ""
+}
+
+type ScaffoldChaincodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Running       bool                   `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Peers         []*PeerStatus          `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
-	Orderers      []*OrdererStatus       `protobuf:"bytes,4,rep,name=orderers,proto3" json:"orderers,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`       // Folder the chaincode was written to
+	Archive       []byte                 `protobuf:"bytes,4,opt,name=archive,proto3" json:"archive,omitempty"` // .tar.gz of the chaincode when no target_dir was given
+	Files         []string               `protobuf:"bytes,5,rep,name=files,proto3" json:"files,omitempty"`
+	Language      string                 `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	ModulePath    string                 `protobuf:"bytes,7,opt,name=module_path,json=modulePath,proto3" json:"module_path,omitempty"` // Names the template was rendered with
+	ContractName  string                 `protobuf:"bytes,8,opt,name=contract_name,json=contractName,proto3" json:"contract_name,omitempty"`
+	PackageName   string                 `protobuf:"bytes,9,opt,name=package_name,json=packageName,proto3" json:"package_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkStatusResponse) Reset() {
-	*x = NetworkStatusResponse{}
-	mi := &file_protos_fabricx_proto_msgTypes[11]
+func (x *ScaffoldChaincodeResponse) Reset() {
+	*x = ScaffoldChaincodeResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkStatusResponse) String() string {
+func (x *ScaffoldChaincodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkStatusResponse) ProtoMessage() {}
+func (*ScaffoldChaincodeResponse) ProtoMessage() {}
 
-func (x *NetworkStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[11]
+func (x *ScaffoldChaincodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,64 +5643,102 @@ func (x *NetworkStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkStatusResponse.ProtoReflect.Descriptor instead.
-func (*NetworkStatusResponse) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ScaffoldChaincodeResponse.ProtoReflect.Descriptor instead.
+func (*ScaffoldChaincodeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ScaffoldChaincodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ScaffoldChaincodeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ScaffoldChaincodeResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ScaffoldChaincodeResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ScaffoldChaincodeResponse) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
 }
 
-func (x *NetworkStatusResponse) GetRunning() bool {
+func (x *ScaffoldChaincodeResponse) GetLanguage() string {
 	if x != nil {
-		return x.Running
+		return x.Language
 	}
-	return false
+	return ""
 }
 
-func (x *NetworkStatusResponse) GetStatus() string {
+func (x *ScaffoldChaincodeResponse) GetModulePath() string {
 	if x != nil {
-		return x.Status
+		return x.ModulePath
 	}
 	return ""
 }
 
-func (x *NetworkStatusResponse) GetPeers() []*PeerStatus {
+func (x *ScaffoldChaincodeResponse) GetContractName() string {
 	if x != nil {
-		return x.Peers
+		return x.ContractName
 	}
-	return nil
+	return ""
 }
 
-func (x *NetworkStatusResponse) GetOrderers() []*OrdererStatus {
+func (x *ScaffoldChaincodeResponse) GetPackageName() string {
 	if x != nil {
-		return x.Orderers
+		return x.PackageName
 	}
-	return nil
+	return ""
 }
 
-type PeerStatus struct {
+type CheckDeterminismRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Org           string                 `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Endpoint      string                 `protobuf:"bytes,4,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	ChaincodeName string                 `protobuf:"bytes,2,opt,name=chaincode_name,json=chaincodeName,proto3" json:"chaincode_name,omitempty"` // Names the checkout folder of git_url
+	ChaincodePath string                 `protobuf:"bytes,3,opt,name=chaincode_path,json=chaincodePath,proto3" json:"chaincode_path,omitempty"` // Or upload_id or git_url, as for DeployChaincode
+	UploadId      string                 `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	GitUrl        string                 `protobuf:"bytes,5,opt,name=git_url,json=gitUrl,proto3" json:"git_url,omitempty"`
+	GitRef        string                 `protobuf:"bytes,6,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
+	Language      string                 `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"` // Detected when empty; only golang can be checked
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PeerStatus) Reset() {
-	*x = PeerStatus{}
-	mi := &file_protos_fabricx_proto_msgTypes[12]
+func (x *CheckDeterminismRequest) Reset() {
+	*x = CheckDeterminismRequest{}
+	mi := &file_protos_fabricx_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PeerStatus) String() string {
+func (x *CheckDeterminismRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeerStatus) ProtoMessage() {}
+func (*CheckDeterminismRequest) ProtoMessage() {}
 
-func (x *PeerStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[12]
+func (x *CheckDeterminismRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,63 +5749,86 @@ func (x *PeerStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeerStatus.ProtoReflect.Descriptor instead.
-func (*PeerStatus) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CheckDeterminismRequest.ProtoReflect.Descriptor instead.
+func (*CheckDeterminismRequest) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *PeerStatus) GetName() string {
+func (x *CheckDeterminismRequest) GetNetworkId() string {
 	if x != nil {
-		return x.Name
+		return x.NetworkId
 	}
 	return ""
 }
 
-func (x *PeerStatus) GetOrg() string {
+func (x *CheckDeterminismRequest) GetChaincodeName() string {
 	if x != nil {
-		return x.Org
+		return x.ChaincodeName
 	}
 	return ""
 }
 
-func (x *PeerStatus) GetStatus() string {
+func (x *CheckDeterminismRequest) GetChaincodePath() string {
 	if x != nil {
-		return x.Status
+		return x.ChaincodePath
 	}
 	return ""
 }
 
-func (x *PeerStatus) GetEndpoint() string {
+func (x *CheckDeterminismRequest) GetUploadId() string {
 	if x != nil {
-		return x.Endpoint
+		return x.UploadId
 	}
 	return ""
 }
 
-type OrdererStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+func (x *CheckDeterminismRequest) GetGitUrl() string {
+	if x != nil {
+		return x.GitUrl
+	}
+	return ""
+}
+
+func (x *CheckDeterminismRequest) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+func (x *CheckDeterminismRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type CheckDeterminismResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Success       bool                     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Diagnostics   []*DeterminismDiagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Errors        int32                    `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	Warnings      int32                    `protobuf:"varint,5,opt,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrdererStatus) Reset() {
-	*x = OrdererStatus{}
-	mi := &file_protos_fabricx_proto_msgTypes[13]
+func (x *CheckDeterminismResponse) Reset() {
+	*x = CheckDeterminismResponse{}
+	mi := &file_protos_fabricx_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrdererStatus) String() string {
+func (x *CheckDeterminismResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrdererStatus) ProtoMessage() {}
+func (*CheckDeterminismResponse) ProtoMessage() {}
 
-func (x *OrdererStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[13]
+func (x *CheckDeterminismResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,55 +5839,75 @@ func (x *OrdererStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrdererStatus.ProtoReflect.Descriptor instead.
-func (*OrdererStatus) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CheckDeterminismResponse.ProtoReflect.Descriptor instead.
+func (*CheckDeterminismResponse) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *OrdererStatus) GetName() string {
+func (x *CheckDeterminismResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Name
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *OrdererStatus) GetStatus() string {
+func (x *CheckDeterminismResponse) GetMessage() string {
 	if x != nil {
-		return x.Status
+		return x.Message
 	}
 	return ""
 }
 
-func (x *OrdererStatus) GetEndpoint() string {
+func (x *CheckDeterminismResponse) GetDiagnostics() []*DeterminismDiagnostic {
 	if x != nil {
-		return x.Endpoint
+		return x.Diagnostics
 	}
-	return ""
+	return nil
 }
 
-type StreamLogsRequest struct {
+func (x *CheckDeterminismResponse) GetErrors() int32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CheckDeterminismResponse) GetWarnings() int32 {
+	if x != nil {
+		return x.Warnings
+	}
+	return 0
+}
+
+// DeterminismDiagnostic is chaincode that endorsers can execute differently
+type DeterminismDiagnostic struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NetworkId     string                 `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
-	ContainerName string                 `protobuf:"bytes,2,opt,name=container_name,json=containerName,proto3" json:"container_name,omitempty"`
+	Check         string                 `protobuf:"bytes,1,opt,name=check,proto3" json:"check,omitempty"`       // time, random, io, map-iteration, global-state or goroutine
+	Severity      string                 `protobuf:"bytes,2,opt,name=severity,proto3" json:"severity,omitempty"` // "error" or "warning"
+	File          string                 `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`         // Relative to the chaincode folder
+	Line          int32                  `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	Column        int32                  `protobuf:"varint,5,opt,name=column,proto3" json:"column,omitempty"`
+	Function      string                 `protobuf:"bytes,6,opt,name=function,proto3" json:"function,omitempty"` // Transaction or helper the code is in
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamLogsRequest) Reset() {
-	*x = StreamLogsRequest{}
-	mi := &file_protos_fabricx_proto_msgTypes[14]
+func (x *DeterminismDiagnostic) Reset() {
+	*x = DeterminismDiagnostic{}
+	mi := &file_protos_fabricx_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamLogsRequest) String() string {
+func (x *DeterminismDiagnostic) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamLogsRequest) ProtoMessage() {}
+func (*DeterminismDiagnostic) ProtoMessage() {}
 
-func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[14]
+func (x *DeterminismDiagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_fabricx_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,79 +5918,54 @@ func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
-func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DeterminismDiagnostic.ProtoReflect.Descriptor instead.
+func (*DeterminismDiagnostic) Descriptor() ([]byte, []int) {
+	return file_protos_fabricx_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *StreamLogsRequest) GetNetworkId() string {
+func (x *DeterminismDiagnostic) GetCheck() string {
 	if x != nil {
-		return x.NetworkId
+		return x.Check
 	}
 	return ""
 }
 
-func (x *StreamLogsRequest) GetContainerName() string {
+func (x *DeterminismDiagnostic) GetSeverity() string {
 	if x != nil {
-		return x.ContainerName
+		return x.Severity
 	}
 	return ""
 }
 
-type LogMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Container     string                 `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *LogMessage) Reset() {
-	*x = LogMessage{}
-	mi := &file_protos_fabricx_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LogMessage) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LogMessage) ProtoMessage() {}
-
-func (x *LogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_fabricx_proto_msgTypes[15]
+func (x *DeterminismDiagnostic) GetFile() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.File
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
-func (*LogMessage) Descriptor() ([]byte, []int) {
-	return file_protos_fabricx_proto_rawDescGZIP(), []int{15}
+func (x *DeterminismDiagnostic) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
 }
 
-func (x *LogMessage) GetTimestamp() string {
+func (x *DeterminismDiagnostic) GetColumn() int32 {
 	if x != nil {
-		return x.Timestamp
+		return x.Column
 	}
-	return ""
+	return 0
 }
 
-func (x *LogMessage) GetContainer() string {
+func (x *DeterminismDiagnostic) GetFunction() string {
 	if x != nil {
-		return x.Container
+		return x.Function
 	}
 	return ""
 }
 
-func (x *LogMessage) GetMessage() string {
+func (x *DeterminismDiagnostic) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -1035,12 +5976,15 @@ var File_protos_fabricx_proto protoreflect.FileDescriptor
 
 const file_protos_fabricx_proto_rawDesc = "" +
 	"\n" +
-	"\x14protos/fabricx.proto\x12\afabricx\"\xf1\x01\n" +
+	"\x14protos/fabricx.proto\x12\afabricx\"\xde\x02\n" +
 	"\x12InitNetworkRequest\x12!\n" +
 	"\fnetwork_name\x18\x01 \x01(\tR\vnetworkName\x12\x19\n" +
 	"\bnum_orgs\x18\x02 \x01(\x05R\anumOrgs\x12!\n" +
 	"\fchannel_name\x18\x03 \x01(\tR\vchannelName\x12?\n" +
-	"\x06config\x18\x04 \x03(\v2'.fabricx.InitNetworkRequest.ConfigEntryR\x06config\x1a9\n" +
+	"\x06config\x18\x04 \x03(\v2'.fabricx.InitNetworkRequest.ConfigEntryR\x06config\x12\x1a\n" +
+	"\btopology\x18\x05 \x01(\tR\btopology\x12'\n" +
+	"\x0fcrypto_provider\x18\x06 \x01(\tR\x0ecryptoProvider\x12&\n" +
+	"\x0fseparate_tls_ca\x18\a \x01(\bR\rseparateTlsCa\x1a9\n" +
 	"\vConfigEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x86\x01\n" +
@@ -1049,7 +5993,7 @@ const file_protos_fabricx_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
 	"\n" +
 	"network_id\x18\x03 \x01(\tR\tnetworkId\x12\x1c\n" +
-	"\tendpoints\x18\x04 \x03(\tR\tendpoints\"\xf3\x01\n" +
+	"\tendpoints\x18\x04 \x03(\tR\tendpoints\"\xe3\x04\n" +
 	"\x16DeployChaincodeRequest\x12\x1d\n" +
 	"\n" +
 	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
@@ -1057,29 +6001,211 @@ const file_protos_fabricx_proto_rawDesc = "" +
 	"\x0echaincode_path\x18\x03 \x01(\tR\rchaincodePath\x12\x18\n" +
 	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1a\n" +
 	"\blanguage\x18\x05 \x01(\tR\blanguage\x126\n" +
-	"\x17endorsement_policy_orgs\x18\x06 \x03(\tR\x15endorsementPolicyOrgs\"p\n" +
+	"\x17endorsement_policy_orgs\x18\x06 \x03(\tR\x15endorsementPolicyOrgs\x12-\n" +
+	"\x12collections_config\x18\a \x01(\tR\x11collectionsConfig\x12-\n" +
+	"\x12endorsement_policy\x18\b \x01(\tR\x11endorsementPolicy\x12\x12\n" +
+	"\x04mode\x18\t \x01(\tR\x04mode\x12\x14\n" +
+	"\x05debug\x18\n" +
+	" \x01(\bR\x05debug\x12\x1d\n" +
+	"\n" +
+	"debug_port\x18\v \x01(\x05R\tdebugPort\x12\x14\n" +
+	"\x05stage\x18\f \x01(\bR\x05stage\x12\x16\n" +
+	"\x06vendor\x18\r \x01(\bR\x06vendor\x12\x1b\n" +
+	"\tupload_id\x18\x0e \x01(\tR\buploadId\x12\x17\n" +
+	"\agit_url\x18\x0f \x01(\tR\x06gitUrl\x12\x17\n" +
+	"\agit_ref\x18\x10 \x01(\tR\x06gitRef\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x11 \x01(\tR\tpackageId\x12+\n" +
+	"\x11determinism_check\x18\x12 \x01(\tR\x10determinismCheck\"\xa0\x02\n" +
 	"\x17DeployChaincodeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
-	"\fchaincode_id\x18\x03 \x01(\tR\vchaincodeId\"\xb7\x01\n" +
+	"\fchaincode_id\x18\x03 \x01(\tR\vchaincodeId\x12\x1d\n" +
+	"\n" +
+	"debug_port\x18\x04 \x01(\x05R\tdebugPort\x12\x16\n" +
+	"\x06staged\x18\x05 \x01(\bR\x06staged\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\a \x01(\tR\tpackageId\x12@\n" +
+	"\vdiagnostics\x18\b \x03(\v2\x1e.fabricx.DeterminismDiagnosticR\vdiagnostics\"\xe4\x04\n" +
+	"\x17UpgradeChaincodeRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12%\n" +
+	"\x0echaincode_path\x18\x03 \x01(\tR\rchaincodePath\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1a\n" +
+	"\blanguage\x18\x05 \x01(\tR\blanguage\x126\n" +
+	"\x17endorsement_policy_orgs\x18\x06 \x03(\tR\x15endorsementPolicyOrgs\x12-\n" +
+	"\x12collections_config\x18\a \x01(\tR\x11collectionsConfig\x12-\n" +
+	"\x12endorsement_policy\x18\b \x01(\tR\x11endorsementPolicy\x12\x12\n" +
+	"\x04mode\x18\t \x01(\tR\x04mode\x12\x14\n" +
+	"\x05debug\x18\n" +
+	" \x01(\bR\x05debug\x12\x1d\n" +
+	"\n" +
+	"debug_port\x18\v \x01(\x05R\tdebugPort\x12\x14\n" +
+	"\x05stage\x18\f \x01(\bR\x05stage\x12\x16\n" +
+	"\x06vendor\x18\r \x01(\bR\x06vendor\x12\x1b\n" +
+	"\tupload_id\x18\x0e \x01(\tR\buploadId\x12\x17\n" +
+	"\agit_url\x18\x0f \x01(\tR\x06gitUrl\x12\x17\n" +
+	"\agit_ref\x18\x10 \x01(\tR\x06gitRef\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x11 \x01(\tR\tpackageId\x12+\n" +
+	"\x11determinism_check\x18\x12 \x01(\tR\x10determinismCheck\"\xe9\x03\n" +
+	"\x18UpgradeChaincodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\fchaincode_id\x18\x03 \x01(\tR\vchaincodeId\x12\x1f\n" +
+	"\vold_version\x18\x04 \x01(\tR\n" +
+	"oldVersion\x12\x1f\n" +
+	"\vnew_version\x18\x05 \x01(\tR\n" +
+	"newVersion\x12!\n" +
+	"\fold_sequence\x18\x06 \x01(\x03R\voldSequence\x12!\n" +
+	"\fnew_sequence\x18\a \x01(\x03R\vnewSequence\x12 \n" +
+	"\vreinstalled\x18\b \x01(\bR\vreinstalled\x12\x1c\n" +
+	"\trestarted\x18\t \x01(\bR\trestarted\x12\x1d\n" +
+	"\n" +
+	"debug_port\x18\n" +
+	" \x01(\x05R\tdebugPort\x12\x16\n" +
+	"\x06staged\x18\v \x01(\bR\x06staged\x12\x16\n" +
+	"\x06reason\x18\f \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\r \x01(\tR\tpackageId\x12@\n" +
+	"\vdiagnostics\x18\x0e \x03(\v2\x1e.fabricx.DeterminismDiagnosticR\vdiagnostics\"\x9b\x04\n" +
 	"\x18InvokeTransactionRequest\x12\x1d\n" +
 	"\n" +
 	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
 	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12#\n" +
 	"\rfunction_name\x18\x03 \x01(\tR\ffunctionName\x12\x12\n" +
-	"\x04args\x18\x04 \x03(\tR\x04args\x12\x1c\n" +
-	"\ttransient\x18\x05 \x01(\bR\ttransient\"\x90\x01\n" +
+	"\x04args\x18\x04 \x03(\tR\x04args\x12\x10\n" +
+	"\x03org\x18\x06 \x01(\tR\x03org\x12\x1a\n" +
+	"\bidentity\x18\a \x01(\tR\bidentity\x12N\n" +
+	"\ttransient\x18\b \x03(\v20.fabricx.InvokeTransactionRequest.TransientEntryR\ttransient\x12\x1e\n" +
+	"\n" +
+	"collection\x18\t \x01(\tR\n" +
+	"collection\x12%\n" +
+	"\x0eendorsing_orgs\x18\n" +
+	" \x03(\tR\rendorsingOrgs\x12'\n" +
+	"\x0fendorsing_peers\x18\v \x03(\tR\x0eendorsingPeers\x12!\n" +
+	"\fauto_endorse\x18\f \x01(\bR\vautoEndorse\x12+\n" +
+	"\x11check_determinism\x18\r \x01(\bR\x10checkDeterminism\x1a<\n" +
+	"\x0eTransientEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01J\x04\b\x05\x10\x06\"\xec\x03\n" +
 	"\x19InvokeTransactionResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
 	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x12\x18\n" +
-	"\apayload\x18\x04 \x01(\fR\apayload\"\x93\x01\n" +
+	"\apayload\x18\x04 \x01(\fR\apayload\x12<\n" +
+	"\vdeterminism\x18\x05 \x01(\v2\x1a.fabricx.DeterminismReportR\vdeterminism\x12!\n" +
+	"\fblock_number\x18\x06 \x01(\x04R\vblockNumber\x12B\n" +
+	"\x0fvalidation_code\x18\a \x01(\x0e2\x19.fabricx.TxValidationCodeR\x0evalidationCode\x12'\n" +
+	"\x0fendorsing_peers\x18\b \x03(\tR\x0eendorsingPeers\x12)\n" +
+	"\x10chaincode_status\x18\t \x01(\x05R\x0fchaincodeStatus\x12+\n" +
+	"\x11chaincode_message\x18\n" +
+	" \x01(\tR\x10chaincodeMessage\x12\x16\n" +
+	"\x06reason\x18\v \x01(\tR\x06reason\x12\x1c\n" +
+	"\tretryable\x18\f \x01(\bR\tretryable\"\xa3\x01\n" +
+	"\x11DeterminismReport\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x01 \x01(\bR\n" +
+	"consistent\x127\n" +
+	"\tendorsers\x18\x02 \x03(\v2\x19.fabricx.EndorserResponseR\tendorsers\x125\n" +
+	"\vdivergences\x18\x03 \x03(\v2\x13.fabricx.DivergenceR\vdivergences\"\x81\x02\n" +
+	"\x10EndorserResponse\x12\x12\n" +
+	"\x04peer\x18\x01 \x01(\tR\x04peer\x12\x10\n" +
+	"\x03org\x18\x02 \x01(\tR\x03org\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\fR\apayload\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12-\n" +
+	"\x05event\x18\a \x01(\v2\x17.fabricx.ChaincodeEventR\x05event\x126\n" +
+	"\x06rwsets\x18\b \x03(\v2\x1e.fabricx.NamespaceReadWriteSetR\x06rwsets\"\xea\x03\n" +
+	"\x19EndorseTransactionRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12#\n" +
+	"\rfunction_name\x18\x03 \x01(\tR\ffunctionName\x12\x12\n" +
+	"\x04args\x18\x04 \x03(\tR\x04args\x12\x10\n" +
+	"\x03org\x18\x05 \x01(\tR\x03org\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\x12O\n" +
+	"\ttransient\x18\a \x03(\v21.fabricx.EndorseTransactionRequest.TransientEntryR\ttransient\x12\x1e\n" +
+	"\n" +
+	"collection\x18\b \x01(\tR\n" +
+	"collection\x12%\n" +
+	"\x0eendorsing_orgs\x18\t \x03(\tR\rendorsingOrgs\x12'\n" +
+	"\x0fendorsing_peers\x18\n" +
+	" \x03(\tR\x0eendorsingPeers\x12!\n" +
+	"\fauto_endorse\x18\v \x01(\bR\vautoEndorse\x1a<\n" +
+	"\x0eTransientEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xb0\x01\n" +
+	"\x1aEndorseTransactionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x127\n" +
+	"\tendorsers\x18\x04 \x03(\v2\x19.fabricx.EndorserResponseR\tendorsers\">\n" +
+	"\x0eChaincodeEvent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\fR\apayload\"\x85\x02\n" +
+	"\x15NamespaceReadWriteSet\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12&\n" +
+	"\x05reads\x18\x02 \x03(\v2\x10.fabricx.KeyReadR\x05reads\x12)\n" +
+	"\x06writes\x18\x03 \x03(\v2\x11.fabricx.KeyWriteR\x06writes\x128\n" +
+	"\rrange_queries\x18\x04 \x03(\v2\x13.fabricx.RangeQueryR\frangeQueries\x12A\n" +
+	"\vcollections\x18\x05 \x03(\v2\x1f.fabricx.CollectionReadWriteSetR\vcollections\"@\n" +
+	"\n" +
+	"KeyVersion\x12\x1b\n" +
+	"\tblock_num\x18\x01 \x01(\x04R\bblockNum\x12\x15\n" +
+	"\x06tx_num\x18\x02 \x01(\x04R\x05txNum\"J\n" +
+	"\aKeyRead\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12-\n" +
+	"\aversion\x18\x02 \x01(\v2\x13.fabricx.KeyVersionR\aversion\"O\n" +
+	"\bKeyWrite\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value\x12\x1b\n" +
+	"\tis_delete\x18\x03 \x01(\bR\bisDelete\"\xad\x01\n" +
+	"\n" +
+	"RangeQuery\x12\x1b\n" +
+	"\tstart_key\x18\x01 \x01(\tR\bstartKey\x12\x17\n" +
+	"\aend_key\x18\x02 \x01(\tR\x06endKey\x12\x1c\n" +
+	"\texhausted\x18\x03 \x01(\bR\texhausted\x12&\n" +
+	"\x05reads\x18\x04 \x03(\v2\x10.fabricx.KeyReadR\x05reads\x12#\n" +
+	"\rmerkle_hashes\x18\x05 \x03(\fR\fmerkleHashes\"\x97\x01\n" +
+	"\x16CollectionReadWriteSet\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\tR\n" +
+	"collection\x12,\n" +
+	"\x05reads\x18\x02 \x03(\v2\x16.fabricx.HashedKeyReadR\x05reads\x12/\n" +
+	"\x06writes\x18\x03 \x03(\v2\x17.fabricx.HashedKeyWriteR\x06writes\"Y\n" +
+	"\rHashedKeyRead\x12\x19\n" +
+	"\bkey_hash\x18\x01 \x01(\fR\akeyHash\x12-\n" +
+	"\aversion\x18\x02 \x01(\v2\x13.fabricx.KeyVersionR\aversion\"\x82\x01\n" +
+	"\x0eHashedKeyWrite\x12\x19\n" +
+	"\bkey_hash\x18\x01 \x01(\fR\akeyHash\x12\x1d\n" +
+	"\n" +
+	"value_hash\x18\x02 \x01(\fR\tvalueHash\x12\x1b\n" +
+	"\tis_delete\x18\x03 \x01(\bR\bisDelete\x12\x19\n" +
+	"\bis_purge\x18\x04 \x01(\bR\aisPurge\"\xe4\x01\n" +
+	"\n" +
+	"Divergence\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x1e\n" +
+	"\n" +
+	"collection\x18\x03 \x01(\tR\n" +
+	"collection\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\x127\n" +
+	"\x06values\x18\x05 \x03(\v2\x1f.fabricx.Divergence.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc1\x01\n" +
 	"\x12QueryLedgerRequest\x12\x1d\n" +
 	"\n" +
 	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
 	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12#\n" +
 	"\rfunction_name\x18\x03 \x01(\tR\ffunctionName\x12\x12\n" +
-	"\x04args\x18\x04 \x03(\tR\x04args\"c\n" +
+	"\x04args\x18\x04 \x03(\tR\x04args\x12\x10\n" +
+	"\x03org\x18\x05 \x01(\tR\x03org\x12\x1a\n" +
+	"\bidentity\x18\x06 \x01(\tR\bidentity\"c\n" +
 	"\x13QueryLedgerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
@@ -1117,16 +6243,364 @@ const file_protos_fabricx_proto_rawDesc = "" +
 	"LogMessage\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x1c\n" +
 	"\tcontainer\x18\x02 \x01(\tR\tcontainer\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage2\xb4\x04\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa4\x03\n" +
+	"\x15WatchChaincodeRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12%\n" +
+	"\x0echaincode_path\x18\x03 \x01(\tR\rchaincodePath\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x126\n" +
+	"\x17endorsement_policy_orgs\x18\x05 \x03(\tR\x15endorsementPolicyOrgs\x12-\n" +
+	"\x12endorsement_policy\x18\x06 \x01(\tR\x11endorsementPolicy\x12-\n" +
+	"\x12collections_config\x18\a \x01(\tR\x11collectionsConfig\x12\x12\n" +
+	"\x04mode\x18\b \x01(\tR\x04mode\x12\x1f\n" +
+	"\vdebounce_ms\x18\t \x01(\x03R\n" +
+	"debounceMs\x12\x1f\n" +
+	"\vskip_checks\x18\n" +
+	" \x01(\bR\n" +
+	"skipChecks\x12\x16\n" +
+	"\x06vendor\x18\v \x01(\bR\x06vendor\"\xc4\x01\n" +
+	"\n" +
+	"WatchEvent\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x16\n" +
+	"\x06output\x18\x04 \x01(\tR\x06output\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12\x1a\n" +
+	"\bsequence\x18\x06 \x01(\x03R\bsequence\x12\x1c\n" +
+	"\trestarted\x18\a \x01(\bR\trestarted\"N\n" +
+	"\x15ExportTopologyRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"\x80\x01\n" +
+	"\x16ExportTopologyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\btopology\x18\x03 \x01(\tR\btopology\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\"\xe6\x02\n" +
+	"\x17RegisterIdentityRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12\x10\n" +
+	"\x03org\x18\x02 \x01(\tR\x03org\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vaffiliation\x18\x06 \x01(\tR\vaffiliation\x12P\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v20.fabricx.RegisterIdentityRequest.AttributesEntryR\n" +
+	"attributes\x12'\n" +
+	"\x0fmax_enrollments\x18\b \x01(\x05R\x0emaxEnrollments\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x18RegisterIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"\x94\x01\n" +
+	"\x15EnrollIdentityRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12\x10\n" +
+	"\x03org\x18\x02 \x01(\tR\x03org\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\tR\n" +
+	"attributes\"g\n" +
+	"\x16EnrollIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\bmsp_path\x18\x03 \x01(\tR\amspPath\"t\n" +
+	"\x15RevokeIdentityRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12\x10\n" +
+	"\x03org\x18\x02 \x01(\tR\x03org\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"L\n" +
+	"\x16RevokeIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"H\n" +
+	"\x15ListIdentitiesRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12\x10\n" +
+	"\x03org\x18\x02 \x01(\tR\x03org\"\x83\x01\n" +
+	"\x16ListIdentitiesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\n" +
+	"identities\x18\x03 \x03(\v2\x15.fabricx.IdentityInfoR\n" +
+	"identities\"\x87\x02\n" +
+	"\fIdentityInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12 \n" +
+	"\vaffiliation\x18\x03 \x01(\tR\vaffiliation\x12'\n" +
+	"\x0fmax_enrollments\x18\x04 \x01(\x05R\x0emaxEnrollments\x12E\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2%.fabricx.IdentityInfo.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"]\n" +
+	"\x15GetCollectionsRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\"\x87\x01\n" +
+	"\x16GetCollectionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\vcollections\x18\x03 \x03(\v2\x17.fabricx.CollectionInfoR\vcollections\"\xea\x02\n" +
+	"\x0eCollectionInfo\x12%\n" +
+	"\x0echaincode_name\x18\x01 \x01(\tR\rchaincodeName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06policy\x18\x03 \x01(\tR\x06policy\x12\x1f\n" +
+	"\vmember_orgs\x18\x04 \x03(\tR\n" +
+	"memberOrgs\x12\x14\n" +
+	"\x05peers\x18\x05 \x03(\tR\x05peers\x12.\n" +
+	"\x13required_peer_count\x18\x06 \x01(\x05R\x11requiredPeerCount\x12$\n" +
+	"\x0emax_peer_count\x18\a \x01(\x05R\fmaxPeerCount\x12\"\n" +
+	"\rblock_to_live\x18\b \x01(\x04R\vblockToLive\x12(\n" +
+	"\x10member_only_read\x18\t \x01(\bR\x0ememberOnlyRead\x12*\n" +
+	"\x11member_only_write\x18\n" +
+	" \x01(\bR\x0fmemberOnlyWrite\"6\n" +
+	"\x15ListChaincodesRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\"\xc5\x01\n" +
+	"\x16ListChaincodesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
+	"\tinstalled\x18\x05 \x03(\v2\x15.fabricx.PeerPackagesR\tinstalled\x126\n" +
+	"\bchannels\x18\x06 \x03(\v2\x1a.fabricx.ChannelChaincodesR\bchannelsJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"i\n" +
+	"\x11ChannelChaincodes\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12:\n" +
+	"\tcommitted\x18\x02 \x03(\v2\x1c.fabricx.ChaincodeDefinitionR\tcommitted\"e\n" +
+	"\x1dGetChaincodeDefinitionRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\"\xd6\x01\n" +
+	"\x1eGetChaincodeDefinitionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
+	"\tinstalled\x18\x05 \x03(\v2\x15.fabricx.PeerPackagesR\tinstalled\x12?\n" +
+	"\bchannels\x18\x06 \x03(\v2#.fabricx.ChannelChaincodeDefinitionR\bchannelsJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05\"\xaa\x01\n" +
+	"\x1aChannelChaincodeDefinition\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12:\n" +
+	"\tcommitted\x18\x02 \x01(\v2\x1c.fabricx.ChaincodeDefinitionR\tcommitted\x126\n" +
+	"\apending\x18\x03 \x01(\v2\x1c.fabricx.ChaincodeDefinitionR\apending\"\xf7\x02\n" +
+	"\x13ChaincodeDefinition\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x03R\bsequence\x12-\n" +
+	"\x12endorsement_policy\x18\x04 \x01(\tR\x11endorsementPolicy\x129\n" +
+	"\vcollections\x18\x05 \x03(\v2\x17.fabricx.CollectionInfoR\vcollections\x12#\n" +
+	"\rinit_required\x18\x06 \x01(\bR\finitRequired\x12I\n" +
+	"\tapprovals\x18\a \x03(\v2+.fabricx.ChaincodeDefinition.ApprovalsEntryR\tapprovals\x1a<\n" +
+	"\x0eApprovalsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"k\n" +
+	"\fPeerPackages\x12\x10\n" +
+	"\x03org\x18\x01 \x01(\tR\x03org\x12\x12\n" +
+	"\x04peer\x18\x02 \x01(\tR\x04peer\x125\n" +
+	"\bpackages\x18\x03 \x03(\v2\x19.fabricx.InstalledPackageR\bpackages\"g\n" +
+	"\x10InstalledPackage\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tR\tpackageId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1e\n" +
+	"\n" +
+	"references\x18\x03 \x03(\tR\n" +
+	"references\"q\n" +
+	"\x17ApproveChaincodeRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12\x10\n" +
+	"\x03org\x18\x03 \x01(\tR\x03org\"N\n" +
+	"\x18ApproveChaincodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x88\x01\n" +
+	"\x16RejectChaincodeRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12\x10\n" +
+	"\x03org\x18\x03 \x01(\tR\x03org\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"M\n" +
+	"\x17RejectChaincodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"c\n" +
+	"\x1bCheckCommitReadinessRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\"\x91\x04\n" +
+	"\x1cCheckCommitReadinessResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x1a\n" +
+	"\bsequence\x18\x04 \x01(\x03R\bsequence\x12R\n" +
+	"\tapprovals\x18\x05 \x03(\v24.fabricx.CheckCommitReadinessResponse.ApprovalsEntryR\tapprovals\x12U\n" +
+	"\n" +
+	"rejections\x18\x06 \x03(\v25.fabricx.CheckCommitReadinessResponse.RejectionsEntryR\n" +
+	"rejections\x12\x1e\n" +
+	"\n" +
+	"mismatched\x18\a \x03(\tR\n" +
+	"mismatched\x12)\n" +
+	"\x10lifecycle_policy\x18\b \x01(\tR\x0flifecyclePolicy\x12\x14\n" +
+	"\x05ready\x18\t \x01(\bR\x05ready\x1a<\n" +
+	"\x0eApprovalsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1a=\n" +
+	"\x0fRejectionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"^\n" +
+	"\x16CommitChaincodeRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\"\xbe\x01\n" +
+	"\x17CommitChaincodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12!\n" +
+	"\fchaincode_id\x18\x03 \x01(\tR\vchaincodeId\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1a\n" +
+	"\bsequence\x18\x05 \x01(\x03R\bsequence\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"\x94\x01\n" +
+	"\x16UploadChaincodeRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x16\n" +
+	"\x06sha256\x18\x04 \x01(\tR\x06sha256\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\"\xe7\x01\n" +
+	"\x17UploadChaincodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\x05 \x01(\tR\x06sha256\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x06 \x01(\tR\tpackageId\x12\x14\n" +
+	"\x05label\x18\a \x01(\tR\x05label\x12\x1a\n" +
+	"\blanguage\x18\b \x01(\tR\blanguage\"\x16\n" +
+	"\x14ListTemplatesRequest\"\x85\x01\n" +
+	"\x15ListTemplatesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\ttemplates\x18\x03 \x03(\v2\x1a.fabricx.ChaincodeTemplateR\ttemplates\"\xcf\x02\n" +
+	"\x11ChaincodeTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tlanguages\x18\x04 \x03(\tR\tlanguages\x127\n" +
+	"\tfunctions\x18\x05 \x03(\v2\x19.fabricx.TemplateFunctionR\tfunctions\x12.\n" +
+	"\x06events\x18\x06 \x03(\v2\x16.fabricx.TemplateEventR\x06events\x12\x1f\n" +
+	"\vmodule_path\x18\a \x01(\tR\n" +
+	"modulePath\x12#\n" +
+	"\rcontract_name\x18\b \x01(\tR\fcontractName\x12!\n" +
+	"\fpackage_name\x18\t \x01(\tR\vpackageName\"r\n" +
+	"\x10TemplateFunction\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04args\x18\x03 \x03(\tR\x04args\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\bR\x05query\"E\n" +
+	"\rTemplateEvent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xda\x01\n" +
+	"\x18ScaffoldChaincodeRequest\x12\x1a\n" +
+	"\btemplate\x18\x01 \x01(\tR\btemplate\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1f\n" +
+	"\vmodule_path\x18\x03 \x01(\tR\n" +
+	"modulePath\x12#\n" +
+	"\rcontract_name\x18\x04 \x01(\tR\fcontractName\x12!\n" +
+	"\fpackage_name\x18\x05 \x01(\tR\vpackageName\x12\x1d\n" +
+	"\n" +
+	"target_dir\x18\x06 \x01(\tR\ttargetDir\"\x98\x02\n" +
+	"\x19ScaffoldChaincodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x18\n" +
+	"\aarchive\x18\x04 \x01(\fR\aarchive\x12\x14\n" +
+	"\x05files\x18\x05 \x03(\tR\x05files\x12\x1a\n" +
+	"\blanguage\x18\x06 \x01(\tR\blanguage\x12\x1f\n" +
+	"\vmodule_path\x18\a \x01(\tR\n" +
+	"modulePath\x12#\n" +
+	"\rcontract_name\x18\b \x01(\tR\fcontractName\x12!\n" +
+	"\fpackage_name\x18\t \x01(\tR\vpackageName\"\xf1\x01\n" +
+	"\x17CheckDeterminismRequest\x12\x1d\n" +
+	"\n" +
+	"network_id\x18\x01 \x01(\tR\tnetworkId\x12%\n" +
+	"\x0echaincode_name\x18\x02 \x01(\tR\rchaincodeName\x12%\n" +
+	"\x0echaincode_path\x18\x03 \x01(\tR\rchaincodePath\x12\x1b\n" +
+	"\tupload_id\x18\x04 \x01(\tR\buploadId\x12\x17\n" +
+	"\agit_url\x18\x05 \x01(\tR\x06gitUrl\x12\x17\n" +
+	"\agit_ref\x18\x06 \x01(\tR\x06gitRef\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\"\xc4\x01\n" +
+	"\x18CheckDeterminismResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12@\n" +
+	"\vdiagnostics\x18\x03 \x03(\v2\x1e.fabricx.DeterminismDiagnosticR\vdiagnostics\x12\x16\n" +
+	"\x06errors\x18\x04 \x01(\x05R\x06errors\x12\x1a\n" +
+	"\bwarnings\x18\x05 \x01(\x05R\bwarnings\"\xbf\x01\n" +
+	"\x15DeterminismDiagnostic\x12\x14\n" +
+	"\x05check\x18\x01 \x01(\tR\x05check\x12\x1a\n" +
+	"\bseverity\x18\x02 \x01(\tR\bseverity\x12\x12\n" +
+	"\x04file\x18\x03 \x01(\tR\x04file\x12\x12\n" +
+	"\x04line\x18\x04 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06column\x18\x05 \x01(\x05R\x06column\x12\x1a\n" +
+	"\bfunction\x18\x06 \x01(\tR\bfunction\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage*\xab\x05\n" +
+	"\x10TxValidationCode\x12\t\n" +
+	"\x05VALID\x10\x00\x12\x10\n" +
+	"\fNIL_ENVELOPE\x10\x01\x12\x0f\n" +
+	"\vBAD_PAYLOAD\x10\x02\x12\x15\n" +
+	"\x11BAD_COMMON_HEADER\x10\x03\x12\x19\n" +
+	"\x15BAD_CREATOR_SIGNATURE\x10\x04\x12 \n" +
+	"\x1cINVALID_ENDORSER_TRANSACTION\x10\x05\x12\x1e\n" +
+	"\x1aINVALID_CONFIG_TRANSACTION\x10\x06\x12\x1a\n" +
+	"\x16UNSUPPORTED_TX_PAYLOAD\x10\a\x12\x15\n" +
+	"\x11BAD_PROPOSAL_TXID\x10\b\x12\x12\n" +
+	"\x0eDUPLICATE_TXID\x10\t\x12\x1e\n" +
+	"\x1aENDORSEMENT_POLICY_FAILURE\x10\n" +
+	"\x12\x16\n" +
+	"\x12MVCC_READ_CONFLICT\x10\v\x12\x19\n" +
+	"\x15PHANTOM_READ_CONFLICT\x10\f\x12\x13\n" +
+	"\x0fUNKNOWN_TX_TYPE\x10\r\x12\x1a\n" +
+	"\x16TARGET_CHAIN_NOT_FOUND\x10\x0e\x12\x14\n" +
+	"\x10MARSHAL_TX_ERROR\x10\x0f\x12\x10\n" +
+	"\fNIL_TXACTION\x10\x10\x12\x15\n" +
+	"\x11EXPIRED_CHAINCODE\x10\x11\x12\x1e\n" +
+	"\x1aCHAINCODE_VERSION_CONFLICT\x10\x12\x12\x18\n" +
+	"\x14BAD_HEADER_EXTENSION\x10\x13\x12\x16\n" +
+	"\x12BAD_CHANNEL_HEADER\x10\x14\x12\x18\n" +
+	"\x14BAD_RESPONSE_PAYLOAD\x10\x15\x12\r\n" +
+	"\tBAD_RWSET\x10\x16\x12\x14\n" +
+	"\x10ILLEGAL_WRITESET\x10\x17\x12\x14\n" +
+	"\x10INVALID_WRITESET\x10\x18\x12\x15\n" +
+	"\x11INVALID_CHAINCODE\x10\x19\x12\x12\n" +
+	"\rNOT_VALIDATED\x10\xfe\x01\x12\x19\n" +
+	"\x14INVALID_OTHER_REASON\x10\xff\x012\xb2\x11\n" +
 	"\x0eFabricXService\x12H\n" +
 	"\vInitNetwork\x12\x1b.fabricx.InitNetworkRequest\x1a\x1c.fabricx.InitNetworkResponse\x12T\n" +
-	"\x0fDeployChaincode\x12\x1f.fabricx.DeployChaincodeRequest\x1a .fabricx.DeployChaincodeResponse\x12Z\n" +
+	"\x0fDeployChaincode\x12\x1f.fabricx.DeployChaincodeRequest\x1a .fabricx.DeployChaincodeResponse\x12W\n" +
+	"\x10UpgradeChaincode\x12 .fabricx.UpgradeChaincodeRequest\x1a!.fabricx.UpgradeChaincodeResponse\x12Z\n" +
 	"\x11InvokeTransaction\x12!.fabricx.InvokeTransactionRequest\x1a\".fabricx.InvokeTransactionResponse\x12H\n" +
 	"\vQueryLedger\x12\x1b.fabricx.QueryLedgerRequest\x1a\x1c.fabricx.QueryLedgerResponse\x12H\n" +
 	"\vStopNetwork\x12\x1b.fabricx.StopNetworkRequest\x1a\x1c.fabricx.StopNetworkResponse\x12Q\n" +
 	"\x10GetNetworkStatus\x12\x1d.fabricx.NetworkStatusRequest\x1a\x1e.fabricx.NetworkStatusResponse\x12?\n" +
 	"\n" +
-	"StreamLogs\x12\x1a.fabricx.StreamLogsRequest\x1a\x13.fabricx.LogMessage0\x01B,Z*github.com/temmyjay001/core/pkg/grpcserverb\x06proto3"
+	"StreamLogs\x12\x1a.fabricx.StreamLogsRequest\x1a\x13.fabricx.LogMessage0\x01\x12Q\n" +
+	"\x0eExportTopology\x12\x1e.fabricx.ExportTopologyRequest\x1a\x1f.fabricx.ExportTopologyResponse\x12W\n" +
+	"\x10RegisterIdentity\x12 .fabricx.RegisterIdentityRequest\x1a!.fabricx.RegisterIdentityResponse\x12Q\n" +
+	"\x0eEnrollIdentity\x12\x1e.fabricx.EnrollIdentityRequest\x1a\x1f.fabricx.EnrollIdentityResponse\x12Q\n" +
+	"\x0eRevokeIdentity\x12\x1e.fabricx.RevokeIdentityRequest\x1a\x1f.fabricx.RevokeIdentityResponse\x12Q\n" +
+	"\x0eListIdentities\x12\x1e.fabricx.ListIdentitiesRequest\x1a\x1f.fabricx.ListIdentitiesResponse\x12Q\n" +
+	"\x0eGetCollections\x12\x1e.fabricx.GetCollectionsRequest\x1a\x1f.fabricx.GetCollectionsResponse\x12G\n" +
+	"\x0eWatchChaincode\x12\x1e.fabricx.WatchChaincodeRequest\x1a\x13.fabricx.WatchEvent0\x01\x12Q\n" +
+	"\x0eListChaincodes\x12\x1e.fabricx.ListChaincodesRequest\x1a\x1f.fabricx.ListChaincodesResponse\x12i\n" +
+	"\x16GetChaincodeDefinition\x12&.fabricx.GetChaincodeDefinitionRequest\x1a'.fabricx.GetChaincodeDefinitionResponse\x12W\n" +
+	"\x10ApproveChaincode\x12 .fabricx.ApproveChaincodeRequest\x1a!.fabricx.ApproveChaincodeResponse\x12T\n" +
+	"\x0fRejectChaincode\x12\x1f.fabricx.RejectChaincodeRequest\x1a .fabricx.RejectChaincodeResponse\x12c\n" +
+	"\x14CheckCommitReadiness\x12$.fabricx.CheckCommitReadinessRequest\x1a%.fabricx.CheckCommitReadinessResponse\x12T\n" +
+	"\x0fCommitChaincode\x12\x1f.fabricx.CommitChaincodeRequest\x1a .fabricx.CommitChaincodeResponse\x12V\n" +
+	"\x0fUploadChaincode\x12\x1f.fabricx.UploadChaincodeRequest\x1a .fabricx.UploadChaincodeResponse(\x01\x12N\n" +
+	"\rListTemplates\x12\x1d.fabricx.ListTemplatesRequest\x1a\x1e.fabricx.ListTemplatesResponse\x12Z\n" +
+	"\x11ScaffoldChaincode\x12!.fabricx.ScaffoldChaincodeRequest\x1a\".fabricx.ScaffoldChaincodeResponse\x12W\n" +
+	"\x10CheckDeterminism\x12 .fabricx.CheckDeterminismRequest\x1a!.fabricx.CheckDeterminismResponse\x12]\n" +
+	"\x12EndorseTransaction\x12\".fabricx.EndorseTransactionRequest\x1a#.fabricx.EndorseTransactionResponseB,Z*github.com/temmyjay001/core/pkg/grpcserverb\x06proto3"
 
 var (
 	file_protos_fabricx_proto_rawDescOnce sync.Once
@@ -1140,49 +6614,199 @@ func file_protos_fabricx_proto_rawDescGZIP() []byte {
 	return file_protos_fabricx_proto_rawDescData
 }
 
-var file_protos_fabricx_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_protos_fabricx_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_protos_fabricx_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_protos_fabricx_proto_goTypes = []any{
-	(*InitNetworkRequest)(nil),        // 0: fabricx.InitNetworkRequest
-	(*InitNetworkResponse)(nil),       // 1: fabricx.InitNetworkResponse
-	(*DeployChaincodeRequest)(nil),    // 2: fabricx.DeployChaincodeRequest
-	(*DeployChaincodeResponse)(nil),   // 3: fabricx.DeployChaincodeResponse
-	(*InvokeTransactionRequest)(nil),  // 4: fabricx.InvokeTransactionRequest
-	(*InvokeTransactionResponse)(nil), // 5: fabricx.InvokeTransactionResponse
-	(*QueryLedgerRequest)(nil),        // 6: fabricx.QueryLedgerRequest
-	(*QueryLedgerResponse)(nil),       // 7: fabricx.QueryLedgerResponse
-	(*StopNetworkRequest)(nil),        // 8: fabricx.StopNetworkRequest
-	(*StopNetworkResponse)(nil),       // 9: fabricx.StopNetworkResponse
-	(*NetworkStatusRequest)(nil),      // 10: fabricx.NetworkStatusRequest
-	(*NetworkStatusResponse)(nil),     // 11: fabricx.NetworkStatusResponse
-	(*PeerStatus)(nil),                // 12: fabricx.PeerStatus
-	(*OrdererStatus)(nil),             // 13: fabricx.OrdererStatus
-	(*StreamLogsRequest)(nil),         // 14: fabricx.StreamLogsRequest
-	(*LogMessage)(nil),                // 15: fabricx.LogMessage
-	nil,                               // 16: fabricx.InitNetworkRequest.ConfigEntry
+	(TxValidationCode)(0),                  // 0: fabricx.TxValidationCode
+	(*InitNetworkRequest)(nil),             // 1: fabricx.InitNetworkRequest
+	(*InitNetworkResponse)(nil),            // 2: fabricx.InitNetworkResponse
+	(*DeployChaincodeRequest)(nil),         // 3: fabricx.DeployChaincodeRequest
+	(*DeployChaincodeResponse)(nil),        // 4: fabricx.DeployChaincodeResponse
+	(*UpgradeChaincodeRequest)(nil),        // 5: fabricx.UpgradeChaincodeRequest
+	(*UpgradeChaincodeResponse)(nil),       // 6: fabricx.UpgradeChaincodeResponse
+	(*InvokeTransactionRequest)(nil),       // 7: fabricx.InvokeTransactionRequest
+	(*InvokeTransactionResponse)(nil),      // 8: fabricx.InvokeTransactionResponse
+	(*DeterminismReport)(nil),              // 9: fabricx.DeterminismReport
+	(*EndorserResponse)(nil),               // 10: fabricx.EndorserResponse
+	(*EndorseTransactionRequest)(nil),      // 11: fabricx.EndorseTransactionRequest
+	(*EndorseTransactionResponse)(nil),     // 12: fabricx.EndorseTransactionResponse
+	(*ChaincodeEvent)(nil),                 // 13: fabricx.ChaincodeEvent
+	(*NamespaceReadWriteSet)(nil),          // 14: fabricx.NamespaceReadWriteSet
+	(*KeyVersion)(nil),                     // 15: fabricx.KeyVersion
+	(*KeyRead)(nil),                        // 16: fabricx.KeyRead
+	(*KeyWrite)(nil),                       // 17: fabricx.KeyWrite
+	(*RangeQuery)(nil),                     // 18: fabricx.RangeQuery
+	(*CollectionReadWriteSet)(nil),         // 19: fabricx.CollectionReadWriteSet
+	(*HashedKeyRead)(nil),                  // 20: fabricx.HashedKeyRead
+	(*HashedKeyWrite)(nil),                 // 21: fabricx.HashedKeyWrite
+	(*Divergence)(nil),                     // 22: fabricx.Divergence
+	(*QueryLedgerRequest)(nil),             // 23: fabricx.QueryLedgerRequest
+	(*QueryLedgerResponse)(nil),            // 24: fabricx.QueryLedgerResponse
+	(*StopNetworkRequest)(nil),             // 25: fabricx.StopNetworkRequest
+	(*StopNetworkResponse)(nil),            // 26: fabricx.StopNetworkResponse
+	(*NetworkStatusRequest)(nil),           // 27: fabricx.NetworkStatusRequest
+	(*NetworkStatusResponse)(nil),          // 28: fabricx.NetworkStatusResponse
+	(*PeerStatus)(nil),                     // 29: fabricx.PeerStatus
+	(*OrdererStatus)(nil),                  // 30: fabricx.OrdererStatus
+	(*StreamLogsRequest)(nil),              // 31: fabricx.StreamLogsRequest
+	(*LogMessage)(nil),                     // 32: fabricx.LogMessage
+	(*WatchChaincodeRequest)(nil),          // 33: fabricx.WatchChaincodeRequest
+	(*WatchEvent)(nil),                     // 34: fabricx.WatchEvent
+	(*ExportTopologyRequest)(nil),          // 35: fabricx.ExportTopologyRequest
+	(*ExportTopologyResponse)(nil),         // 36: fabricx.ExportTopologyResponse
+	(*RegisterIdentityRequest)(nil),        // 37: fabricx.RegisterIdentityRequest
+	(*RegisterIdentityResponse)(nil),       // 38: fabricx.RegisterIdentityResponse
+	(*EnrollIdentityRequest)(nil),          // 39: fabricx.EnrollIdentityRequest
+	(*EnrollIdentityResponse)(nil),         // 40: fabricx.EnrollIdentityResponse
+	(*RevokeIdentityRequest)(nil),          // 41: fabricx.RevokeIdentityRequest
+	(*RevokeIdentityResponse)(nil),         // 42: fabricx.RevokeIdentityResponse
+	(*ListIdentitiesRequest)(nil),          // 43: fabricx.ListIdentitiesRequest
+	(*ListIdentitiesResponse)(nil),         // 44: fabricx.ListIdentitiesResponse
+	(*IdentityInfo)(nil),                   // 45: fabricx.IdentityInfo
+	(*GetCollectionsRequest)(nil),          // 46: fabricx.GetCollectionsRequest
+	(*GetCollectionsResponse)(nil),         // 47: fabricx.GetCollectionsResponse
+	(*CollectionInfo)(nil),                 // 48: fabricx.CollectionInfo
+	(*ListChaincodesRequest)(nil),          // 49: fabricx.ListChaincodesRequest
+	(*ListChaincodesResponse)(nil),         // 50: fabricx.ListChaincodesResponse
+	(*ChannelChaincodes)(nil),              // 51: fabricx.ChannelChaincodes
+	(*GetChaincodeDefinitionRequest)(nil),  // 52: fabricx.GetChaincodeDefinitionRequest
+	(*GetChaincodeDefinitionResponse)(nil), // 53: fabricx.GetChaincodeDefinitionResponse
+	(*ChannelChaincodeDefinition)(nil),     // 54: fabricx.ChannelChaincodeDefinition
+	(*ChaincodeDefinition)(nil),            // 55: fabricx.ChaincodeDefinition
+	(*PeerPackages)(nil),                   // 56: fabricx.PeerPackages
+	(*InstalledPackage)(nil),               // 57: fabricx.InstalledPackage
+	(*ApproveChaincodeRequest)(nil),        // 58: fabricx.ApproveChaincodeRequest
+	(*ApproveChaincodeResponse)(nil),       // 59: fabricx.ApproveChaincodeResponse
+	(*RejectChaincodeRequest)(nil),         // 60: fabricx.RejectChaincodeRequest
+	(*RejectChaincodeResponse)(nil),        // 61: fabricx.RejectChaincodeResponse
+	(*CheckCommitReadinessRequest)(nil),    // 62: fabricx.CheckCommitReadinessRequest
+	(*CheckCommitReadinessResponse)(nil),   // 63: fabricx.CheckCommitReadinessResponse
+	(*CommitChaincodeRequest)(nil),         // 64: fabricx.CommitChaincodeRequest
+	(*CommitChaincodeResponse)(nil),        // 65: fabricx.CommitChaincodeResponse
+	(*UploadChaincodeRequest)(nil),         // 66: fabricx.UploadChaincodeRequest
+	(*UploadChaincodeResponse)(nil),        // 67: fabricx.UploadChaincodeResponse
+	(*ListTemplatesRequest)(nil),           // 68: fabricx.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),          // 69: fabricx.ListTemplatesResponse
+	(*ChaincodeTemplate)(nil),              // 70: fabricx.ChaincodeTemplate
+	(*TemplateFunction)(nil),               // 71: fabricx.TemplateFunction
+	(*TemplateEvent)(nil),                  // 72: fabricx.TemplateEvent
+	(*ScaffoldChaincodeRequest)(nil),       // 73: fabricx.ScaffoldChaincodeRequest
+	(*ScaffoldChaincodeResponse)(nil),      // 74: fabricx.ScaffoldChaincodeResponse
+	(*CheckDeterminismRequest)(nil),        // 75: fabricx.CheckDeterminismRequest
+	(*CheckDeterminismResponse)(nil),       // 76: fabricx.CheckDeterminismResponse
+	(*DeterminismDiagnostic)(nil),          // 77: fabricx.DeterminismDiagnostic
+	nil,                                    // 78: fabricx.InitNetworkRequest.ConfigEntry
+	nil,                                    // 79: fabricx.InvokeTransactionRequest.TransientEntry
+	nil,                                    // 80: fabricx.EndorseTransactionRequest.TransientEntry
+	nil,                                    // 81: fabricx.Divergence.ValuesEntry
+	nil,                                    // 82: fabricx.RegisterIdentityRequest.AttributesEntry
+	nil,                                    // 83: fabricx.IdentityInfo.AttributesEntry
+	nil,                                    // 84: fabricx.ChaincodeDefinition.ApprovalsEntry
+	nil,                                    // 85: fabricx.CheckCommitReadinessResponse.ApprovalsEntry
+	nil,                                    // 86: fabricx.CheckCommitReadinessResponse.RejectionsEntry
 }
 var file_protos_fabricx_proto_depIdxs = []int32{
-	16, // 0: fabricx.InitNetworkRequest.config:type_name -> fabricx.InitNetworkRequest.ConfigEntry
-	12, // 1: fabricx.NetworkStatusResponse.peers:type_name -> fabricx.PeerStatus
-	13, // 2: fabricx.NetworkStatusResponse.orderers:type_name -> fabricx.OrdererStatus
-	0,  // 3: fabricx.FabricXService.InitNetwork:input_type -> fabricx.InitNetworkRequest
-	2,  // 4: fabricx.FabricXService.DeployChaincode:input_type -> fabricx.DeployChaincodeRequest
-	4,  // 5: fabricx.FabricXService.InvokeTransaction:input_type -> fabricx.InvokeTransactionRequest
-	6,  // 6: fabricx.FabricXService.QueryLedger:input_type -> fabricx.QueryLedgerRequest
-	8,  // 7: fabricx.FabricXService.StopNetwork:input_type -> fabricx.StopNetworkRequest
-	10, // 8: fabricx.FabricXService.GetNetworkStatus:input_type -> fabricx.NetworkStatusRequest
-	14, // 9: fabricx.FabricXService.StreamLogs:input_type -> fabricx.StreamLogsRequest
-	1,  // 10: fabricx.FabricXService.InitNetwork:output_type -> fabricx.InitNetworkResponse
-	3,  // 11: fabricx.FabricXService.DeployChaincode:output_type -> fabricx.DeployChaincodeResponse
-	5,  // 12: fabricx.FabricXService.InvokeTransaction:output_type -> fabricx.InvokeTransactionResponse
-	7,  // 13: fabricx.FabricXService.QueryLedger:output_type -> fabricx.QueryLedgerResponse
-	9,  // 14: fabricx.FabricXService.StopNetwork:output_type -> fabricx.StopNetworkResponse
-	11, // 15: fabricx.FabricXService.GetNetworkStatus:output_type -> fabricx.NetworkStatusResponse
-	15, // 16: fabricx.FabricXService.StreamLogs:output_type -> fabricx.LogMessage
-	10, // [10:17] is the sub-list for method output_type
-	3,  // [3:10] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	78, // 0: fabricx.InitNetworkRequest.config:type_name -> fabricx.InitNetworkRequest.ConfigEntry
+	77, // 1: fabricx.DeployChaincodeResponse.diagnostics:type_name -> fabricx.DeterminismDiagnostic
+	77, // 2: fabricx.UpgradeChaincodeResponse.diagnostics:type_name -> fabricx.DeterminismDiagnostic
+	79, // 3: fabricx.InvokeTransactionRequest.transient:type_name -> fabricx.InvokeTransactionRequest.TransientEntry
+	9,  // 4: fabricx.InvokeTransactionResponse.determinism:type_name -> fabricx.DeterminismReport
+	0,  // 5: fabricx.InvokeTransactionResponse.validation_code:type_name -> fabricx.TxValidationCode
+	10, // 6: fabricx.DeterminismReport.endorsers:type_name -> fabricx.EndorserResponse
+	22, // 7: fabricx.DeterminismReport.divergences:type_name -> fabricx.Divergence
+	13, // 8: fabricx.EndorserResponse.event:type_name -> fabricx.ChaincodeEvent
+	14, // 9: fabricx.EndorserResponse.rwsets:type_name -> fabricx.NamespaceReadWriteSet
+	80, // 10: fabricx.EndorseTransactionRequest.transient:type_name -> fabricx.EndorseTransactionRequest.TransientEntry
+	10, // 11: fabricx.EndorseTransactionResponse.endorsers:type_name -> fabricx.EndorserResponse
+	16, // 12: fabricx.NamespaceReadWriteSet.reads:type_name -> fabricx.KeyRead
+	17, // 13: fabricx.NamespaceReadWriteSet.writes:type_name -> fabricx.KeyWrite
+	18, // 14: fabricx.NamespaceReadWriteSet.range_queries:type_name -> fabricx.RangeQuery
+	19, // 15: fabricx.NamespaceReadWriteSet.collections:type_name -> fabricx.CollectionReadWriteSet
+	15, // 16: fabricx.KeyRead.version:type_name -> fabricx.KeyVersion
+	16, // 17: fabricx.RangeQuery.reads:type_name -> fabricx.KeyRead
+	20, // 18: fabricx.CollectionReadWriteSet.reads:type_name -> fabricx.HashedKeyRead
+	21, // 19: fabricx.CollectionReadWriteSet.writes:type_name -> fabricx.HashedKeyWrite
+	15, // 20: fabricx.HashedKeyRead.version:type_name -> fabricx.KeyVersion
+	81, // 21: fabricx.Divergence.values:type_name -> fabricx.Divergence.ValuesEntry
+	29, // 22: fabricx.NetworkStatusResponse.peers:type_name -> fabricx.PeerStatus
+	30, // 23: fabricx.NetworkStatusResponse.orderers:type_name -> fabricx.OrdererStatus
+	82, // 24: fabricx.RegisterIdentityRequest.attributes:type_name -> fabricx.RegisterIdentityRequest.AttributesEntry
+	45, // 25: fabricx.ListIdentitiesResponse.identities:type_name -> fabricx.IdentityInfo
+	83, // 26: fabricx.IdentityInfo.attributes:type_name -> fabricx.IdentityInfo.AttributesEntry
+	48, // 27: fabricx.GetCollectionsResponse.collections:type_name -> fabricx.CollectionInfo
+	56, // 28: fabricx.ListChaincodesResponse.installed:type_name -> fabricx.PeerPackages
+	51, // 29: fabricx.ListChaincodesResponse.channels:type_name -> fabricx.ChannelChaincodes
+	55, // 30: fabricx.ChannelChaincodes.committed:type_name -> fabricx.ChaincodeDefinition
+	56, // 31: fabricx.GetChaincodeDefinitionResponse.installed:type_name -> fabricx.PeerPackages
+	54, // 32: fabricx.GetChaincodeDefinitionResponse.channels:type_name -> fabricx.ChannelChaincodeDefinition
+	55, // 33: fabricx.ChannelChaincodeDefinition.committed:type_name -> fabricx.ChaincodeDefinition
+	55, // 34: fabricx.ChannelChaincodeDefinition.pending:type_name -> fabricx.ChaincodeDefinition
+	48, // 35: fabricx.ChaincodeDefinition.collections:type_name -> fabricx.CollectionInfo
+	84, // 36: fabricx.ChaincodeDefinition.approvals:type_name -> fabricx.ChaincodeDefinition.ApprovalsEntry
+	57, // 37: fabricx.PeerPackages.packages:type_name -> fabricx.InstalledPackage
+	85, // 38: fabricx.CheckCommitReadinessResponse.approvals:type_name -> fabricx.CheckCommitReadinessResponse.ApprovalsEntry
+	86, // 39: fabricx.CheckCommitReadinessResponse.rejections:type_name -> fabricx.CheckCommitReadinessResponse.RejectionsEntry
+	70, // 40: fabricx.ListTemplatesResponse.templates:type_name -> fabricx.ChaincodeTemplate
+	71, // 41: fabricx.ChaincodeTemplate.functions:type_name -> fabricx.TemplateFunction
+	72, // 42: fabricx.ChaincodeTemplate.events:type_name -> fabricx.TemplateEvent
+	77, // 43: fabricx.CheckDeterminismResponse.diagnostics:type_name -> fabricx.DeterminismDiagnostic
+	1,  // 44: fabricx.FabricXService.InitNetwork:input_type -> fabricx.InitNetworkRequest
+	3,  // 45: fabricx.FabricXService.DeployChaincode:input_type -> fabricx.DeployChaincodeRequest
+	5,  // 46: fabricx.FabricXService.UpgradeChaincode:input_type -> fabricx.UpgradeChaincodeRequest
+	7,  // 47: fabricx.FabricXService.InvokeTransaction:input_type -> fabricx.InvokeTransactionRequest
+	23, // 48: fabricx.FabricXService.QueryLedger:input_type -> fabricx.QueryLedgerRequest
+	25, // 49: fabricx.FabricXService.StopNetwork:input_type -> fabricx.StopNetworkRequest
+	27, // 50: fabricx.FabricXService.GetNetworkStatus:input_type -> fabricx.NetworkStatusRequest
+	31, // 51: fabricx.FabricXService.StreamLogs:input_type -> fabricx.StreamLogsRequest
+	35, // 52: fabricx.FabricXService.ExportTopology:input_type -> fabricx.ExportTopologyRequest
+	37, // 53: fabricx.FabricXService.RegisterIdentity:input_type -> fabricx.RegisterIdentityRequest
+	39, // 54: fabricx.FabricXService.EnrollIdentity:input_type -> fabricx.EnrollIdentityRequest
+	41, // 55: fabricx.FabricXService.RevokeIdentity:input_type -> fabricx.RevokeIdentityRequest
+	43, // 56: fabricx.FabricXService.ListIdentities:input_type -> fabricx.ListIdentitiesRequest
+	46, // 57: fabricx.FabricXService.GetCollections:input_type -> fabricx.GetCollectionsRequest
+	33, // 58: fabricx.FabricXService.WatchChaincode:input_type -> fabricx.WatchChaincodeRequest
+	49, // 59: fabricx.FabricXService.ListChaincodes:input_type -> fabricx.ListChaincodesRequest
+	52, // 60: fabricx.FabricXService.GetChaincodeDefinition:input_type -> fabricx.GetChaincodeDefinitionRequest
+	58, // 61: fabricx.FabricXService.ApproveChaincode:input_type -> fabricx.ApproveChaincodeRequest
+	60, // 62: fabricx.FabricXService.RejectChaincode:input_type -> fabricx.RejectChaincodeRequest
+	62, // 63: fabricx.FabricXService.CheckCommitReadiness:input_type -> fabricx.CheckCommitReadinessRequest
+	64, // 64: fabricx.FabricXService.CommitChaincode:input_type -> fabricx.CommitChaincodeRequest
+	66, // 65: fabricx.FabricXService.UploadChaincode:input_type -> fabricx.UploadChaincodeRequest
+	68, // 66: fabricx.FabricXService.ListTemplates:input_type -> fabricx.ListTemplatesRequest
+	73, // 67: fabricx.FabricXService.ScaffoldChaincode:input_type -> fabricx.ScaffoldChaincodeRequest
+	75, // 68: fabricx.FabricXService.CheckDeterminism:input_type -> fabricx.CheckDeterminismRequest
+	11, // 69: fabricx.FabricXService.EndorseTransaction:input_type -> fabricx.EndorseTransactionRequest
+	2,  // 70: fabricx.FabricXService.InitNetwork:output_type -> fabricx.InitNetworkResponse
+	4,  // 71: fabricx.FabricXService.DeployChaincode:output_type -> fabricx.DeployChaincodeResponse
+	6,  // 72: fabricx.FabricXService.UpgradeChaincode:output_type -> fabricx.UpgradeChaincodeResponse
+	8,  // 73: fabricx.FabricXService.InvokeTransaction:output_type -> fabricx.InvokeTransactionResponse
+	24, // 74: fabricx.FabricXService.QueryLedger:output_type -> fabricx.QueryLedgerResponse
+	26, // 75: fabricx.FabricXService.StopNetwork:output_type -> fabricx.StopNetworkResponse
+	28, // 76: fabricx.FabricXService.GetNetworkStatus:output_type -> fabricx.NetworkStatusResponse
+	32, // 77: fabricx.FabricXService.StreamLogs:output_type -> fabricx.LogMessage
+	36, // 78: fabricx.FabricXService.ExportTopology:output_type -> fabricx.ExportTopologyResponse
+	38, // 79: fabricx.FabricXService.RegisterIdentity:output_type -> fabricx.RegisterIdentityResponse
+	40, // 80: fabricx.FabricXService.EnrollIdentity:output_type -> fabricx.EnrollIdentityResponse
+	42, // 81: fabricx.FabricXService.RevokeIdentity:output_type -> fabricx.RevokeIdentityResponse
+	44, // 82: fabricx.FabricXService.ListIdentities:output_type -> fabricx.ListIdentitiesResponse
+	47, // 83: fabricx.FabricXService.GetCollections:output_type -> fabricx.GetCollectionsResponse
+	34, // 84: fabricx.FabricXService.WatchChaincode:output_type -> fabricx.WatchEvent
+	50, // 85: fabricx.FabricXService.ListChaincodes:output_type -> fabricx.ListChaincodesResponse
+	53, // 86: fabricx.FabricXService.GetChaincodeDefinition:output_type -> fabricx.GetChaincodeDefinitionResponse
+	59, // 87: fabricx.FabricXService.ApproveChaincode:output_type -> fabricx.ApproveChaincodeResponse
+	61, // 88: fabricx.FabricXService.RejectChaincode:output_type -> fabricx.RejectChaincodeResponse
+	63, // 89: fabricx.FabricXService.CheckCommitReadiness:output_type -> fabricx.CheckCommitReadinessResponse
+	65, // 90: fabricx.FabricXService.CommitChaincode:output_type -> fabricx.CommitChaincodeResponse
+	67, // 91: fabricx.FabricXService.UploadChaincode:output_type -> fabricx.UploadChaincodeResponse
+	69, // 92: fabricx.FabricXService.ListTemplates:output_type -> fabricx.ListTemplatesResponse
+	74, // 93: fabricx.FabricXService.ScaffoldChaincode:output_type -> fabricx.ScaffoldChaincodeResponse
+	76, // 94: fabricx.FabricXService.CheckDeterminism:output_type -> fabricx.CheckDeterminismResponse
+	12, // 95: fabricx.FabricXService.EndorseTransaction:output_type -> fabricx.EndorseTransactionResponse
+	70, // [70:96] is the sub-list for method output_type
+	44, // [44:70] is the sub-list for method input_type
+	44, // [44:44] is the sub-list for extension type_name
+	44, // [44:44] is the sub-list for extension extendee
+	0,  // [0:44] is the sub-list for field type_name
 }
 
 func init() { file_protos_fabricx_proto_init() }
@@ -1195,13 +6819,14 @@ func file_protos_fabricx_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_fabricx_proto_rawDesc), len(file_protos_fabricx_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      1,
+			NumMessages:   86,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_protos_fabricx_proto_goTypes,
 		DependencyIndexes: file_protos_fabricx_proto_depIdxs,
+		EnumInfos:         file_protos_fabricx_proto_enumTypes,
 		MessageInfos:      file_protos_fabricx_proto_msgTypes,
 	}.Build()
 	File_protos_fabricx_proto = out.File
@@ -21,13 +21,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	FabricXService_InitNetwork_FullMethodName       = "/fabricx.FabricXService/InitNetwork"
-	FabricXService_DeployChaincode_FullMethodName   = "/fabricx.FabricXService/DeployChaincode"
-	FabricXService_InvokeTransaction_FullMethodName = "/fabricx.FabricXService/InvokeTransaction"
-	FabricXService_QueryLedger_FullMethodName       = "/fabricx.FabricXService/QueryLedger"
-	FabricXService_StopNetwork_FullMethodName       = "/fabricx.FabricXService/StopNetwork"
-	FabricXService_GetNetworkStatus_FullMethodName  = "/fabricx.FabricXService/GetNetworkStatus"
-	FabricXService_StreamLogs_FullMethodName        = "/fabricx.FabricXService/StreamLogs"
+	FabricXService_InitNetwork_FullMethodName            = "/fabricx.FabricXService/InitNetwork"
+	FabricXService_DeployChaincode_FullMethodName        = "/fabricx.FabricXService/DeployChaincode"
+	FabricXService_UpgradeChaincode_FullMethodName       = "/fabricx.FabricXService/UpgradeChaincode"
+	FabricXService_InvokeTransaction_FullMethodName      = "/fabricx.FabricXService/InvokeTransaction"
+	FabricXService_QueryLedger_FullMethodName            = "/fabricx.FabricXService/QueryLedger"
+	FabricXService_StopNetwork_FullMethodName            = "/fabricx.FabricXService/StopNetwork"
+	FabricXService_GetNetworkStatus_FullMethodName       = "/fabricx.FabricXService/GetNetworkStatus"
+	FabricXService_StreamLogs_FullMethodName             = "/fabricx.FabricXService/StreamLogs"
+	FabricXService_ExportTopology_FullMethodName         = "/fabricx.FabricXService/ExportTopology"
+	FabricXService_RegisterIdentity_FullMethodName       = "/fabricx.FabricXService/RegisterIdentity"
+	FabricXService_EnrollIdentity_FullMethodName         = "/fabricx.FabricXService/EnrollIdentity"
+	FabricXService_RevokeIdentity_FullMethodName         = "/fabricx.FabricXService/RevokeIdentity"
+	FabricXService_ListIdentities_FullMethodName         = "/fabricx.FabricXService/ListIdentities"
+	FabricXService_GetCollections_FullMethodName         = "/fabricx.FabricXService/GetCollections"
+	FabricXService_WatchChaincode_FullMethodName         = "/fabricx.FabricXService/WatchChaincode"
+	FabricXService_ListChaincodes_FullMethodName         = "/fabricx.FabricXService/ListChaincodes"
+	FabricXService_GetChaincodeDefinition_FullMethodName = "/fabricx.FabricXService/GetChaincodeDefinition"
+	FabricXService_ApproveChaincode_FullMethodName       = "/fabricx.FabricXService/ApproveChaincode"
+	FabricXService_RejectChaincode_FullMethodName        = "/fabricx.FabricXService/RejectChaincode"
+	FabricXService_CheckCommitReadiness_FullMethodName   = "/fabricx.FabricXService/CheckCommitReadiness"
+	FabricXService_CommitChaincode_FullMethodName        = "/fabricx.FabricXService/CommitChaincode"
+	FabricXService_UploadChaincode_FullMethodName        = "/fabricx.FabricXService/UploadChaincode"
+	FabricXService_ListTemplates_FullMethodName          = "/fabricx.FabricXService/ListTemplates"
+	FabricXService_ScaffoldChaincode_FullMethodName      = "/fabricx.FabricXService/ScaffoldChaincode"
+	FabricXService_CheckDeterminism_FullMethodName       = "/fabricx.FabricXService/CheckDeterminism"
+	FabricXService_EndorseTransaction_FullMethodName     = "/fabricx.FabricXService/EndorseTransaction"
 )
 
 // FabricXServiceClient is the client API for FabricXService service.
@@ -36,11 +55,30 @@ const (
 type FabricXServiceClient interface {
 	InitNetwork(ctx context.Context, in *InitNetworkRequest, opts ...grpc.CallOption) (*InitNetworkResponse, error)
 	DeployChaincode(ctx context.Context, in *DeployChaincodeRequest, opts ...grpc.CallOption) (*DeployChaincodeResponse, error)
+	UpgradeChaincode(ctx context.Context, in *UpgradeChaincodeRequest, opts ...grpc.CallOption) (*UpgradeChaincodeResponse, error)
 	InvokeTransaction(ctx context.Context, in *InvokeTransactionRequest, opts ...grpc.CallOption) (*InvokeTransactionResponse, error)
 	QueryLedger(ctx context.Context, in *QueryLedgerRequest, opts ...grpc.CallOption) (*QueryLedgerResponse, error)
 	StopNetwork(ctx context.Context, in *StopNetworkRequest, opts ...grpc.CallOption) (*StopNetworkResponse, error)
 	GetNetworkStatus(ctx context.Context, in *NetworkStatusRequest, opts ...grpc.CallOption) (*NetworkStatusResponse, error)
 	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogMessage], error)
+	ExportTopology(ctx context.Context, in *ExportTopologyRequest, opts ...grpc.CallOption) (*ExportTopologyResponse, error)
+	RegisterIdentity(ctx context.Context, in *RegisterIdentityRequest, opts ...grpc.CallOption) (*RegisterIdentityResponse, error)
+	EnrollIdentity(ctx context.Context, in *EnrollIdentityRequest, opts ...grpc.CallOption) (*EnrollIdentityResponse, error)
+	RevokeIdentity(ctx context.Context, in *RevokeIdentityRequest, opts ...grpc.CallOption) (*RevokeIdentityResponse, error)
+	ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error)
+	GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error)
+	WatchChaincode(ctx context.Context, in *WatchChaincodeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error)
+	ListChaincodes(ctx context.Context, in *ListChaincodesRequest, opts ...grpc.CallOption) (*ListChaincodesResponse, error)
+	GetChaincodeDefinition(ctx context.Context, in *GetChaincodeDefinitionRequest, opts ...grpc.CallOption) (*GetChaincodeDefinitionResponse, error)
+	ApproveChaincode(ctx context.Context, in *ApproveChaincodeRequest, opts ...grpc.CallOption) (*ApproveChaincodeResponse, error)
+	RejectChaincode(ctx context.Context, in *RejectChaincodeRequest, opts ...grpc.CallOption) (*RejectChaincodeResponse, error)
+	CheckCommitReadiness(ctx context.Context, in *CheckCommitReadinessRequest, opts ...grpc.CallOption) (*CheckCommitReadinessResponse, error)
+	CommitChaincode(ctx context.Context, in *CommitChaincodeRequest, opts ...grpc.CallOption) (*CommitChaincodeResponse, error)
+	UploadChaincode(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChaincodeRequest, UploadChaincodeResponse], error)
+	ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	ScaffoldChaincode(ctx context.Context, in *ScaffoldChaincodeRequest, opts ...grpc.CallOption) (*ScaffoldChaincodeResponse, error)
+	CheckDeterminism(ctx context.Context, in *CheckDeterminismRequest, opts ...grpc.CallOption) (*CheckDeterminismResponse, error)
+	EndorseTransaction(ctx context.Context, in *EndorseTransactionRequest, opts ...grpc.CallOption) (*EndorseTransactionResponse, error)
 }
 
 type fabricXServiceClient struct {
@@ -71,6 +109,16 @@ func (c *fabricXServiceClient) DeployChaincode(ctx context.Context, in *DeployCh
 	return out, nil
 }
 
+func (c *fabricXServiceClient) UpgradeChaincode(ctx context.Context, in *UpgradeChaincodeRequest, opts ...grpc.CallOption) (*UpgradeChaincodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpgradeChaincodeResponse)
+	err := c.cc.Invoke(ctx, FabricXService_UpgradeChaincode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fabricXServiceClient) InvokeTransaction(ctx context.Context, in *InvokeTransactionRequest, opts ...grpc.CallOption) (*InvokeTransactionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InvokeTransactionResponse)
@@ -130,17 +178,228 @@ func (c *fabricXServiceClient) StreamLogs(ctx context.Context, in *StreamLogsReq
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type FabricXService_StreamLogsClient = grpc.ServerStreamingClient[LogMessage]
 
+func (c *fabricXServiceClient) ExportTopology(ctx context.Context, in *ExportTopologyRequest, opts ...grpc.CallOption) (*ExportTopologyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportTopologyResponse)
+	err := c.cc.Invoke(ctx, FabricXService_ExportTopology_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) RegisterIdentity(ctx context.Context, in *RegisterIdentityRequest, opts ...grpc.CallOption) (*RegisterIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterIdentityResponse)
+	err := c.cc.Invoke(ctx, FabricXService_RegisterIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) EnrollIdentity(ctx context.Context, in *EnrollIdentityRequest, opts ...grpc.CallOption) (*EnrollIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollIdentityResponse)
+	err := c.cc.Invoke(ctx, FabricXService_EnrollIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) RevokeIdentity(ctx context.Context, in *RevokeIdentityRequest, opts ...grpc.CallOption) (*RevokeIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeIdentityResponse)
+	err := c.cc.Invoke(ctx, FabricXService_RevokeIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) ListIdentities(ctx context.Context, in *ListIdentitiesRequest, opts ...grpc.CallOption) (*ListIdentitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentitiesResponse)
+	err := c.cc.Invoke(ctx, FabricXService_ListIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) GetCollections(ctx context.Context, in *GetCollectionsRequest, opts ...grpc.CallOption) (*GetCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionsResponse)
+	err := c.cc.Invoke(ctx, FabricXService_GetCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) WatchChaincode(ctx context.Context, in *WatchChaincodeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FabricXService_ServiceDesc.Streams[1], FabricXService_WatchChaincode_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChaincodeRequest, WatchEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FabricXService_WatchChaincodeClient = grpc.ServerStreamingClient[WatchEvent]
+
+func (c *fabricXServiceClient) ListChaincodes(ctx context.Context, in *ListChaincodesRequest, opts ...grpc.CallOption) (*ListChaincodesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChaincodesResponse)
+	err := c.cc.Invoke(ctx, FabricXService_ListChaincodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) GetChaincodeDefinition(ctx context.Context, in *GetChaincodeDefinitionRequest, opts ...grpc.CallOption) (*GetChaincodeDefinitionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChaincodeDefinitionResponse)
+	err := c.cc.Invoke(ctx, FabricXService_GetChaincodeDefinition_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) ApproveChaincode(ctx context.Context, in *ApproveChaincodeRequest, opts ...grpc.CallOption) (*ApproveChaincodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveChaincodeResponse)
+	err := c.cc.Invoke(ctx, FabricXService_ApproveChaincode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) RejectChaincode(ctx context.Context, in *RejectChaincodeRequest, opts ...grpc.CallOption) (*RejectChaincodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectChaincodeResponse)
+	err := c.cc.Invoke(ctx, FabricXService_RejectChaincode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) CheckCommitReadiness(ctx context.Context, in *CheckCommitReadinessRequest, opts ...grpc.CallOption) (*CheckCommitReadinessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckCommitReadinessResponse)
+	err := c.cc.Invoke(ctx, FabricXService_CheckCommitReadiness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) CommitChaincode(ctx context.Context, in *CommitChaincodeRequest, opts ...grpc.CallOption) (*CommitChaincodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitChaincodeResponse)
+	err := c.cc.Invoke(ctx, FabricXService_CommitChaincode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) UploadChaincode(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadChaincodeRequest, UploadChaincodeResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FabricXService_ServiceDesc.Streams[2], FabricXService_UploadChaincode_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadChaincodeRequest, UploadChaincodeResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FabricXService_UploadChaincodeClient = grpc.ClientStreamingClient[UploadChaincodeRequest, UploadChaincodeResponse]
+
+func (c *fabricXServiceClient) ListTemplates(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, FabricXService_ListTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) ScaffoldChaincode(ctx context.Context, in *ScaffoldChaincodeRequest, opts ...grpc.CallOption) (*ScaffoldChaincodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaffoldChaincodeResponse)
+	err := c.cc.Invoke(ctx, FabricXService_ScaffoldChaincode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) CheckDeterminism(ctx context.Context, in *CheckDeterminismRequest, opts ...grpc.CallOption) (*CheckDeterminismResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDeterminismResponse)
+	err := c.cc.Invoke(ctx, FabricXService_CheckDeterminism_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fabricXServiceClient) EndorseTransaction(ctx context.Context, in *EndorseTransactionRequest, opts ...grpc.CallOption) (*EndorseTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EndorseTransactionResponse)
+	err := c.cc.Invoke(ctx, FabricXService_EndorseTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FabricXServiceServer is the server API for FabricXService service.
 // All implementations must embed UnimplementedFabricXServiceServer
 // for forward compatibility.
 type FabricXServiceServer interface {
 	InitNetwork(context.Context, *InitNetworkRequest) (*InitNetworkResponse, error)
 	DeployChaincode(context.Context, *DeployChaincodeRequest) (*DeployChaincodeResponse, error)
+	UpgradeChaincode(context.Context, *UpgradeChaincodeRequest) (*UpgradeChaincodeResponse, error)
 	InvokeTransaction(context.Context, *InvokeTransactionRequest) (*InvokeTransactionResponse, error)
 	QueryLedger(context.Context, *QueryLedgerRequest) (*QueryLedgerResponse, error)
 	StopNetwork(context.Context, *StopNetworkRequest) (*StopNetworkResponse, error)
 	GetNetworkStatus(context.Context, *NetworkStatusRequest) (*NetworkStatusResponse, error)
 	StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogMessage]) error
+	ExportTopology(context.Context, *ExportTopologyRequest) (*ExportTopologyResponse, error)
+	RegisterIdentity(context.Context, *RegisterIdentityRequest) (*RegisterIdentityResponse, error)
+	EnrollIdentity(context.Context, *EnrollIdentityRequest) (*EnrollIdentityResponse, error)
+	RevokeIdentity(context.Context, *RevokeIdentityRequest) (*RevokeIdentityResponse, error)
+	ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error)
+	GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error)
+	WatchChaincode(*WatchChaincodeRequest, grpc.ServerStreamingServer[WatchEvent]) error
+	ListChaincodes(context.Context, *ListChaincodesRequest) (*ListChaincodesResponse, error)
+	GetChaincodeDefinition(context.Context, *GetChaincodeDefinitionRequest) (*GetChaincodeDefinitionResponse, error)
+	ApproveChaincode(context.Context, *ApproveChaincodeRequest) (*ApproveChaincodeResponse, error)
+	RejectChaincode(context.Context, *RejectChaincodeRequest) (*RejectChaincodeResponse, error)
+	CheckCommitReadiness(context.Context, *CheckCommitReadinessRequest) (*CheckCommitReadinessResponse, error)
+	CommitChaincode(context.Context, *CommitChaincodeRequest) (*CommitChaincodeResponse, error)
+	UploadChaincode(grpc.ClientStreamingServer[UploadChaincodeRequest, UploadChaincodeResponse]) error
+	ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	ScaffoldChaincode(context.Context, *ScaffoldChaincodeRequest) (*ScaffoldChaincodeResponse, error)
+	CheckDeterminism(context.Context, *CheckDeterminismRequest) (*CheckDeterminismResponse, error)
+	EndorseTransaction(context.Context, *EndorseTransactionRequest) (*EndorseTransactionResponse, error)
 	mustEmbedUnimplementedFabricXServiceServer()
 }
 
@@ -157,6 +416,9 @@ func (UnimplementedFabricXServiceServer) InitNetwork(context.Context, *InitNetwo
 func (UnimplementedFabricXServiceServer) DeployChaincode(context.Context, *DeployChaincodeRequest) (*DeployChaincodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeployChaincode not implemented")
 }
+func (UnimplementedFabricXServiceServer) UpgradeChaincode(context.Context, *UpgradeChaincodeRequest) (*UpgradeChaincodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeChaincode not implemented")
+}
 func (UnimplementedFabricXServiceServer) InvokeTransaction(context.Context, *InvokeTransactionRequest) (*InvokeTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvokeTransaction not implemented")
 }
@@ -172,6 +434,60 @@ func (UnimplementedFabricXServiceServer) GetNetworkStatus(context.Context, *Netw
 func (UnimplementedFabricXServiceServer) StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogMessage]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
 }
+func (UnimplementedFabricXServiceServer) ExportTopology(context.Context, *ExportTopologyRequest) (*ExportTopologyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTopology not implemented")
+}
+func (UnimplementedFabricXServiceServer) RegisterIdentity(context.Context, *RegisterIdentityRequest) (*RegisterIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterIdentity not implemented")
+}
+func (UnimplementedFabricXServiceServer) EnrollIdentity(context.Context, *EnrollIdentityRequest) (*EnrollIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollIdentity not implemented")
+}
+func (UnimplementedFabricXServiceServer) RevokeIdentity(context.Context, *RevokeIdentityRequest) (*RevokeIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeIdentity not implemented")
+}
+func (UnimplementedFabricXServiceServer) ListIdentities(context.Context, *ListIdentitiesRequest) (*ListIdentitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentities not implemented")
+}
+func (UnimplementedFabricXServiceServer) GetCollections(context.Context, *GetCollectionsRequest) (*GetCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollections not implemented")
+}
+func (UnimplementedFabricXServiceServer) WatchChaincode(*WatchChaincodeRequest, grpc.ServerStreamingServer[WatchEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChaincode not implemented")
+}
+func (UnimplementedFabricXServiceServer) ListChaincodes(context.Context, *ListChaincodesRequest) (*ListChaincodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChaincodes not implemented")
+}
+func (UnimplementedFabricXServiceServer) GetChaincodeDefinition(context.Context, *GetChaincodeDefinitionRequest) (*GetChaincodeDefinitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChaincodeDefinition not implemented")
+}
+func (UnimplementedFabricXServiceServer) ApproveChaincode(context.Context, *ApproveChaincodeRequest) (*ApproveChaincodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveChaincode not implemented")
+}
+func (UnimplementedFabricXServiceServer) RejectChaincode(context.Context, *RejectChaincodeRequest) (*RejectChaincodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectChaincode not implemented")
+}
+func (UnimplementedFabricXServiceServer) CheckCommitReadiness(context.Context, *CheckCommitReadinessRequest) (*CheckCommitReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCommitReadiness not implemented")
+}
+func (UnimplementedFabricXServiceServer) CommitChaincode(context.Context, *CommitChaincodeRequest) (*CommitChaincodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitChaincode not implemented")
+}
+func (UnimplementedFabricXServiceServer) UploadChaincode(grpc.ClientStreamingServer[UploadChaincodeRequest, UploadChaincodeResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadChaincode not implemented")
+}
+func (UnimplementedFabricXServiceServer) ListTemplates(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedFabricXServiceServer) ScaffoldChaincode(context.Context, *ScaffoldChaincodeRequest) (*ScaffoldChaincodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScaffoldChaincode not implemented")
+}
+func (UnimplementedFabricXServiceServer) CheckDeterminism(context.Context, *CheckDeterminismRequest) (*CheckDeterminismResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDeterminism not implemented")
+}
+func (UnimplementedFabricXServiceServer) EndorseTransaction(context.Context, *EndorseTransactionRequest) (*EndorseTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndorseTransaction not implemented")
+}
 func (UnimplementedFabricXServiceServer) mustEmbedUnimplementedFabricXServiceServer() {}
 func (UnimplementedFabricXServiceServer) testEmbeddedByValue()                        {}
 
@@ -229,6 +545,24 @@ func _FabricXService_DeployChaincode_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FabricXService_UpgradeChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeChaincodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).UpgradeChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_UpgradeChaincode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).UpgradeChaincode(ctx, req.(*UpgradeChaincodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FabricXService_InvokeTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InvokeTransactionRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +646,312 @@ func _FabricXService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStrea
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type FabricXService_StreamLogsServer = grpc.ServerStreamingServer[LogMessage]
 
+func _FabricXService_ExportTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).ExportTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_ExportTopology_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).ExportTopology(ctx, req.(*ExportTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_RegisterIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).RegisterIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_RegisterIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).RegisterIdentity(ctx, req.(*RegisterIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_EnrollIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).EnrollIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_EnrollIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).EnrollIdentity(ctx, req.(*EnrollIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_RevokeIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).RevokeIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_RevokeIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).RevokeIdentity(ctx, req.(*RevokeIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_ListIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).ListIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_ListIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).ListIdentities(ctx, req.(*ListIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_GetCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).GetCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_GetCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).GetCollections(ctx, req.(*GetCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_WatchChaincode_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChaincodeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FabricXServiceServer).WatchChaincode(m, &grpc.GenericServerStream[WatchChaincodeRequest, WatchEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FabricXService_WatchChaincodeServer = grpc.ServerStreamingServer[WatchEvent]
+
+func _FabricXService_ListChaincodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChaincodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).ListChaincodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_ListChaincodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).ListChaincodes(ctx, req.(*ListChaincodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_GetChaincodeDefinition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChaincodeDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).GetChaincodeDefinition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_GetChaincodeDefinition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).GetChaincodeDefinition(ctx, req.(*GetChaincodeDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_ApproveChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveChaincodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).ApproveChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_ApproveChaincode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).ApproveChaincode(ctx, req.(*ApproveChaincodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_RejectChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectChaincodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).RejectChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_RejectChaincode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).RejectChaincode(ctx, req.(*RejectChaincodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_CheckCommitReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCommitReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).CheckCommitReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_CheckCommitReadiness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).CheckCommitReadiness(ctx, req.(*CheckCommitReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_CommitChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitChaincodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).CommitChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_CommitChaincode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).CommitChaincode(ctx, req.(*CommitChaincodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_UploadChaincode_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FabricXServiceServer).UploadChaincode(&grpc.GenericServerStream[UploadChaincodeRequest, UploadChaincodeResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FabricXService_UploadChaincodeServer = grpc.ClientStreamingServer[UploadChaincodeRequest, UploadChaincodeResponse]
+
+func _FabricXService_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).ListTemplates(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_ScaffoldChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaffoldChaincodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).ScaffoldChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_ScaffoldChaincode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).ScaffoldChaincode(ctx, req.(*ScaffoldChaincodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_CheckDeterminism_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDeterminismRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).CheckDeterminism(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_CheckDeterminism_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).CheckDeterminism(ctx, req.(*CheckDeterminismRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FabricXService_EndorseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndorseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FabricXServiceServer).EndorseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FabricXService_EndorseTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FabricXServiceServer).EndorseTransaction(ctx, req.(*EndorseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FabricXService_ServiceDesc is the grpc.ServiceDesc for FabricXService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -327,6 +967,10 @@ var FabricXService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeployChaincode",
 			Handler:    _FabricXService_DeployChaincode_Handler,
 		},
+		{
+			MethodName: "UpgradeChaincode",
+			Handler:    _FabricXService_UpgradeChaincode_Handler,
+		},
 		{
 			MethodName: "InvokeTransaction",
 			Handler:    _FabricXService_InvokeTransaction_Handler,
@@ -343,6 +987,70 @@ var FabricXService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetworkStatus",
 			Handler:    _FabricXService_GetNetworkStatus_Handler,
 		},
+		{
+			MethodName: "ExportTopology",
+			Handler:    _FabricXService_ExportTopology_Handler,
+		},
+		{
+			MethodName: "RegisterIdentity",
+			Handler:    _FabricXService_RegisterIdentity_Handler,
+		},
+		{
+			MethodName: "EnrollIdentity",
+			Handler:    _FabricXService_EnrollIdentity_Handler,
+		},
+		{
+			MethodName: "RevokeIdentity",
+			Handler:    _FabricXService_RevokeIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentities",
+			Handler:    _FabricXService_ListIdentities_Handler,
+		},
+		{
+			MethodName: "GetCollections",
+			Handler:    _FabricXService_GetCollections_Handler,
+		},
+		{
+			MethodName: "ListChaincodes",
+			Handler:    _FabricXService_ListChaincodes_Handler,
+		},
+		{
+			MethodName: "GetChaincodeDefinition",
+			Handler:    _FabricXService_GetChaincodeDefinition_Handler,
+		},
+		{
+			MethodName: "ApproveChaincode",
+			Handler:    _FabricXService_ApproveChaincode_Handler,
+		},
+		{
+			MethodName: "RejectChaincode",
+			Handler:    _FabricXService_RejectChaincode_Handler,
+		},
+		{
+			MethodName: "CheckCommitReadiness",
+			Handler:    _FabricXService_CheckCommitReadiness_Handler,
+		},
+		{
+			MethodName: "CommitChaincode",
+			Handler:    _FabricXService_CommitChaincode_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _FabricXService_ListTemplates_Handler,
+		},
+		{
+			MethodName: "ScaffoldChaincode",
+			Handler:    _FabricXService_ScaffoldChaincode_Handler,
+		},
+		{
+			MethodName: "CheckDeterminism",
+			Handler:    _FabricXService_CheckDeterminism_Handler,
+		},
+		{
+			MethodName: "EndorseTransaction",
+			Handler:    _FabricXService_EndorseTransaction_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -350,6 +1058,16 @@ var FabricXService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _FabricXService_StreamLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchChaincode",
+			Handler:       _FabricXService_WatchChaincode_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadChaincode",
+			Handler:       _FabricXService_UploadChaincode_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "protos/fabricx.proto",
 }
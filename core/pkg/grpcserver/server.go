@@ -2,16 +2,20 @@
 package grpcserver
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
 	"github.com/temmyjay001/core/pkg/chaincode"
+	"github.com/temmyjay001/core/pkg/determinism"
 	"github.com/temmyjay001/core/pkg/docker"
 	"github.com/temmyjay001/core/pkg/errors"
 	"github.com/temmyjay001/core/pkg/executor"
 	"github.com/temmyjay001/core/pkg/network"
+	"github.com/temmyjay001/core/pkg/templates"
 )
 
 type FabricXServer struct {
@@ -41,10 +45,24 @@ func (s *FabricXServer) InitNetwork(ctx context.Context, req *InitNetworkRequest
 
 	// Create network configuration
 	config := &network.Config{
-		NetworkName:  req.NetworkName,
-		NumOrgs:      int(req.NumOrgs),
-		ChannelName:  req.ChannelName,
-		CustomConfig: req.Config,
+		NetworkName:    req.NetworkName,
+		NumOrgs:        int(req.NumOrgs),
+		ChannelName:    req.ChannelName,
+		CustomConfig:   req.Config,
+		CryptoProvider: req.CryptoProvider,
+		SeparateTLSCA:  req.SeparateTlsCa,
+	}
+
+	// A topology document takes precedence over the org count and channel name
+	if req.Topology != "" {
+		topo, err := network.ParseTopology([]byte(req.Topology))
+		if err != nil {
+			return &InitNetworkResponse{
+				Success: false,
+				Message: fmt.Sprintf("Invalid topology: %v", err),
+			}, nil
+		}
+		config.Topology = topo
 	}
 
 	// Bootstrap the network with context
@@ -139,6 +157,18 @@ func (s *FabricXServer) DeployChaincode(ctx context.Context, req *DeployChaincod
 		Version:               req.Version,
 		Language:              req.Language,
 		EndorsementPolicyOrgs: req.EndorsementPolicyOrgs,
+		EndorsementPolicy:     req.EndorsementPolicy,
+		CollectionsConfig:     req.CollectionsConfig,
+		Mode:                  req.Mode,
+		Debug:                 req.Debug,
+		DebugPort:             int(req.DebugPort),
+		Stage:                 req.Stage,
+		Vendor:                req.Vendor,
+		UploadID:              req.UploadId,
+		GitURL:                req.GitUrl,
+		GitRef:                req.GitRef,
+		PackageID:             req.PackageId,
+		DeterminismCheck:      req.DeterminismCheck,
 	})
 
 	if err != nil {
@@ -151,15 +181,119 @@ func (s *FabricXServer) DeployChaincode(ctx context.Context, req *DeployChaincod
 		return &DeployChaincodeResponse{
 			Success: false,
 			Message: fmt.Sprintf("Failed to deploy chaincode: %v", err),
+			Reason:  deployFailureReason(err),
 		}, nil
 	}
 
+	if req.Stage {
+		log.Printf("Chaincode %s staged for approval", req.ChaincodeName)
+		resp := &DeployChaincodeResponse{
+			Success:     true,
+			Message:     "Chaincode definition staged for approval",
+			ChaincodeId: ccID,
+			Staged:      true,
+		}
+		if staged := net.StagedDefinition(req.ChaincodeName); staged != nil {
+			resp.Diagnostics = toDiagnostics(staged.Determinism)
+		}
+		return resp, nil
+	}
+
 	log.Printf("Chaincode %s deployed successfully (ID: %s)", req.ChaincodeName, ccID)
 
-	return &DeployChaincodeResponse{
+	resp := &DeployChaincodeResponse{
 		Success:     true,
 		Message:     "Chaincode deployed successfully",
 		ChaincodeId: ccID,
+	}
+	if cc := net.Chaincode(req.ChaincodeName); cc != nil {
+		resp.DebugPort = int32(cc.DebugPort)
+		resp.PackageId = cc.PackageID
+		resp.Diagnostics = toDiagnostics(cc.Determinism)
+	}
+	return resp, nil
+}
+
+func (s *FabricXServer) UpgradeChaincode(ctx context.Context, req *UpgradeChaincodeRequest) (*UpgradeChaincodeResponse, error) {
+	log.Printf("UpgradeChaincode called: %s on network %s", req.ChaincodeName, req.NetworkId)
+
+	// Check context
+	if err := ctx.Err(); err != nil {
+		return &UpgradeChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Context error: %v", err),
+		}, nil
+	}
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &UpgradeChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Network %s not found", req.NetworkId),
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+
+	result, err := deployer.Upgrade(ctx, &chaincode.DeployRequest{
+		Name:                  req.ChaincodeName,
+		Path:                  req.ChaincodePath,
+		Version:               req.Version,
+		Language:              req.Language,
+		EndorsementPolicyOrgs: req.EndorsementPolicyOrgs,
+		EndorsementPolicy:     req.EndorsementPolicy,
+		CollectionsConfig:     req.CollectionsConfig,
+		Mode:                  req.Mode,
+		Debug:                 req.Debug,
+		DebugPort:             int(req.DebugPort),
+		Stage:                 req.Stage,
+		Vendor:                req.Vendor,
+		UploadID:              req.UploadId,
+		GitURL:                req.GitUrl,
+		GitRef:                req.GitRef,
+		PackageID:             req.PackageId,
+		DeterminismCheck:      req.DeterminismCheck,
+	})
+	if err != nil {
+		if errors.IsTimeout(err) {
+			return &UpgradeChaincodeResponse{
+				Success: false,
+				Message: "Chaincode upgrade timed out",
+			}, nil
+		}
+		return &UpgradeChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to upgrade chaincode: %v", err),
+			Reason:  deployFailureReason(err),
+		}, nil
+	}
+
+	message := "Chaincode upgraded successfully"
+	if result.Staged {
+		message = "Chaincode definition staged for approval"
+		log.Printf("Chaincode %s version %s staged at sequence %d", req.ChaincodeName, result.Version, result.Sequence)
+	} else {
+		log.Printf("Chaincode %s upgraded to version %s, sequence %d", req.ChaincodeName, result.Version, result.Sequence)
+	}
+
+	return &UpgradeChaincodeResponse{
+		Success:     true,
+		Message:     message,
+		ChaincodeId: result.ChaincodeID,
+		OldVersion:  result.OldVersion,
+		NewVersion:  result.Version,
+		OldSequence: result.OldSequence,
+		NewSequence: result.Sequence,
+		Reinstalled: result.Reinstalled,
+		Restarted:   result.Restarted,
+		DebugPort:   int32(result.DebugPort),
+		Staged:      result.Staged,
+		PackageId:   result.PackageID,
+		Diagnostics: toDiagnostics(result.Diagnostics),
 	}, nil
 }
 
@@ -169,8 +303,9 @@ func (s *FabricXServer) InvokeTransaction(ctx context.Context, req *InvokeTransa
 	// Check context
 	if err := ctx.Err(); err != nil {
 		return &InvokeTransactionResponse{
-			Success: false,
-			Message: fmt.Sprintf("Context error: %v", err),
+			Success:        false,
+			Message:        fmt.Sprintf("Context error: %v", err),
+			ValidationCode: TxValidationCode_NOT_VALIDATED,
 		}, nil
 	}
 
@@ -181,37 +316,93 @@ func (s *FabricXServer) InvokeTransaction(ctx context.Context, req *InvokeTransa
 
 	if !exists {
 		return &InvokeTransactionResponse{
-			Success: false,
-			Message: fmt.Sprintf("Network %s not found", req.NetworkId),
+			Success:        false,
+			Message:        fmt.Sprintf("Network %s not found", req.NetworkId),
+			ValidationCode: TxValidationCode_NOT_VALIDATED,
 		}, nil
 	}
 
 	// Create transaction invoker
 	invoker := chaincode.NewInvoker(net, executor.NewRealExecutor())
+	invokeReq := &chaincode.InvokeRequest{
+		Chaincode:      req.ChaincodeName,
+		Function:       req.FunctionName,
+		Args:           req.Args,
+		Org:            req.Org,
+		Identity:       req.Identity,
+		Transient:      req.Transient,
+		Collection:     req.Collection,
+		EndorsingOrgs:  req.EndorsingOrgs,
+		EndorsingPeers: req.EndorsingPeers,
+		AutoEndorse:    req.AutoEndorse,
+	}
 
-	// Invoke transaction with context
-	txID, payload, err := invoker.Invoke(ctx, req.ChaincodeName, req.FunctionName, req.Args)
-	if err != nil {
-		if errors.IsTimeout(err) {
+	if req.CheckDeterminism {
+		report, err := invoker.CheckConsistency(ctx, invokeReq)
+		if err != nil {
 			return &InvokeTransactionResponse{
-				Success: false,
-				Message: "Transaction timed out",
+				Success:        false,
+				Message:        fmt.Sprintf("Determinism check failed: %v", err),
+				ValidationCode: TxValidationCode_NOT_VALIDATED,
 			}, nil
 		}
+
+		message := "Endorsers agree, the transaction was not submitted"
+		if !report.Consistent() {
+			message = fmt.Sprintf("Endorsers disagree on %d parts of the response, the transaction was not submitted", len(report.Divergences))
+		}
+		log.Printf("Determinism check of %s.%s: %s", req.ChaincodeName, req.FunctionName, message)
+
 		return &InvokeTransactionResponse{
-			Success: false,
-			Message: fmt.Sprintf("Transaction failed: %v", err),
+			Success:        true,
+			Message:        message,
+			Determinism:    toDeterminismReport(report),
+			ValidationCode: TxValidationCode_NOT_VALIDATED,
 		}, nil
 	}
 
-	log.Printf("Transaction invoked successfully: %s", txID)
+	// Invoke transaction with context
+	result, err := invoker.Submit(ctx, invokeReq)
+	if err != nil {
+		resp := toInvokeTransactionResponse(result)
+		resp.Message = fmt.Sprintf("Transaction failed: %v", err)
+		switch {
+		case errors.IsTimeout(err):
+			resp.Message = "Transaction timed out"
+		case errors.IsEndorsementRejected(err):
+			resp.Reason = "endorsement_rejected"
+		case errors.IsEndorsementMismatch(err):
+			resp.Reason = "endorsement_mismatch"
+		case errors.IsTransactionInvalidated(err):
+			resp.Reason = "invalidated"
+			resp.Retryable = result.Retryable()
+		}
+		return resp, nil
+	}
+
+	log.Printf("Transaction %s committed in block %d", result.TxID, result.BlockNumber)
+
+	resp := toInvokeTransactionResponse(result)
+	resp.Success = true
+	resp.Message = "Transaction invoked successfully"
+	return resp, nil
+}
 
+// toInvokeTransactionResponse converts what is known of a submitted
+// transaction; a nil result was never endorsed
+func toInvokeTransactionResponse(result *chaincode.TransactionResult) *InvokeTransactionResponse {
+	if result == nil {
+		return &InvokeTransactionResponse{ValidationCode: TxValidationCode_NOT_VALIDATED}
+	}
 	return &InvokeTransactionResponse{
-		Success:       true,
-		Message:       "Transaction invoked successfully",
-		TransactionId: txID,
-		Payload:       payload,
-	}, nil
+		TransactionId:    result.TxID,
+		Payload:          result.Payload,
+		BlockNumber:      result.BlockNumber,
+		ValidationCode:   TxValidationCode(result.ValidationCode),
+		EndorsingPeers:   result.Endorsers,
+		ChaincodeStatus:  result.Status,
+		ChaincodeMessage: result.Message,
+	}
 }
 
 func (s *FabricXServer) QueryLedger(ctx context.Context, req *QueryLedgerRequest) (*QueryLedgerResponse, error) {
@@ -241,7 +432,13 @@ func (s *FabricXServer) QueryLedger(ctx context.Context, req *QueryLedgerRequest
 	invoker := chaincode.NewInvoker(net, executor.NewRealExecutor())
 
 	// Query ledger with context
-	payload, err := invoker.Query(ctx, req.ChaincodeName, req.FunctionName, req.Args)
+	payload, err := invoker.Evaluate(ctx, &chaincode.InvokeRequest{
+		Chaincode: req.ChaincodeName,
+		Function:  req.FunctionName,
+		Args:      req.Args,
+		Org:       req.Org,
+		Identity:  req.Identity,
+	})
 	if err != nil {
 		if errors.IsTimeout(err) {
 			return &QueryLedgerResponse{
@@ -290,6 +487,12 @@ func (s *FabricXServer) StopNetwork(ctx context.Context, req *StopNetworkRequest
 		}, nil
 	}
 
+	// Chaincode services are not part of the compose project
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	if err := deployer.StopServices(ctx); err != nil {
+		log.Printf("Warning: failed to stop chaincode services: %v", err)
+	}
+
 	// Stop Docker containers with context
 	if err := s.dockerMgr.StopNetwork(ctx, net, req.Cleanup); err != nil {
 		return &StopNetworkResponse{
@@ -366,8 +569,14 @@ func (s *FabricXServer) GetNetworkStatus(ctx context.Context, req *NetworkStatus
 	}, nil
 }
 
-func (s *FabricXServer) StreamLogs(req *StreamLogsRequest, stream FabricXService_StreamLogsServer) error {
-	log.Printf("StreamLogs called for network %s, container %s", req.NetworkId, req.ContainerName)
+func (s *FabricXServer) ExportTopology(ctx context.Context, req *ExportTopologyRequest) (*ExportTopologyResponse, error) {
+	// Check context
+	if err := ctx.Err(); err != nil {
+		return &ExportTopologyResponse{
+			Success: false,
+			Message: fmt.Sprintf("Context error: %v", err),
+		}, nil
+	}
 
 	// Get network
 	s.networksMu.RLock()
@@ -375,38 +584,1008 @@ func (s *FabricXServer) StreamLogs(req *StreamLogsRequest, stream FabricXService
 	s.networksMu.RUnlock()
 
 	if !exists {
-		return errors.WrapWithContext("StreamLogs", errors.ErrNetworkNotFound, map[string]interface{}{
-			"network_id": req.NetworkId,
+		return &ExportTopologyResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	format := req.Format
+	if format == "" {
+		format = "yaml"
+	}
+
+	data, err := net.Topology().Marshal(format)
+	if err != nil {
+		return &ExportTopologyResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to export topology: %v", err),
+		}, nil
+	}
+
+	return &ExportTopologyResponse{
+		Success:  true,
+		Message:  "Topology exported successfully",
+		Topology: string(data),
+		Format:   format,
+	}, nil
+}
+
+func (s *FabricXServer) RegisterIdentity(ctx context.Context, req *RegisterIdentityRequest) (*RegisterIdentityResponse, error) {
+	log.Printf("RegisterIdentity called: %s in %s", req.Name, req.Org)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &RegisterIdentityResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	secret, err := net.RegisterIdentity(ctx, req.Org, &network.Identity{
+		Name:           req.Name,
+		Type:           req.Type,
+		Affiliation:    req.Affiliation,
+		Attributes:     req.Attributes,
+		MaxEnrollments: int(req.MaxEnrollments),
+	}, req.Secret)
+	if err != nil {
+		return &RegisterIdentityResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to register identity: %v", err),
+		}, nil
+	}
+
+	return &RegisterIdentityResponse{
+		Success: true,
+		Message: fmt.Sprintf("Identity %s registered", req.Name),
+		Secret:  secret,
+	}, nil
+}
+
+func (s *FabricXServer) EnrollIdentity(ctx context.Context, req *EnrollIdentityRequest) (*EnrollIdentityResponse, error) {
+	log.Printf("EnrollIdentity called: %s in %s", req.Name, req.Org)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &EnrollIdentityResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	mspPath, err := net.EnrollIdentity(ctx, req.Org, req.Name, req.Secret, req.Attributes)
+	if err != nil {
+		return &EnrollIdentityResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to enroll identity: %v", err),
+		}, nil
+	}
+
+	return &EnrollIdentityResponse{
+		Success: true,
+		Message: fmt.Sprintf("Identity %s enrolled", req.Name),
+		MspPath: mspPath,
+	}, nil
+}
+
+func (s *FabricXServer) RevokeIdentity(ctx context.Context, req *RevokeIdentityRequest) (*RevokeIdentityResponse, error) {
+	log.Printf("RevokeIdentity called: %s in %s", req.Name, req.Org)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &RevokeIdentityResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	if err := net.RevokeIdentity(ctx, req.Org, req.Name, req.Reason); err != nil {
+		return &RevokeIdentityResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to revoke identity: %v", err),
+		}, nil
+	}
+
+	return &RevokeIdentityResponse{
+		Success: true,
+		Message: fmt.Sprintf("Identity %s revoked", req.Name),
+	}, nil
+}
+
+func (s *FabricXServer) ListIdentities(ctx context.Context, req *ListIdentitiesRequest) (*ListIdentitiesResponse, error) {
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &ListIdentitiesResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	identities, err := net.ListIdentities(ctx, req.Org)
+	if err != nil {
+		return &ListIdentitiesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list identities: %v", err),
+		}, nil
+	}
+
+	infos := []*IdentityInfo{}
+	for _, id := range identities {
+		infos = append(infos, &IdentityInfo{
+			Name:           id.Name,
+			Type:           id.Type,
+			Affiliation:    id.Affiliation,
+			MaxEnrollments: int32(id.MaxEnrollments),
+			Attributes:     id.Attributes,
 		})
 	}
 
-	// Get log channels from docker manager with stream context
-	ctx := stream.Context()
-	logChan, errChan := s.dockerMgr.StreamLogs(ctx, net, req.ContainerName)
+	return &ListIdentitiesResponse{
+		Success:    true,
+		Message:    fmt.Sprintf("%d identities", len(infos)),
+		Identities: infos,
+	}, nil
+}
 
-	// Forward logs to gRPC stream
-	for {
-		select {
-		case line, ok := <-logChan:
-			if !ok {
-				return nil
-			}
-			if err := stream.Send(&LogMessage{
-				Timestamp: "",
-				Container: req.ContainerName,
-				Message:   line,
-			}); err != nil {
-				return errors.Wrap("StreamLogs.Send", err)
-			}
-		case err := <-errChan:
+func (s *FabricXServer) GetCollections(ctx context.Context, req *GetCollectionsRequest) (*GetCollectionsResponse, error) {
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &GetCollectionsResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	chaincodes := net.CollectionChaincodes()
+	if req.ChaincodeName != "" {
+		chaincodes = []string{req.ChaincodeName}
+	}
+
+	infos := []*CollectionInfo{}
+	for _, cc := range chaincodes {
+		for _, c := range net.Collections(cc) {
+			orgs, err := net.CollectionOrgs(cc, c.Name)
 			if err != nil {
-				return errors.Wrap("StreamLogs", err)
+				return &GetCollectionsResponse{
+					Success: false,
+					Message: fmt.Sprintf("Failed to resolve collection members: %v", err),
+				}, nil
 			}
-			return nil
-		case <-ctx.Done():
-			return ctx.Err()
+
+			peers := []string{}
+			for _, org := range orgs {
+				for _, peer := range org.Peers {
+					peers = append(peers, peer.Name)
+				}
+			}
+
+			infos = append(infos, &CollectionInfo{
+				ChaincodeName:     cc,
+				Name:              c.Name,
+				Policy:            c.Policy,
+				MemberOrgs:        c.MemberOrgs,
+				Peers:             peers,
+				RequiredPeerCount: int32(c.RequiredPeerCount),
+				MaxPeerCount:      int32(c.MaxPeerCount),
+				BlockToLive:       c.BlockToLive,
+				MemberOnlyRead:    c.MemberOnlyRead,
+				MemberOnlyWrite:   c.MemberOnlyWrite,
+			})
+		}
+	}
+
+	return &GetCollectionsResponse{
+		Success:     true,
+		Message:     fmt.Sprintf("%d collections", len(infos)),
+		Collections: infos,
+	}, nil
+}
+
+func (s *FabricXServer) ListChaincodes(ctx context.Context, req *ListChaincodesRequest) (*ListChaincodesResponse, error) {
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &ListChaincodesResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	state, err := deployer.ListChaincodes(ctx)
+	if err != nil {
+		return &ListChaincodesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to query chaincodes: %v", err),
+		}, nil
+	}
+
+	count := 0
+	channels := make([]*ChannelChaincodes, 0, len(state.Channels))
+	for _, ch := range state.Channels {
+		committed := make([]*ChaincodeDefinition, 0, len(ch.Committed))
+		for _, def := range ch.Committed {
+			committed = append(committed, toChaincodeDefinition(def))
 		}
+		count += len(committed)
+		channels = append(channels, &ChannelChaincodes{
+			Channel:   ch.Channel,
+			Committed: committed,
+		})
 	}
+
+	return &ListChaincodesResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("%d committed chaincodes on %d channels", count, len(channels)),
+		Channels:  channels,
+		Installed: toPeerPackages(state.Installed),
+	}, nil
+}
+
+func (s *FabricXServer) GetChaincodeDefinition(ctx context.Context, req *GetChaincodeDefinitionRequest) (*GetChaincodeDefinitionResponse, error) {
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &GetChaincodeDefinitionResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	state, err := deployer.ChaincodeDefinition(ctx, req.ChaincodeName)
+	if err != nil {
+		if errors.IsChaincodeNotFound(err) {
+			return &GetChaincodeDefinitionResponse{
+				Success: false,
+				Message: fmt.Sprintf("Chaincode %s not found", req.ChaincodeName),
+			}, nil
+		}
+		return &GetChaincodeDefinitionResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to query chaincode definition: %v", err),
+		}, nil
+	}
+
+	resp := &GetChaincodeDefinitionResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("Chaincode %s", req.ChaincodeName),
+		Installed: toPeerPackages(state.Installed),
+	}
+	for _, ch := range state.Channels {
+		def := &ChannelChaincodeDefinition{Channel: ch.Channel}
+		if ch.Committed != nil {
+			def.Committed = toChaincodeDefinition(ch.Committed)
+		}
+		if ch.Pending != nil {
+			def.Pending = toChaincodeDefinition(ch.Pending)
+		}
+		resp.Channels = append(resp.Channels, def)
+	}
+	return resp, nil
+}
+
+func (s *FabricXServer) ApproveChaincode(ctx context.Context, req *ApproveChaincodeRequest) (*ApproveChaincodeResponse, error) {
+	log.Printf("ApproveChaincode called: %s by %s on network %s", req.ChaincodeName, req.Org, req.NetworkId)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &ApproveChaincodeResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	if err := deployer.ApproveStaged(ctx, req.ChaincodeName, req.Org); err != nil {
+		if errors.IsChaincodeNotFound(err) {
+			return &ApproveChaincodeResponse{
+				Success: false,
+				Message: fmt.Sprintf("No staged definition for chaincode %s", req.ChaincodeName),
+			}, nil
+		}
+		return &ApproveChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to approve chaincode: %v", err),
+		}, nil
+	}
+
+	return &ApproveChaincodeResponse{
+		Success: true,
+		Message: fmt.Sprintf("%s approved chaincode %s", req.Org, req.ChaincodeName),
+	}, nil
+}
+
+func (s *FabricXServer) RejectChaincode(ctx context.Context, req *RejectChaincodeRequest) (*RejectChaincodeResponse, error) {
+	log.Printf("RejectChaincode called: %s by %s on network %s", req.ChaincodeName, req.Org, req.NetworkId)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &RejectChaincodeResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	if err := deployer.RejectStaged(req.ChaincodeName, req.Org, req.Reason); err != nil {
+		if errors.IsChaincodeNotFound(err) {
+			return &RejectChaincodeResponse{
+				Success: false,
+				Message: fmt.Sprintf("No staged definition for chaincode %s", req.ChaincodeName),
+			}, nil
+		}
+		return &RejectChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to reject chaincode: %v", err),
+		}, nil
+	}
+
+	return &RejectChaincodeResponse{
+		Success: true,
+		Message: fmt.Sprintf("%s rejected chaincode %s", req.Org, req.ChaincodeName),
+	}, nil
+}
+
+func (s *FabricXServer) CheckCommitReadiness(ctx context.Context, req *CheckCommitReadinessRequest) (*CheckCommitReadinessResponse, error) {
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &CheckCommitReadinessResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	readiness, err := deployer.CommitReadiness(ctx, req.ChaincodeName)
+	if err != nil {
+		if errors.IsChaincodeNotFound(err) {
+			return &CheckCommitReadinessResponse{
+				Success: false,
+				Message: fmt.Sprintf("No staged definition for chaincode %s", req.ChaincodeName),
+			}, nil
+		}
+		return &CheckCommitReadinessResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to check commit readiness: %v", err),
+		}, nil
+	}
+
+	message := "Definition is ready to commit"
+	if !readiness.Ready {
+		message = "Definition is not ready to commit"
+	}
+
+	return &CheckCommitReadinessResponse{
+		Success:         true,
+		Message:         message,
+		Version:         readiness.Version,
+		Sequence:        readiness.Sequence,
+		Approvals:       readiness.Approvals,
+		Rejections:      readiness.Rejections,
+		Mismatched:      readiness.Mismatched,
+		LifecyclePolicy: readiness.Policy,
+		Ready:           readiness.Ready,
+	}, nil
+}
+
+func (s *FabricXServer) CommitChaincode(ctx context.Context, req *CommitChaincodeRequest) (*CommitChaincodeResponse, error) {
+	log.Printf("CommitChaincode called: %s on network %s", req.ChaincodeName, req.NetworkId)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &CommitChaincodeResponse{
+			Success: false,
+			Message: "Network not found",
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	result, err := deployer.CommitStaged(ctx, req.ChaincodeName)
+	if err != nil {
+		resp := &CommitChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to commit chaincode: %v", err),
+		}
+		switch {
+		case errors.IsDefinitionRejected(err):
+			resp.Reason = "rejected"
+		case errors.IsDefinitionMismatch(err):
+			resp.Reason = "mismatch"
+		case errors.IsApprovalsMissing(err):
+			resp.Reason = "missing_approvals"
+		case errors.IsChaincodeNotFound(err):
+			resp.Message = fmt.Sprintf("No staged definition for chaincode %s", req.ChaincodeName)
+		}
+		return resp, nil
+	}
+
+	log.Printf("Chaincode %s committed at sequence %d", req.ChaincodeName, result.Sequence)
+
+	return &CommitChaincodeResponse{
+		Success:     true,
+		Message:     "Chaincode committed successfully",
+		ChaincodeId: result.ChaincodeID,
+		Version:     result.Version,
+		Sequence:    result.Sequence,
+	}, nil
+}
+
+// UploadChaincode stores a lifecycle package or source archive streamed by a
+// client that cannot share a folder with the runtime
+func (s *FabricXServer) UploadChaincode(stream FabricXService_UploadChaincodeServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return stream.SendAndClose(&UploadChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to receive upload: %v", err),
+		})
+	}
+
+	log.Printf("UploadChaincode called: %s %s on network %s", first.Kind, first.FileName, first.NetworkId)
+
+	s.networksMu.RLock()
+	net, exists := s.networks[first.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return stream.SendAndClose(&UploadChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Network %s not found", first.NetworkId),
+		})
+	}
+
+	upload, err := chaincode.SaveUpload(net, first.Kind, first.FileName, first.Sha256, &uploadReader{stream: stream, buf: first.Data})
+	if err != nil {
+		return stream.SendAndClose(&UploadChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to store upload: %v", err),
+		})
+	}
+
+	log.Printf("Stored upload %s (%d bytes)", upload.ID, upload.Size)
+
+	return stream.SendAndClose(&UploadChaincodeResponse{
+		Success:   true,
+		Message:   "Upload stored",
+		UploadId:  upload.ID,
+		Size:      upload.Size,
+		Sha256:    upload.SHA256,
+		PackageId: upload.PackageID,
+		Label:     upload.Label,
+		Language:  upload.Language,
+	})
+}
+
+// uploadReader reads the data of an upload stream
+type uploadReader struct {
+	stream FabricXService_UploadChaincodeServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err // io.EOF once the client closes the stream
+		}
+		r.buf = msg.Data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// ListTemplates describes the chaincode templates embedded in the runtime
+func (s *FabricXServer) ListTemplates(ctx context.Context, req *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	list, err := templates.List()
+	if err != nil {
+		return &ListTemplatesResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to list templates: %v", err),
+		}, nil
+	}
+
+	resp := &ListTemplatesResponse{
+		Success: true,
+		Message: fmt.Sprintf("%d templates", len(list)),
+	}
+	for _, t := range list {
+		tmpl := &ChaincodeTemplate{
+			Name:         t.Name,
+			Title:        t.Title,
+			Description:  t.Description,
+			Languages:    t.Languages,
+			ModulePath:   t.Defaults.ModulePath,
+			ContractName: t.Defaults.ContractName,
+			PackageName:  t.Defaults.PackageName,
+		}
+		for _, fn := range t.Functions {
+			tmpl.Functions = append(tmpl.Functions, &TemplateFunction{
+				Name:        fn.Name,
+				Description: fn.Description,
+				Args:        fn.Args,
+				Query:       fn.Query,
+			})
+		}
+		for _, event := range t.Events {
+			tmpl.Events = append(tmpl.Events, &TemplateEvent{
+				Name:        event.Name,
+				Description: event.Description,
+			})
+		}
+		resp.Templates = append(resp.Templates, tmpl)
+	}
+	return resp, nil
+}
+
+// ScaffoldChaincode renders a template into a folder on the runtime host, or
+// into an archive for clients on other machines
+func (s *FabricXServer) ScaffoldChaincode(ctx context.Context, req *ScaffoldChaincodeRequest) (*ScaffoldChaincodeResponse, error) {
+	log.Printf("ScaffoldChaincode called: %s (%s)", req.Template, req.Language)
+
+	opts := &templates.Options{
+		Language:     req.Language,
+		ModulePath:   req.ModulePath,
+		ContractName: req.ContractName,
+		PackageName:  req.PackageName,
+	}
+	rendered, err := templates.Render(req.Template, opts)
+	if err != nil {
+		if errors.IsTemplateNotFound(err) {
+			name := req.Template
+			if req.Language != "" {
+				name = fmt.Sprintf("%s (%s)", req.Template, req.Language)
+			}
+			return &ScaffoldChaincodeResponse{
+				Success: false,
+				Message: fmt.Sprintf("Template %s not found", name),
+			}, nil
+		}
+		return &ScaffoldChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to render template: %v", err),
+		}, nil
+	}
+
+	resp := &ScaffoldChaincodeResponse{
+		Success:      true,
+		Language:     opts.Language,
+		ModulePath:   opts.ModulePath,
+		ContractName: opts.ContractName,
+		PackageName:  opts.PackageName,
+	}
+	for _, f := range rendered {
+		resp.Files = append(resp.Files, f.Path)
+	}
+
+	if req.TargetDir == "" {
+		var buf bytes.Buffer
+		if err := templates.WriteArchive(&buf, rendered); err != nil {
+			return &ScaffoldChaincodeResponse{
+				Success: false,
+				Message: fmt.Sprintf("Failed to archive chaincode: %v", err),
+			}, nil
+		}
+		resp.Archive = buf.Bytes()
+		resp.Message = fmt.Sprintf("Rendered %s with %d files", req.Template, len(rendered))
+		return resp, nil
+	}
+
+	if err := templates.WriteDir(req.TargetDir, rendered); err != nil {
+		return &ScaffoldChaincodeResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to write chaincode: %v", err),
+		}, nil
+	}
+
+	log.Printf("Scaffolded %s into %s", req.Template, req.TargetDir)
+
+	resp.Path = req.TargetDir
+	resp.Message = fmt.Sprintf("Scaffolded %s into %s", req.Template, req.TargetDir)
+	return resp, nil
+}
+
+func (s *FabricXServer) CheckDeterminism(ctx context.Context, req *CheckDeterminismRequest) (*CheckDeterminismResponse, error) {
+	log.Printf("CheckDeterminism called: %s on network %s", req.ChaincodeName, req.NetworkId)
+
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &CheckDeterminismResponse{
+			Success: false,
+			Message: fmt.Sprintf("Network %s not found", req.NetworkId),
+		}, nil
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+	diagnostics, err := deployer.CheckDeterminism(ctx, &chaincode.DeployRequest{
+		Name:     req.ChaincodeName,
+		Path:     req.ChaincodePath,
+		Language: req.Language,
+		UploadID: req.UploadId,
+		GitURL:   req.GitUrl,
+		GitRef:   req.GitRef,
+	})
+	if err != nil {
+		return &CheckDeterminismResponse{
+			Success: false,
+			Message: fmt.Sprintf("Failed to check chaincode: %v", err),
+		}, nil
+	}
+
+	resp := &CheckDeterminismResponse{
+		Success:     true,
+		Diagnostics: toDiagnostics(diagnostics),
+	}
+	for _, d := range diagnostics {
+		if d.Severity == determinism.SeverityError {
+			resp.Errors++
+		} else {
+			resp.Warnings++
+		}
+	}
+	resp.Message = fmt.Sprintf("%d errors, %d warnings", resp.Errors, resp.Warnings)
+	return resp, nil
+}
+
+func (s *FabricXServer) EndorseTransaction(ctx context.Context, req *EndorseTransactionRequest) (*EndorseTransactionResponse, error) {
+	log.Printf("EndorseTransaction called: %s.%s on network %s", req.ChaincodeName, req.FunctionName, req.NetworkId)
+
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return &EndorseTransactionResponse{
+			Success: false,
+			Message: fmt.Sprintf("Network %s not found", req.NetworkId),
+		}, nil
+	}
+
+	invoker := chaincode.NewInvoker(net, executor.NewRealExecutor())
+	result, err := invoker.Endorse(ctx, &chaincode.InvokeRequest{
+		Chaincode:      req.ChaincodeName,
+		Function:       req.FunctionName,
+		Args:           req.Args,
+		Org:            req.Org,
+		Identity:       req.Identity,
+		Transient:      req.Transient,
+		Collection:     req.Collection,
+		EndorsingOrgs:  req.EndorsingOrgs,
+		EndorsingPeers: req.EndorsingPeers,
+		AutoEndorse:    req.AutoEndorse,
+	})
+	if err != nil {
+		return &EndorseTransactionResponse{
+			Success: false,
+			Message: fmt.Sprintf("Endorsement failed: %v", err),
+		}, nil
+	}
+
+	resp := &EndorseTransactionResponse{
+		Success:       true,
+		Message:       fmt.Sprintf("Endorsed by %d peers, the transaction was not submitted", len(result.Endorsers)),
+		TransactionId: result.TxID,
+	}
+	for _, p := range result.Endorsers {
+		resp.Endorsers = append(resp.Endorsers, toEndorserResponse(p))
+	}
+	return resp, nil
+}
+
+// deployFailureReason classifies deploy and upgrade failures clients can act on
+func deployFailureReason(err error) string {
+	if errors.IsGoModules(err) {
+		return "go_modules"
+	}
+	if errors.IsNondeterministic(err) {
+		return "nondeterministic"
+	}
+	return ""
+}
+
+// toDeterminismReport converts the comparison of endorser responses
+func toDeterminismReport(report *chaincode.ConsistencyReport) *DeterminismReport {
+	result := &DeterminismReport{Consistent: report.Consistent()}
+	for _, p := range report.Peers {
+		result.Endorsers = append(result.Endorsers, toEndorserResponse(p))
+	}
+	for _, d := range report.Divergences {
+		result.Divergences = append(result.Divergences, &Divergence{
+			Kind:       d.Kind,
+			Namespace:  d.Namespace,
+			Collection: d.Collection,
+			Key:        d.Key,
+			Values:     d.Values,
+		})
+	}
+	return result
+}
+
+// toEndorserResponse converts one endorser's response and read-write set
+func toEndorserResponse(p *chaincode.PeerResponse) *EndorserResponse {
+	result := &EndorserResponse{
+		Peer:    p.Peer,
+		Org:     p.Org,
+		Status:  p.Status,
+		Message: p.Message,
+		Payload: p.Payload,
+		Error:   p.Error,
+	}
+	if p.Event != nil {
+		result.Event = &ChaincodeEvent{Name: p.Event.Name, Payload: p.Event.Payload}
+	}
+	for _, set := range p.RWSets {
+		ns := &NamespaceReadWriteSet{Namespace: set.Namespace}
+		ns.Reads = toKeyReads(set.Reads)
+		for _, w := range set.Writes {
+			ns.Writes = append(ns.Writes, &KeyWrite{Key: w.Key, Value: w.Value, IsDelete: w.IsDelete})
+		}
+		for _, q := range set.RangeQueries {
+			ns.RangeQueries = append(ns.RangeQueries, &RangeQuery{
+				StartKey:     q.StartKey,
+				EndKey:       q.EndKey,
+				Exhausted:    q.Exhausted,
+				Reads:        toKeyReads(q.Reads),
+				MerkleHashes: q.MerkleHashes,
+			})
+		}
+		for _, c := range set.Collections {
+			coll := &CollectionReadWriteSet{Collection: c.Collection}
+			for _, r := range c.Reads {
+				coll.Reads = append(coll.Reads, &HashedKeyRead{KeyHash: r.KeyHash, Version: toKeyVersion(r.Version)})
+			}
+			for _, w := range c.Writes {
+				coll.Writes = append(coll.Writes, &HashedKeyWrite{
+					KeyHash:   w.KeyHash,
+					ValueHash: w.ValueHash,
+					IsDelete:  w.IsDelete,
+					IsPurge:   w.IsPurge,
+				})
+			}
+			ns.Collections = append(ns.Collections, coll)
+		}
+		result.Rwsets = append(result.Rwsets, ns)
+	}
+	return result
+}
+
+func toKeyReads(reads []*chaincode.KeyRead) []*KeyRead {
+	var result []*KeyRead
+	for _, r := range reads {
+		result = append(result, &KeyRead{Key: r.Key, Version: toKeyVersion(r.Version)})
+	}
+	return result
+}
+
+func toKeyVersion(version *chaincode.Version) *KeyVersion {
+	if version == nil {
+		return nil
+	}
+	return &KeyVersion{BlockNum: version.BlockNum, TxNum: version.TxNum}
+}
+
+// toDiagnostics converts determinism check findings
+func toDiagnostics(diagnostics []determinism.Diagnostic) []*DeterminismDiagnostic {
+	if len(diagnostics) == 0 {
+		return nil
+	}
+	result := make([]*DeterminismDiagnostic, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		result = append(result, &DeterminismDiagnostic{
+			Check:    d.Check,
+			Severity: d.Severity,
+			File:     d.File,
+			Line:     int32(d.Line),
+			Column:   int32(d.Column),
+			Function: d.Function,
+			Message:  d.Message,
+		})
+	}
+	return result
+}
+
+// toChaincodeDefinition converts a definition read from the peer
+func toChaincodeDefinition(def *chaincode.CommittedDefinition) *ChaincodeDefinition {
+	policy, ref, err := def.EndorsementPolicy()
+	endorsementPolicy := ref
+	if err != nil {
+		endorsementPolicy = fmt.Sprintf("unreadable: %v", err)
+	} else if policy != nil {
+		endorsementPolicy = policy.String()
+	}
+
+	collections := make([]*CollectionInfo, 0, len(def.Collections))
+	for _, c := range def.Collections {
+		var memberOrgs []string
+		if p, err := chaincode.ParsePolicy(c.Policy); err == nil {
+			memberOrgs = p.MSPIDs()
+		}
+		collections = append(collections, &CollectionInfo{
+			ChaincodeName:     def.Name,
+			Name:              c.Name,
+			Policy:            c.Policy,
+			MemberOrgs:        memberOrgs,
+			RequiredPeerCount: int32(c.RequiredPeerCount),
+			MaxPeerCount:      int32(c.MaxPeerCount),
+			BlockToLive:       c.BlockToLive,
+			MemberOnlyRead:    c.MemberOnlyRead,
+			MemberOnlyWrite:   c.MemberOnlyWrite,
+		})
+	}
+
+	return &ChaincodeDefinition{
+		Name:              def.Name,
+		Version:           def.Version,
+		Sequence:          def.Sequence,
+		EndorsementPolicy: endorsementPolicy,
+		Collections:       collections,
+		InitRequired:      def.InitRequired,
+		Approvals:         def.Approvals,
+	}
+}
+
+// toPeerPackages converts the installed packages of each peer
+func toPeerPackages(peers []*chaincode.PeerPackages) []*PeerPackages {
+	result := make([]*PeerPackages, 0, len(peers))
+	for _, peer := range peers {
+		packages := make([]*InstalledPackage, 0, len(peer.Packages))
+		for _, pkg := range peer.Packages {
+			packages = append(packages, &InstalledPackage{
+				PackageId:  pkg.PackageID,
+				Label:      pkg.Label,
+				References: pkg.References,
+			})
+		}
+		result = append(result, &PeerPackages{
+			Org:      peer.Org,
+			Peer:     peer.Peer,
+			Packages: packages,
+		})
+	}
+	return result
+}
+
+func (s *FabricXServer) StreamLogs(req *StreamLogsRequest, stream FabricXService_StreamLogsServer) error {
+	log.Printf("StreamLogs called for network %s, container %s", req.NetworkId, req.ContainerName)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return errors.WrapWithContext("StreamLogs", errors.ErrNetworkNotFound, map[string]interface{}{
+			"network_id": req.NetworkId,
+		})
+	}
+
+	// Get log channels from docker manager with stream context
+	ctx := stream.Context()
+	logChan, errChan := s.dockerMgr.StreamLogs(ctx, net, req.ContainerName)
+
+	// Forward logs to gRPC stream
+	for {
+		select {
+		case line, ok := <-logChan:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&LogMessage{
+				Timestamp: "",
+				Container: req.ContainerName,
+				Message:   line,
+			}); err != nil {
+				return errors.Wrap("StreamLogs.Send", err)
+			}
+		case err := <-errChan:
+			if err != nil {
+				return errors.Wrap("StreamLogs", err)
+			}
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *FabricXServer) WatchChaincode(req *WatchChaincodeRequest, stream FabricXService_WatchChaincodeServer) error {
+	log.Printf("WatchChaincode called: %s on network %s", req.ChaincodeName, req.NetworkId)
+
+	// Get network
+	s.networksMu.RLock()
+	net, exists := s.networks[req.NetworkId]
+	s.networksMu.RUnlock()
+
+	if !exists {
+		return errors.WrapWithContext("WatchChaincode", errors.ErrNetworkNotFound, map[string]interface{}{
+			"network_id": req.NetworkId,
+		})
+	}
+
+	deployer := chaincode.NewDeployer(net, s.dockerMgr, executor.NewRealExecutor())
+
+	// Watch until the client goes away
+	ctx := stream.Context()
+	events := deployer.Watch(ctx, &chaincode.DeployRequest{
+		Name:                  req.ChaincodeName,
+		Path:                  req.ChaincodePath,
+		Language:              req.Language,
+		EndorsementPolicyOrgs: req.EndorsementPolicyOrgs,
+		EndorsementPolicy:     req.EndorsementPolicy,
+		CollectionsConfig:     req.CollectionsConfig,
+		Mode:                  req.Mode,
+		Vendor:                req.Vendor,
+	}, chaincode.WatchOptions{
+		Debounce:   time.Duration(req.DebounceMs) * time.Millisecond,
+		SkipChecks: req.SkipChecks,
+	})
+
+	for event := range events {
+		msg := &WatchEvent{
+			Timestamp: event.Timestamp.Format(time.RFC3339),
+			Type:      event.Type,
+			Message:   event.Message,
+			Output:    event.Output,
+		}
+		if event.Result != nil {
+			msg.Version = event.Result.Version
+			msg.Sequence = event.Result.Sequence
+			msg.Restarted = event.Result.Restarted
+		}
+		if err := stream.Send(msg); err != nil {
+			return errors.Wrap("WatchChaincode.Send", err)
+		}
+	}
+
+	return ctx.Err()
 }
 
 // Shutdown gracefully shuts down the server
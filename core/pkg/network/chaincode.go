@@ -0,0 +1,164 @@
+// core/pkg/network/chaincode.go
+package network
+
+import (
+	"sort"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/determinism"
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// DebugExecuteTimeout is the chaincode execute timeout peers get while
+// chaincode runs under a debugger, so breakpoints don't abort transactions
+const DebugExecuteTimeout = time.Hour
+
+// Chaincode records what was last deployed for a chaincode name
+type Chaincode struct {
+	Name       string
+	Version    string
+	Sequence   int64
+	Language   string
+	Path       string
+	PackageID  string
+	SourceHash string // Digest of the source tree the package was built from
+	Vendored   bool   // Go modules were vendored into the package
+	Mode       string // How the chaincode runs, e.g. package or ccaas
+	Definition string // Digest of the endorsement policy and collections
+	DebugPort  int    // Host port of the Delve server, 0 when not debugging
+
+	// Findings of the determinism check, nil when the source was not checked
+	Determinism []determinism.Diagnostic
+}
+
+// RecordChaincode stores the deployed definition of a chaincode
+func (n *Network) RecordChaincode(cc *Chaincode) {
+	n.chaincodesMu.Lock()
+	defer n.chaincodesMu.Unlock()
+
+	if n.chaincodes == nil {
+		n.chaincodes = make(map[string]*Chaincode)
+	}
+	n.chaincodes[cc.Name] = cc
+}
+
+// Chaincode returns the recorded chaincode with the given name, or nil
+func (n *Network) Chaincode(name string) *Chaincode {
+	n.chaincodesMu.RLock()
+	defer n.chaincodesMu.RUnlock()
+
+	return n.chaincodes[name]
+}
+
+// Chaincodes returns all recorded chaincodes sorted by name
+func (n *Network) Chaincodes() []*Chaincode {
+	n.chaincodesMu.RLock()
+	defer n.chaincodesMu.RUnlock()
+
+	result := make([]*Chaincode, 0, len(n.chaincodes))
+	for _, cc := range n.chaincodes {
+		result = append(result, cc)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// StagedDefinition is a chaincode definition waiting for each org to
+// approve it separately
+type StagedDefinition struct {
+	Chaincode                  // Recorded once the definition is committed
+	PolicyArgs        []string // Endorsement policy flags of approve and commit
+	CollectionsConfig string   // As given at staging, empty without collections
+	Collections       []*Collection
+	Approved          map[string]bool   // MSP IDs that approved through the runtime
+	Rejected          map[string]string // MSP ID -> reason for rejecting
+}
+
+// StageDefinition stores a definition for the orgs to approve, replacing
+// any definition staged earlier for the same chaincode
+func (n *Network) StageDefinition(def *StagedDefinition) {
+	n.chaincodesMu.Lock()
+	defer n.chaincodesMu.Unlock()
+
+	if n.staged == nil {
+		n.staged = make(map[string]*StagedDefinition)
+	}
+	def.Approved = map[string]bool{}
+	def.Rejected = map[string]string{}
+	n.staged[def.Name] = def
+}
+
+// StagedDefinition returns a copy of the definition staged for a chaincode,
+// or nil
+func (n *Network) StagedDefinition(name string) *StagedDefinition {
+	n.chaincodesMu.RLock()
+	defer n.chaincodesMu.RUnlock()
+
+	def, ok := n.staged[name]
+	if !ok {
+		return nil
+	}
+
+	staged := *def
+	staged.Approved = make(map[string]bool, len(def.Approved))
+	for mspID, approved := range def.Approved {
+		staged.Approved[mspID] = approved
+	}
+	staged.Rejected = make(map[string]string, len(def.Rejected))
+	for mspID, reason := range def.Rejected {
+		staged.Rejected[mspID] = reason
+	}
+	return &staged
+}
+
+// RecordVote records an org approving or rejecting a staged definition. A
+// later vote of the same org replaces its earlier one.
+func (n *Network) RecordVote(name, mspID string, approved bool, reason string) error {
+	n.chaincodesMu.Lock()
+	defer n.chaincodesMu.Unlock()
+
+	def, ok := n.staged[name]
+	if !ok {
+		return errors.WrapWithContext("RecordVote", errors.ErrChaincodeNotFound, map[string]interface{}{
+			"chaincode": name,
+			"reason":    "no staged definition",
+		})
+	}
+
+	delete(def.Approved, mspID)
+	delete(def.Rejected, mspID)
+	if approved {
+		def.Approved[mspID] = true
+	} else {
+		def.Rejected[mspID] = reason
+	}
+	return nil
+}
+
+// ClearStagedDefinition forgets the definition staged for a chaincode
+func (n *Network) ClearStagedDefinition(name string) {
+	n.chaincodesMu.Lock()
+	defer n.chaincodesMu.Unlock()
+
+	delete(n.staged, name)
+}
+
+// RaiseChaincodeTimeout raises the peers' chaincode execute timeout and
+// rewrites docker-compose.yaml. It reports whether the file changed, in
+// which case the peers must be recreated to pick the timeout up.
+func (n *Network) RaiseChaincodeTimeout(timeout time.Duration) (bool, error) {
+	n.peerSettingsMu.Lock()
+	defer n.peerSettingsMu.Unlock()
+
+	if n.chaincodeExecuteTimeout >= timeout {
+		return false, nil
+	}
+
+	previous := n.chaincodeExecuteTimeout
+	n.chaincodeExecuteTimeout = timeout
+	if err := generateDockerCompose(n); err != nil {
+		n.chaincodeExecuteTimeout = previous
+		return false, errors.Wrap("RaiseChaincodeTimeout", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,79 @@
+// core/pkg/network/collection.go
+package network
+
+import (
+	"sort"
+
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// Collection is a private data collection and the orgs that hold its data
+type Collection struct {
+	Name              string
+	Policy            string
+	MemberOrgs        []string // MSP IDs
+	RequiredPeerCount int
+	MaxPeerCount      int
+	BlockToLive       uint64
+	MemberOnlyRead    bool
+	MemberOnlyWrite   bool
+}
+
+// SetCollections records the private data collections of a chaincode
+func (n *Network) SetCollections(chaincodeName string, collections []*Collection) {
+	n.collectionsMu.Lock()
+	defer n.collectionsMu.Unlock()
+
+	if n.collections == nil {
+		n.collections = make(map[string][]*Collection)
+	}
+	n.collections[chaincodeName] = collections
+}
+
+// Collections returns the private data collections of a chaincode
+func (n *Network) Collections(chaincodeName string) []*Collection {
+	n.collectionsMu.RLock()
+	defer n.collectionsMu.RUnlock()
+
+	return n.collections[chaincodeName]
+}
+
+// CollectionChaincodes returns the names of chaincodes that define collections
+func (n *Network) CollectionChaincodes() []string {
+	n.collectionsMu.RLock()
+	defer n.collectionsMu.RUnlock()
+
+	names := []string{}
+	for name, collections := range n.collections {
+		if len(collections) > 0 {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CollectionOrgs returns the organizations that are members of a collection
+func (n *Network) CollectionOrgs(chaincodeName, collection string) ([]*Organization, error) {
+	for _, c := range n.Collections(chaincodeName) {
+		if c.Name != collection {
+			continue
+		}
+
+		orgs := []*Organization{}
+		for _, org := range n.Orgs {
+			for _, mspID := range c.MemberOrgs {
+				if org.MSPID == mspID {
+					orgs = append(orgs, org)
+				}
+			}
+		}
+		return orgs, nil
+	}
+
+	return nil, errors.WrapWithContext("CollectionOrgs", errors.ErrInvalidConfig, map[string]interface{}{
+		"chaincode":  chaincodeName,
+		"collection": collection,
+		"reason":     "collection is not defined for this chaincode",
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/temmyjay001/core/pkg/errors"
 	"github.com/temmyjay001/core/pkg/utils"
@@ -14,8 +15,13 @@ const (
 	fabricToolsImage = "hyperledger/fabric-tools:2.5"
 )
 
-// generateCrypto uses Docker to run cryptogen (no local binaries needed)
+// generateCrypto uses Docker to run cryptogen (no local binaries needed),
+// or enrolls every identity through Fabric CA when configured to
 func generateCrypto(ctx context.Context, net *Network) error {
+	if net.cryptoProvider() == CryptoProviderCA {
+		return enrollWithFabricCA(ctx, net)
+	}
+
 	// Generate crypto-config.yaml
 	cryptoConfigPath := filepath.Join(net.ConfigPath, "crypto-config.yaml")
 	if err := utils.EnsureDir(filepath.Dir(cryptoConfigPath)); err != nil {
@@ -54,22 +60,28 @@ func generateCrypto(ctx context.Context, net *Network) error {
 }
 
 func generateCryptoConfig(net *Network) map[string]interface{} {
+	ordererOrg := net.ordererOrg()
+
+	// Every orderer node gets its own spec so Raft clusters work too
+	ordererSpecs := []map[string]interface{}{}
+	for _, orderer := range ordererNodes(net) {
+		ordererSpecs = append(ordererSpecs, map[string]interface{}{
+			"Hostname": strings.TrimSuffix(orderer.Name, "."+ordererOrg.Domain),
+			"SANS": []string{
+				"localhost",
+				orderer.Name,
+				"127.0.0.1",
+			},
+		})
+	}
+
 	config := map[string]interface{}{
 		"OrdererOrgs": []map[string]interface{}{
 			{
-				"Name":   "Orderer",
-				"Domain": "example.com",
+				"Name":          ordererOrg.Name,
+				"Domain":        ordererOrg.Domain,
 				"EnableNodeOUs": true,
-				"Specs": []map[string]interface{}{
-					{
-						"Hostname": "orderer",
-						"SANS": []string{
-							"localhost",
-							"orderer.example.com",
-							"127.0.0.1",
-						},
-					},
-				},
+				"Specs":         ordererSpecs,
 			},
 		},
 		"PeerOrgs": []map[string]interface{}{},
@@ -119,53 +131,59 @@ func generateConfigTx(net *Network) error {
 }
 
 func generateConfigTxYAML(net *Network) map[string]interface{} {
+	ordererOrg := net.ordererOrg()
+
 	// Organizations - these are the full definitions
 	organizations := []map[string]interface{}{
 		{
-			"Name":   "OrdererOrg",
-			"ID":     "OrdererMSP",
-			"MSPDir": "/crypto-config/ordererOrganizations/example.com/msp",
+			"Name":   ordererOrg.Name,
+			"ID":     ordererOrg.MSPID,
+			"MSPDir": fmt.Sprintf("/crypto-config/ordererOrganizations/%s/msp", ordererOrg.Domain),
 			"Policies": map[string]interface{}{
 				"Readers": map[string]interface{}{
 					"Type": "Signature",
-					"Rule": "OR('OrdererMSP.member')",
+					"Rule": fmt.Sprintf("OR('%s.member')", ordererOrg.MSPID),
 				},
 				"Writers": map[string]interface{}{
 					"Type": "Signature",
-					"Rule": "OR('OrdererMSP.member')",
+					"Rule": fmt.Sprintf("OR('%s.member')", ordererOrg.MSPID),
 				},
 				"Admins": map[string]interface{}{
 					"Type": "Signature",
-					"Rule": "OR('OrdererMSP.admin')",
+					"Rule": fmt.Sprintf("OR('%s.admin')", ordererOrg.MSPID),
 				},
 			},
 		},
 	}
 
 	// Add peer organizations with full definitions
+	peerOrgsByName := map[string]map[string]interface{}{}
 	for _, org := range net.Orgs {
-		peerOrg := map[string]interface{}{
-			"Name":   org.Name,
-			"ID":     org.MSPID,
-			"MSPDir": fmt.Sprintf("/crypto-config/peerOrganizations/%s/msp", org.Domain),
-			"Policies": map[string]interface{}{
-				"Readers": map[string]interface{}{
-					"Type": "Signature",
-					"Rule": fmt.Sprintf("OR('%s.admin', '%s.peer', '%s.client')", org.MSPID, org.MSPID, org.MSPID),
-				},
-				"Writers": map[string]interface{}{
-					"Type": "Signature",
-					"Rule": fmt.Sprintf("OR('%s.admin', '%s.client')", org.MSPID, org.MSPID),
-				},
-				"Admins": map[string]interface{}{
-					"Type": "Signature",
-					"Rule": fmt.Sprintf("OR('%s.admin')", org.MSPID),
-				},
-				"Endorsement": map[string]interface{}{
-					"Type": "Signature",
-					"Rule": fmt.Sprintf("OR('%s.peer')", org.MSPID),
-				},
+		policies := map[string]interface{}{
+			"Readers": map[string]interface{}{
+				"Type": "Signature",
+				"Rule": fmt.Sprintf("OR('%s.admin', '%s.peer', '%s.client')", org.MSPID, org.MSPID, org.MSPID),
+			},
+			"Writers": map[string]interface{}{
+				"Type": "Signature",
+				"Rule": fmt.Sprintf("OR('%s.admin', '%s.client')", org.MSPID, org.MSPID),
+			},
+			"Admins": map[string]interface{}{
+				"Type": "Signature",
+				"Rule": fmt.Sprintf("OR('%s.admin')", org.MSPID),
+			},
+			"Endorsement": map[string]interface{}{
+				"Type": "Signature",
+				"Rule": fmt.Sprintf("OR('%s.peer')", org.MSPID),
 			},
+		}
+		applyPolicyOverrides(policies, org.Policies)
+
+		peerOrg := map[string]interface{}{
+			"Name":     org.Name,
+			"ID":       org.MSPID,
+			"MSPDir":   fmt.Sprintf("/crypto-config/peerOrganizations/%s/msp", org.Domain),
+			"Policies": policies,
 			"AnchorPeers": []map[string]interface{}{
 				{
 					"Host": org.Peers[0].Name,
@@ -174,6 +192,7 @@ func generateConfigTxYAML(net *Network) map[string]interface{} {
 			},
 		}
 		organizations = append(organizations, peerOrg)
+		peerOrgsByName[org.Name] = peerOrg
 	}
 
 	// Capabilities
@@ -219,12 +238,15 @@ func generateConfigTxYAML(net *Network) map[string]interface{} {
 		},
 	}
 
+	ordererAddresses := []string{}
+	for _, o := range ordererNodes(net) {
+		ordererAddresses = append(ordererAddresses, fmt.Sprintf("%s:%d", o.Name, o.Port))
+	}
+
 	// Orderer defaults
 	orderer := map[string]interface{}{
-		"OrdererType": "solo",
-		"Addresses": []string{
-			fmt.Sprintf("%s:%d", net.Orderers[0].Name, net.Orderers[0].Port),
-		},
+		"OrdererType":  ordererOrg.Consensus,
+		"Addresses":    ordererAddresses,
 		"BatchTimeout": "2s",
 		"BatchSize": map[string]interface{}{
 			"MaxMessageCount":   10,
@@ -273,45 +295,51 @@ func generateConfigTxYAML(net *Network) map[string]interface{} {
 		},
 	}
 
-	ordererOrg := organizations[0]
+	ordererOrgDef := organizations[0]
 	peerOrgs := organizations[1:]
 
+	genesisOrderer := map[string]interface{}{
+		"OrdererType":  ordererOrg.Consensus,
+		"Addresses":    ordererAddresses,
+		"BatchTimeout": "2s",
+		"BatchSize": map[string]interface{}{
+			"MaxMessageCount":   10,
+			"AbsoluteMaxBytes":  "99 MB",
+			"PreferredMaxBytes": "512 KB",
+		},
+		"Organizations": []interface{}{ordererOrgDef},
+		"Policies": map[string]interface{}{
+			"Readers": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "ANY Readers",
+			},
+			"Writers": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "ANY Writers",
+			},
+			"Admins": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "MAJORITY Admins",
+			},
+			"BlockValidation": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "ANY Writers",
+			},
+		},
+		"Capabilities": map[string]interface{}{
+			"V2_0": true,
+		},
+	}
+
+	if ordererOrg.Consensus == ConsensusEtcdRaft {
+		raft := map[string]interface{}{"Consenters": raftConsenters(net)}
+		orderer["EtcdRaft"] = raft
+		genesisOrderer["EtcdRaft"] = raft
+	}
+
 	profiles := map[string]interface{}{
 		"FabricXOrdererGenesis": map[string]interface{}{
-			"Orderer": map[string]interface{}{
-				"OrdererType": "solo",
-				"Addresses": []string{
-					fmt.Sprintf("%s:%d", net.Orderers[0].Name, net.Orderers[0].Port),
-				},
-				"BatchTimeout": "2s",
-				"BatchSize": map[string]interface{}{
-					"MaxMessageCount":   10,
-					"AbsoluteMaxBytes":  "99 MB",
-					"PreferredMaxBytes": "512 KB",
-				},
-				"Organizations": []interface{}{ordererOrg},
-				"Policies": map[string]interface{}{
-					"Readers": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "ANY Readers",
-					},
-					"Writers": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "ANY Writers",
-					},
-					"Admins": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "MAJORITY Admins",
-					},
-					"BlockValidation": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "ANY Writers",
-					},
-				},
-				"Capabilities": map[string]interface{}{
-					"V2_0": true,
-				},
-			},
+			"Orderer": genesisOrderer,
 			"Consortiums": map[string]interface{}{
 				"FabricXConsortium": map[string]interface{}{
 					"Organizations": peerOrgs,
@@ -335,7 +363,40 @@ func generateConfigTxYAML(net *Network) map[string]interface{} {
 				},
 			},
 		},
-		net.Channel.ProfileName: map[string]interface{}{
+	}
+
+	// One profile per channel, limited to its member organizations
+	for _, ch := range net.AllChannels() {
+		members := []interface{}{}
+		for _, org := range net.ChannelOrgs(ch) {
+			members = append(members, peerOrgsByName[org.Name])
+		}
+
+		appPolicies := map[string]interface{}{
+			"Readers": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "ANY Readers",
+			},
+			"Writers": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "ANY Writers",
+			},
+			"Admins": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "MAJORITY Admins",
+			},
+			"LifecycleEndorsement": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "MAJORITY Endorsement",
+			},
+			"Endorsement": map[string]interface{}{
+				"Type": "ImplicitMeta",
+				"Rule": "MAJORITY Endorsement",
+			},
+		}
+		applyPolicyOverrides(appPolicies, ch.Policies)
+
+		profiles[ch.ProfileName] = map[string]interface{}{
 			"Consortium": "FabricXConsortium",
 			"Policies": map[string]interface{}{
 				"Readers": map[string]interface{}{
@@ -355,34 +416,13 @@ func generateConfigTxYAML(net *Network) map[string]interface{} {
 				"V2_0": true,
 			},
 			"Application": map[string]interface{}{
-				"Organizations": peerOrgs,
+				"Organizations": members,
 				"Capabilities": map[string]interface{}{
 					"V2_0": true,
 				},
-				"Policies": map[string]interface{}{
-					"Readers": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "ANY Readers",
-					},
-					"Writers": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "ANY Writers",
-					},
-					"Admins": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "MAJORITY Admins",
-					},
-					"LifecycleEndorsement": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "MAJORITY Endorsement",
-					},
-					"Endorsement": map[string]interface{}{
-						"Type": "ImplicitMeta",
-						"Rule": "MAJORITY Endorsement",
-					},
-				},
+				"Policies": appPolicies,
 			},
-		},
+		}
 	}
 
 	return map[string]interface{}{
@@ -395,6 +435,41 @@ func generateConfigTxYAML(net *Network) map[string]interface{} {
 	}
 }
 
+// ordererNodes returns the orderer nodes, defaulting to a single solo orderer
+func ordererNodes(net *Network) []*Orderer {
+	if len(net.Orderers) > 0 {
+		return net.Orderers
+	}
+	return generateOrderers()
+}
+
+// raftConsenters lists every orderer node as an etcdraft consenter
+func raftConsenters(net *Network) []map[string]interface{} {
+	domain := net.ordererOrg().Domain
+	consenters := []map[string]interface{}{}
+	for _, o := range ordererNodes(net) {
+		tlsDir := fmt.Sprintf("/crypto-config/ordererOrganizations/%s/orderers/%s/tls", domain, o.Name)
+		consenters = append(consenters, map[string]interface{}{
+			"Host":          o.Name,
+			"Port":          o.Port,
+			"ClientTLSCert": tlsDir + "/server.crt",
+			"ServerTLSCert": tlsDir + "/server.crt",
+		})
+	}
+	return consenters
+}
+
+// applyPolicyOverrides replaces default policies with the ones declared in
+// the topology
+func applyPolicyOverrides(policies map[string]interface{}, overrides map[string]Policy) {
+	for name, policy := range overrides {
+		policies[name] = map[string]interface{}{
+			"Type": policy.Type,
+			"Rule": policy.Rule,
+		}
+	}
+}
+
 // generateGenesisBlock uses Docker to run configtxgen
 func generateGenesisBlock(ctx context.Context, net *Network) error {
 	// Check context
@@ -423,31 +498,34 @@ func generateGenesisBlock(ctx context.Context, net *Network) error {
 	return nil
 }
 
-// generateChannelTx uses Docker to run configtxgen
+// generateChannelTx uses Docker to run configtxgen for every channel
 func generateChannelTx(ctx context.Context, net *Network) error {
-	// Check context
-	if err := ctx.Err(); err != nil {
-		return errors.Wrap("generateChannelTx", err)
-	}
-
-	channelTxPath := fmt.Sprintf("%s.tx", net.Channel.Name)
-
-	exec := net.exec
-	output, err := exec.ExecuteCombined(ctx, "docker", "run", "--rm",
-		"-v", fmt.Sprintf("%s:/config", net.ConfigPath),
-		"-v", fmt.Sprintf("%s:/crypto-config", net.CryptoPath),
-		"-e", "FABRIC_CFG_PATH=/config",
-		fabricToolsImage,
-		"configtxgen",
-		"-profile", net.Channel.ProfileName,
-		"-outputCreateChannelTx", fmt.Sprintf("/config/%s", channelTxPath),
-		"-channelID", net.Channel.Name,
-	)
+	for _, ch := range net.AllChannels() {
+		// Check context
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap("generateChannelTx", err)
+		}
 
-	if err != nil {
-		return errors.WrapWithContext("generateChannelTx", err, map[string]interface{}{
-			"output": string(output),
-		})
+		channelTxPath := fmt.Sprintf("%s.tx", ch.Name)
+
+		exec := net.exec
+		output, err := exec.ExecuteCombined(ctx, "docker", "run", "--rm",
+			"-v", fmt.Sprintf("%s:/config", net.ConfigPath),
+			"-v", fmt.Sprintf("%s:/crypto-config", net.CryptoPath),
+			"-e", "FABRIC_CFG_PATH=/config",
+			fabricToolsImage,
+			"configtxgen",
+			"-profile", ch.ProfileName,
+			"-outputCreateChannelTx", fmt.Sprintf("/config/%s", channelTxPath),
+			"-channelID", ch.Name,
+		)
+
+		if err != nil {
+			return errors.WrapWithContext("generateChannelTx", err, map[string]interface{}{
+				"channel": ch.Name,
+				"output":  string(output),
+			})
+		}
 	}
 
 	return nil
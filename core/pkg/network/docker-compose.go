@@ -57,17 +57,21 @@ func generateServices(net *Network) map[string]interface{} {
 	services := make(map[string]interface{})
 
 	// Add orderer services
-	for _, orderer := range net.Orderers {
-		services[orderer.Name] = generateOrdererService(net, orderer)
+	for i, orderer := range net.Orderers {
+		services[orderer.Name] = generateOrdererService(net, orderer, ordererOpsPort(i))
+	}
+
+	// Add CA services
+	for _, ca := range net.certAuthorities() {
+		services[ca.CAName] = generateCAService(net, ca, ca.CAName, ca.CAPort)
+		if ca.SeparateTLS {
+			services[ca.TLSCAName] = generateCAService(net, ca, ca.TLSCAName, ca.TLSCAPort)
+		}
 	}
 
-	// Add CA, peer, and CouchDB services for each org
+	// Add peer and CouchDB services for each org
 	globalPeerIndex := 0
 	for _, org := range net.Orgs {
-		// CA service
-		caName := fmt.Sprintf("ca.%s", org.Domain)
-		services[caName] = generateCAService(net, org)
-
 		// Peer and CouchDB services
 		for i, peer := range org.Peers {
 			if peer.CouchDB {
@@ -85,7 +89,21 @@ func generateServices(net *Network) map[string]interface{} {
 	return services
 }
 
-func generateOrdererService(net *Network, orderer *Orderer) map[string]interface{} {
+// ordererOpsPort is the host port of the operations service of the i-th
+// orderer
+func ordererOpsPort(i int) int {
+	return 8443 + i
+}
+
+// peerOpsPort is the host port of the operations service of a peer,
+// numbered across all orgs
+func peerOpsPort(globalIndex int) int {
+	return 9443 + globalIndex*1000
+}
+
+func generateOrdererService(net *Network, orderer *Orderer, opsPort int) map[string]interface{} {
+	ordererOrg := net.ordererOrg()
+
 	return map[string]interface{}{
 		"container_name": orderer.Name,
 		"image":          "hyperledger/fabric-orderer:2.5",
@@ -93,7 +111,7 @@ func generateOrdererService(net *Network, orderer *Orderer) map[string]interface
 			"FABRIC_LOGGING_SPEC=INFO",
 			"ORDERER_GENERAL_LISTENADDRESS=0.0.0.0",
 			fmt.Sprintf("ORDERER_GENERAL_LISTENPORT=%d", orderer.Port),
-			"ORDERER_GENERAL_LOCALMSPID=OrdererMSP",
+			fmt.Sprintf("ORDERER_GENERAL_LOCALMSPID=%s", ordererOrg.MSPID),
 			"ORDERER_GENERAL_LOCALMSPDIR=/var/hyperledger/orderer/msp",
 			
 			// TLS ENABLED
@@ -111,43 +129,61 @@ func generateOrdererService(net *Network, orderer *Orderer) map[string]interface
 			"ORDERER_GENERAL_CLUSTER_CLIENTCERTIFICATE=/var/hyperledger/orderer/tls/server.crt",
 			"ORDERER_GENERAL_CLUSTER_CLIENTPRIVATEKEY=/var/hyperledger/orderer/tls/server.key",
 			"ORDERER_GENERAL_CLUSTER_ROOTCAS=[/var/hyperledger/orderer/tls/ca.crt]",
-			"ORDERER_OPERATIONS_LISTENADDRESS=0.0.0.0:8443",
+			fmt.Sprintf("ORDERER_OPERATIONS_LISTENADDRESS=0.0.0.0:%d", opsPort),
 			"ORDERER_METRICS_PROVIDER=prometheus",
 		},
 		"working_dir": "/opt/gopath/src/github.com/hyperledger/fabric",
 		"command":     "orderer",
 		"volumes": []string{
 			fmt.Sprintf("%s/genesis.block:/var/hyperledger/orderer/orderer.genesis.block", net.ConfigPath),
-			fmt.Sprintf("%s/ordererOrganizations/example.com/orderers/%s/msp:/var/hyperledger/orderer/msp", net.CryptoPath, orderer.Name),
-			fmt.Sprintf("%s/ordererOrganizations/example.com/orderers/%s/tls:/var/hyperledger/orderer/tls", net.CryptoPath, orderer.Name),
+			fmt.Sprintf("%s/ordererOrganizations/%s/orderers/%s/msp:/var/hyperledger/orderer/msp", net.CryptoPath, ordererOrg.Domain, orderer.Name),
+			fmt.Sprintf("%s/ordererOrganizations/%s/orderers/%s/tls:/var/hyperledger/orderer/tls", net.CryptoPath, ordererOrg.Domain, orderer.Name),
 			fmt.Sprintf("%s:/var/hyperledger/production/orderer", orderer.Name),
 		},
 		"ports": []string{
 			fmt.Sprintf("%d:%d", orderer.Port, orderer.Port),
-			"8443:8443",
+			fmt.Sprintf("%d:%d", opsPort, opsPort),
 		},
 		"networks": []string{"fabricx"},
 	}
 }
 
-func generateCAService(net *Network, org *Organization) map[string]interface{} {
-	caName := fmt.Sprintf("ca.%s", org.Domain)
+func generateCAService(net *Network, ca *certAuthority, caName string, port int) map[string]interface{} {
+	environment := []string{
+		"FABRIC_CA_HOME=/etc/hyperledger/fabric-ca-server",
+		fmt.Sprintf("FABRIC_CA_SERVER_CA_NAME=%s", caName),
+		"FABRIC_CA_SERVER_TLS_ENABLED=false",
+		fmt.Sprintf("FABRIC_CA_SERVER_PORT=%d", port),
+		fmt.Sprintf("FABRIC_CA_SERVER_CSR_CN=%s", caName),
+		fmt.Sprintf("FABRIC_CA_SERVER_CSR_HOSTS=%s,localhost", caName),
+	}
+
+	var volumes []string
+	if net.cryptoProvider() == CryptoProviderCA {
+		// The CA owns its root key; keep its state with the network
+		volumes = []string{
+			fmt.Sprintf("%s/fabric-ca/%s:/etc/hyperledger/fabric-ca-server", net.BasePath, caName),
+		}
+	} else {
+		// Serve the cryptogen root so newly enrolled identities chain to the org MSP
+		environment = append(environment,
+			fmt.Sprintf("FABRIC_CA_SERVER_CA_CERTFILE=/etc/hyperledger/fabric-ca-server-config/%s-cert.pem", caName),
+			"FABRIC_CA_SERVER_CA_KEYFILE=/etc/hyperledger/fabric-ca-server-config/priv_sk",
+		)
+		volumes = []string{
+			fmt.Sprintf("%s/%s/ca/:/etc/hyperledger/fabric-ca-server-config", net.CryptoPath, ca.OrgDir),
+		}
+	}
+
 	return map[string]interface{}{
 		"container_name": caName,
-		"image":          "hyperledger/fabric-ca:1.5",
-		"environment": []string{
-			"FABRIC_CA_HOME=/etc/hyperledger/fabric-ca-server",
-			fmt.Sprintf("FABRIC_CA_SERVER_CA_NAME=%s", caName),
-			"FABRIC_CA_SERVER_TLS_ENABLED=false",
-			fmt.Sprintf("FABRIC_CA_SERVER_PORT=%d", org.CAPort),
-		},
+		"image":          fabricCAImage,
+		"environment":    environment,
 		"ports": []string{
-			fmt.Sprintf("%d:%d", org.CAPort, org.CAPort),
-		},
-		"command": "sh -c 'fabric-ca-server start -b admin:adminpw -d'",
-		"volumes": []string{
-			fmt.Sprintf("%s/peerOrganizations/%s/ca/:/etc/hyperledger/fabric-ca-server-config", net.CryptoPath, org.Domain),
+			fmt.Sprintf("%d:%d", port, port),
 		},
+		"command":  "sh -c 'fabric-ca-server start -b admin:adminpw -d'",
+		"volumes":  volumes,
 		"networks": []string{"fabricx"},
 	}
 }
@@ -182,6 +218,10 @@ func generatePeerService(net *Network, org *Organization, peer *Peer, index int,
 			
 			"CORE_OPERATIONS_LISTENADDRESS=0.0.0.0:9443",
 			"CORE_METRICS_PROVIDER=prometheus",
+
+			// The peer image ships the ccaas_builder external builder; it
+			// passes this config to chaincode-as-a-service packages
+			fmt.Sprintf(`CHAINCODE_AS_A_SERVICE_BUILDER_CONFIG={"peername":"%s"}`, peer.Name),
 		},
 		"working_dir": "/opt/gopath/src/github.com/hyperledger/fabric/peer",
 		"command":     "peer node start",
@@ -196,11 +236,17 @@ func generatePeerService(net *Network, org *Organization, peer *Peer, index int,
 		},
 		"ports": []string{
 			fmt.Sprintf("%d:%d", peer.Port, peer.Port),
-			fmt.Sprintf("%d:9443", 9443+(globalIndex*1000)),
+			fmt.Sprintf("%d:9443", peerOpsPort(globalIndex)),
 		},
 		"networks": []string{"fabricx"},
 	}
 
+	// Raised while chaincode runs under a debugger
+	if net.chaincodeExecuteTimeout > 0 {
+		service["environment"] = append(service["environment"].([]string),
+			fmt.Sprintf("CORE_CHAINCODE_EXECUTETIMEOUT=%s", net.chaincodeExecuteTimeout))
+	}
+
 	// Add CouchDB dependency if enabled
 	if peer.CouchDB {
 		couchName := fmt.Sprintf("couchdb%d.%s", index, org.Domain)
@@ -371,9 +417,13 @@ func generateCLIService(net *Network) map[string]interface{} {
 				net.CryptoPath, o.Domain, o.Domain))
 	}
 
-	volumes = append(volumes,
-		fmt.Sprintf("%s/ordererOrganizations/example.com/orderers/orderer.example.com/tls:/etc/hyperledger/fabric/crypto/ordererOrganizations/example.com/orderers/orderer.example.com/tls",
-			net.CryptoPath))
+	// Mount the TLS material of every orderer node
+	ordererDomain := net.ordererOrg().Domain
+	for _, o := range net.Orderers {
+		tlsDir := fmt.Sprintf("ordererOrganizations/%s/orderers/%s/tls", ordererDomain, o.Name)
+		volumes = append(volumes,
+			fmt.Sprintf("%s/%s:/etc/hyperledger/fabric/crypto/%s", net.CryptoPath, tlsDir, tlsDir))
+	}
 
 	return map[string]interface{}{
 		"container_name": "cli",
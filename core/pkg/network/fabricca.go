@@ -0,0 +1,342 @@
+// core/pkg/network/fabricca.go
+package network
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/utils"
+)
+
+const (
+	// CryptoProviderCryptogen generates all identities offline with cryptogen
+	CryptoProviderCryptogen = "cryptogen"
+	// CryptoProviderCA enrolls all identities through each org's Fabric CA
+	CryptoProviderCA = "ca"
+
+	fabricCAImage = "hyperledger/fabric-ca:1.5"
+
+	// The orderer org has no CAPort of its own
+	ordererCAPort = 6054
+)
+
+// certAuthority describes the Fabric CA servers backing one organization
+type certAuthority struct {
+	Domain      string
+	OrgDir      string // Relative to the crypto root, e.g. peerOrganizations/org1.example.com
+	CAName      string
+	CAPort      int
+	TLSCAName   string // Same as CAName unless a separate TLS CA runs
+	TLSCAPort   int
+	SeparateTLS bool
+	Nodes       []caNode
+	Users       []caUser
+}
+
+type caNode struct {
+	Name   string // Full hostname, e.g. peer0.org1.example.com
+	Kind   string // peers or orderers
+	Type   string // peer or orderer
+	Secret string
+}
+
+type caUser struct {
+	Dir          string // Directory under users/, e.g. Admin@org1.example.com
+	EnrollmentID string
+	Secret       string
+	Type         string // admin or client
+}
+
+// cryptoProvider returns the configured crypto provider, defaulting to cryptogen
+func (n *Network) cryptoProvider() string {
+	if n.Config == nil || n.Config.CryptoProvider == "" {
+		return CryptoProviderCryptogen
+	}
+	return n.Config.CryptoProvider
+}
+
+// separateTLSCA reports whether every org runs a dedicated TLS CA
+func (n *Network) separateTLSCA() bool {
+	return n.cryptoProvider() == CryptoProviderCA && n.Config.SeparateTLSCA
+}
+
+// tlsCAPort is the port of the separate TLS CA next to a CA
+func tlsCAPort(caPort int) int {
+	return caPort + 1
+}
+
+// peerOrgCA returns the CA layout of a peer organization
+func (n *Network) peerOrgCA(org *Organization) *certAuthority {
+	ca := &certAuthority{
+		Domain:    org.Domain,
+		OrgDir:    filepath.Join("peerOrganizations", org.Domain),
+		CAName:    fmt.Sprintf("ca.%s", org.Domain),
+		CAPort:    org.CAPort,
+		TLSCAName: fmt.Sprintf("ca.%s", org.Domain),
+		TLSCAPort: org.CAPort,
+		Users: []caUser{
+			{Dir: fmt.Sprintf("Admin@%s", org.Domain), EnrollmentID: "orgadmin", Secret: "orgadminpw", Type: "admin"},
+			{Dir: fmt.Sprintf("User1@%s", org.Domain), EnrollmentID: "user1", Secret: "user1pw", Type: "client"},
+		},
+	}
+	if n.separateTLSCA() {
+		ca.TLSCAName = fmt.Sprintf("tlsca.%s", org.Domain)
+		ca.TLSCAPort = tlsCAPort(org.CAPort)
+		ca.SeparateTLS = true
+	}
+
+	for _, peer := range org.Peers {
+		ca.Nodes = append(ca.Nodes, caNode{Name: peer.Name, Kind: "peers", Type: "peer", Secret: peer.Name + "-pw"})
+	}
+
+	return ca
+}
+
+// ordererOrgCA returns the CA layout of the orderer organization
+func (n *Network) ordererOrgCA() *certAuthority {
+	ordererOrg := n.ordererOrg()
+	ca := &certAuthority{
+		Domain:    ordererOrg.Domain,
+		OrgDir:    filepath.Join("ordererOrganizations", ordererOrg.Domain),
+		CAName:    fmt.Sprintf("ca.%s", ordererOrg.Domain),
+		CAPort:    ordererCAPort,
+		TLSCAName: fmt.Sprintf("ca.%s", ordererOrg.Domain),
+		TLSCAPort: ordererCAPort,
+		Users: []caUser{
+			{Dir: fmt.Sprintf("Admin@%s", ordererOrg.Domain), EnrollmentID: "ordereradmin", Secret: "ordereradminpw", Type: "admin"},
+		},
+	}
+	if n.separateTLSCA() {
+		ca.TLSCAName = fmt.Sprintf("tlsca.%s", ordererOrg.Domain)
+		ca.TLSCAPort = tlsCAPort(ordererCAPort)
+		ca.SeparateTLS = true
+	}
+
+	for _, orderer := range n.Orderers {
+		ca.Nodes = append(ca.Nodes, caNode{Name: orderer.Name, Kind: "orderers", Type: "orderer", Secret: orderer.Name + "-pw"})
+	}
+
+	return ca
+}
+
+// certAuthorities lists the CA layout of every org that runs a CA server.
+// The orderer org only gets one when identities are enrolled through Fabric CA.
+func (n *Network) certAuthorities() []*certAuthority {
+	cas := []*certAuthority{}
+	if n.cryptoProvider() == CryptoProviderCA {
+		cas = append(cas, n.ordererOrgCA())
+	}
+	for _, org := range n.Orgs {
+		cas = append(cas, n.peerOrgCA(org))
+	}
+	return cas
+}
+
+// caServiceNames lists the compose services of every CA server
+func (n *Network) caServiceNames() []string {
+	names := []string{}
+	for _, ca := range n.certAuthorities() {
+		names = append(names, ca.CAName)
+		if ca.SeparateTLS {
+			names = append(names, ca.TLSCAName)
+		}
+	}
+	return names
+}
+
+// enrollWithFabricCA starts the CA servers and enrolls every peer, orderer,
+// admin and user, producing the same directory layout as cryptogen
+func enrollWithFabricCA(ctx context.Context, net *Network) error {
+	scriptDir := filepath.Join(net.ConfigPath, "fabric-ca")
+	if err := utils.EnsureDir(scriptDir); err != nil {
+		return errors.Wrap("enrollWithFabricCA.EnsureDir", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(scriptDir, "config.yaml"), []byte(nodeOUConfig), 0644); err != nil {
+		return errors.Wrap("enrollWithFabricCA.WriteNodeOUs", err)
+	}
+
+	cas := net.certAuthorities()
+	for _, ca := range cas {
+		script, err := renderEnrollScript(ca)
+		if err != nil {
+			return errors.Wrap("enrollWithFabricCA.RenderScript", err)
+		}
+		scriptPath := filepath.Join(scriptDir, fmt.Sprintf("enroll-%s.sh", ca.Domain))
+		if err := os.WriteFile(scriptPath, script, 0755); err != nil {
+			return errors.Wrap("enrollWithFabricCA.WriteScript", err)
+		}
+	}
+
+	// The CA servers are regular compose services, so the compose file has
+	// to exist before any identity is issued
+	if err := generateDockerCompose(net); err != nil {
+		return errors.Wrap("enrollWithFabricCA.GenerateDockerCompose", err)
+	}
+
+	composePath := filepath.Join(net.ConfigPath, "docker-compose.yaml")
+	projectName := fmt.Sprintf("fabricx-%s", net.ID)
+
+	exec := net.exec
+	args := append([]string{"-f", composePath, "-p", projectName, "up", "-d"}, net.caServiceNames()...)
+	output, err := exec.ExecuteCombined(ctx, "docker-compose", args...)
+	if err != nil {
+		return errors.WrapWithContext("enrollWithFabricCA.StartCAs", errors.ErrContainerFailed, map[string]interface{}{
+			"error":  err.Error(),
+			"output": string(output),
+		})
+	}
+
+	for _, ca := range cas {
+		if err := ctx.Err(); err != nil {
+			stopCAs(net, composePath, projectName)
+			return errors.Wrap("enrollWithFabricCA", err)
+		}
+
+		fmt.Printf("🔐 Enrolling %s identities with %s...\n", ca.Domain, ca.CAName)
+
+		output, err := exec.ExecuteCombined(ctx, "docker", "run", "--rm",
+			"--network", fmt.Sprintf("fabricx_%s", net.ID),
+			"-v", fmt.Sprintf("%s:/crypto-config", net.CryptoPath),
+			"-v", fmt.Sprintf("%s:/scripts", scriptDir),
+			fabricCAImage,
+			"sh", fmt.Sprintf("/scripts/enroll-%s.sh", ca.Domain),
+		)
+		if err != nil {
+			stopCAs(net, composePath, projectName)
+			return errors.WrapWithContext("enrollWithFabricCA", errors.ErrCryptoGenFailed, map[string]interface{}{
+				"org":    ca.Domain,
+				"ca":     ca.CAName,
+				"error":  err.Error(),
+				"output": string(output),
+			})
+		}
+	}
+
+	return nil
+}
+
+// stopCAs tears the CA servers down after a failed enrollment
+func stopCAs(net *Network, composePath, projectName string) {
+	if output, err := net.exec.ExecuteCombined(context.Background(), "docker-compose",
+		"-f", composePath, "-p", projectName, "down", "-v"); err != nil {
+		fmt.Printf("Warning: failed to stop CA containers: %v (%s)\n", err, string(output))
+	}
+}
+
+func renderEnrollScript(ca *certAuthority) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := enrollScriptTemplate.Execute(&buf, ca); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// nodeOUConfig classifies identities by the OU fabric-ca derives from their type
+const nodeOUConfig = `NodeOUs:
+  Enable: true
+  ClientOUIdentifier:
+    Certificate: cacerts/ca.pem
+    OrganizationalUnitIdentifier: client
+  PeerOUIdentifier:
+    Certificate: cacerts/ca.pem
+    OrganizationalUnitIdentifier: peer
+  AdminOUIdentifier:
+    Certificate: cacerts/ca.pem
+    OrganizationalUnitIdentifier: admin
+  OrdererOUIdentifier:
+    Certificate: cacerts/ca.pem
+    OrganizationalUnitIdentifier: orderer
+`
+
+var enrollScriptTemplate = template.Must(template.New("enroll").Parse(`#!/bin/sh
+# Generated by FabricX: enrolls {{.Domain}} identities through Fabric CA
+set -e
+
+ORG=/crypto-config/{{.OrgDir}}
+CA_URL=http://{{.CAName}}:{{.CAPort}}
+TLSCA_URL=http://{{.TLSCAName}}:{{.TLSCAPort}}
+
+wait_for_ca() {
+  for i in $(seq 1 60); do
+    if fabric-ca-client getcainfo -u "$1" --caname "$2" -M /tmp/wait-$2 >/dev/null 2>&1; then
+      return 0
+    fi
+    sleep 1
+  done
+  echo "CA $2 at $1 did not become ready" >&2
+  exit 1
+}
+
+# Rename enrolled files to the cryptogen layout
+normalize_msp() {
+  mv "$1"/cacerts/* "$1"/cacerts/ca.pem
+  mv "$1"/keystore/* "$1"/keystore/priv_sk
+  rm -rf "$1"/IssuerPublicKey "$1"/IssuerRevocationPublicKey "$1"/user
+  mkdir -p "$1"/tlscacerts
+  cp "$ORG"/msp/tlscacerts/ca.crt "$1"/tlscacerts/ca.crt
+  cp /scripts/config.yaml "$1"/config.yaml
+}
+
+register() {
+  FABRIC_CA_CLIENT_HOME=/tmp/ca-admin fabric-ca-client register -u "$CA_URL" --caname {{.CAName}} \
+    --id.name "$1" --id.secret "$2" --id.type "$3"
+{{- if .SeparateTLS}}
+  FABRIC_CA_CLIENT_HOME=/tmp/tlsca-admin fabric-ca-client register -u "$TLSCA_URL" --caname {{.TLSCAName}} \
+    --id.name "$1" --id.secret "$2" --id.type "$3"
+{{- end}}
+}
+
+# enroll_tls <id> <secret> <hosts> <dir> <cert name> <key name>
+enroll_tls() {
+  fabric-ca-client enroll -u "http://$1:$2@{{.TLSCAName}}:{{.TLSCAPort}}" --caname {{.TLSCAName}} \
+    --enrollment.profile tls --csr.hosts "$3" -M /tmp/tls-$1
+  mkdir -p "$4"
+  cp /tmp/tls-$1/tlscacerts/* "$4"/ca.crt
+  cp /tmp/tls-$1/signcerts/* "$4"/$5
+  cp /tmp/tls-$1/keystore/* "$4"/$6
+}
+
+wait_for_ca "$CA_URL" {{.CAName}}
+{{- if .SeparateTLS}}
+wait_for_ca "$TLSCA_URL" {{.TLSCAName}}
+{{- end}}
+
+FABRIC_CA_CLIENT_HOME=/tmp/ca-admin fabric-ca-client enroll \
+  -u "http://admin:adminpw@{{.CAName}}:{{.CAPort}}" --caname {{.CAName}}
+{{- if .SeparateTLS}}
+FABRIC_CA_CLIENT_HOME=/tmp/tlsca-admin fabric-ca-client enroll \
+  -u "http://admin:adminpw@{{.TLSCAName}}:{{.TLSCAPort}}" --caname {{.TLSCAName}}
+{{- end}}
+
+# Organization MSP
+mkdir -p "$ORG"/msp/cacerts "$ORG"/msp/tlscacerts "$ORG"/ca "$ORG"/tlsca
+cp /tmp/ca-admin/msp/cacerts/* "$ORG"/msp/cacerts/ca.pem
+fabric-ca-client getcainfo -u "$TLSCA_URL" --caname {{.TLSCAName}} -M /tmp/tlsca-info
+cp /tmp/tlsca-info/cacerts/* "$ORG"/msp/tlscacerts/ca.crt
+cp "$ORG"/msp/cacerts/ca.pem "$ORG"/ca/{{.CAName}}-cert.pem
+cp "$ORG"/msp/tlscacerts/ca.crt "$ORG"/tlsca/tlsca.{{.Domain}}-cert.pem
+cp /scripts/config.yaml "$ORG"/msp/config.yaml
+{{range .Nodes}}
+# {{.Name}}
+register {{.Name}} {{.Secret}} {{.Type}}
+fabric-ca-client enroll -u "http://{{.Name}}:{{.Secret}}@{{$.CAName}}:{{$.CAPort}}" --caname {{$.CAName}} \
+  --csr.hosts {{.Name}} -M "$ORG"/{{.Kind}}/{{.Name}}/msp
+normalize_msp "$ORG"/{{.Kind}}/{{.Name}}/msp
+enroll_tls {{.Name}} {{.Secret}} "{{.Name}},localhost,127.0.0.1" "$ORG"/{{.Kind}}/{{.Name}}/tls server.crt server.key
+{{end}}
+{{- range .Users}}
+# {{.Dir}}
+register {{.EnrollmentID}} {{.Secret}} {{.Type}}
+fabric-ca-client enroll -u "http://{{.EnrollmentID}}:{{.Secret}}@{{$.CAName}}:{{$.CAPort}}" --caname {{$.CAName}} \
+  -M "$ORG"/users/{{.Dir}}/msp
+normalize_msp "$ORG"/users/{{.Dir}}/msp
+enroll_tls {{.EnrollmentID}} {{.Secret}} "{{.EnrollmentID}}" "$ORG"/users/{{.Dir}}/tls client.crt client.key
+{{end}}
+echo "Enrolled {{.Domain}} identities"
+`))
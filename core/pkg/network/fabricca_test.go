@@ -0,0 +1,201 @@
+// core/pkg/network/fabricca_test.go
+package network
+
+import (
+	"context"
+	stdErr "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+	"github.com/temmyjay001/core/pkg/utils"
+)
+
+func TestBootstrapWithFabricCA(t *testing.T) {
+	tests := []struct {
+		name          string
+		separateTLSCA bool
+		wantCAs       []string
+	}{
+		{
+			name:    "shared TLS CA",
+			wantCAs: []string{"ca.example.com", "ca.org1.example.com", "ca.org2.example.com"},
+		},
+		{
+			name:          "separate TLS CA",
+			separateTLSCA: true,
+			wantCAs: []string{
+				"ca.example.com", "tlsca.example.com",
+				"ca.org1.example.com", "tlsca.org1.example.com",
+				"ca.org2.example.com", "tlsca.org2.example.com",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockExec := executor.NewMockExecutor()
+			mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+				return []byte("success"), nil
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			net, err := Bootstrap(ctx, &Config{
+				CryptoProvider: CryptoProviderCA,
+				SeparateTLSCA:  tt.separateTLSCA,
+			}, mockExec)
+			if err != nil {
+				t.Fatalf("Bootstrap() error = %v", err)
+			}
+			defer net.Cleanup()
+
+			var started []string
+			enrolled := 0
+			for _, call := range mockExec.Calls {
+				if contains(call.Args, "cryptogen") {
+					t.Error("cryptogen must not run in CA mode")
+				}
+				if call.Name == "docker-compose" && contains(call.Args, "up") {
+					started = call.Args[len(call.Args)-len(tt.wantCAs):]
+				}
+				if contains(call.Args, fabricCAImage) {
+					enrolled++
+				}
+			}
+
+			if strings.Join(started, ",") != strings.Join(tt.wantCAs, ",") {
+				t.Errorf("Expected CA services %v to be started, got %v", tt.wantCAs, started)
+			}
+
+			// One enrollment run for the orderer org and one per peer org
+			if enrolled != 3 {
+				t.Errorf("Expected 3 enrollment runs, got %d", enrolled)
+			}
+
+			var compose map[string]interface{}
+			if err := utils.ReadYAML(filepath.Join(net.ConfigPath, "docker-compose.yaml"), &compose); err != nil {
+				t.Fatalf("Failed to read docker-compose.yaml: %v", err)
+			}
+			services := compose["services"].(map[string]interface{})
+			for _, name := range tt.wantCAs {
+				if _, ok := services[name]; !ok {
+					t.Errorf("Expected CA service %s in docker-compose.yaml", name)
+				}
+			}
+		})
+	}
+}
+
+func TestEnrollScript(t *testing.T) {
+	net := &Network{
+		Config:   &Config{CryptoProvider: CryptoProviderCA, SeparateTLSCA: true},
+		Orgs:     generateOrganizations(1),
+		Orderers: generateOrderers(),
+	}
+
+	script, err := renderEnrollScript(net.peerOrgCA(net.Orgs[0]))
+	if err != nil {
+		t.Fatalf("renderEnrollScript() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"register peer0.org1.example.com peer0.org1.example.com-pw peer",
+		"register orgadmin orgadminpw admin",
+		"register user1 user1pw client",
+		`-M "$ORG"/peers/peer0.org1.example.com/msp`,
+		`"$ORG"/users/Admin@org1.example.com/msp`,
+		"--caname tlsca.org1.example.com",
+		"http://tlsca.org1.example.com:7055",
+		"--enrollment.profile tls",
+	} {
+		if !strings.Contains(string(script), want) {
+			t.Errorf("Expected enrollment script to contain %q", want)
+		}
+	}
+
+	ordererScript, err := renderEnrollScript(net.ordererOrgCA())
+	if err != nil {
+		t.Fatalf("renderEnrollScript() error = %v", err)
+	}
+
+	if !strings.Contains(string(ordererScript), `"$ORG"/orderers/orderer.example.com/tls server.crt server.key`) {
+		t.Error("Expected orderer TLS material in the cryptogen layout")
+	}
+}
+
+func TestEnrollWithFabricCAFailure(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "fabricx-ca-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		if contains(args, fabricCAImage) {
+			return []byte("Error: authentication failure"), fmt.Errorf("exit status 1")
+		}
+		return []byte("success"), nil
+	}
+
+	net := &Network{
+		ID:         "test123",
+		Config:     &Config{CryptoProvider: CryptoProviderCA},
+		BasePath:   tmpDir,
+		ConfigPath: filepath.Join(tmpDir, "config"),
+		CryptoPath: filepath.Join(tmpDir, "crypto-config"),
+		Orgs:       generateOrganizations(1),
+		Orderers:   generateOrderers(),
+		Channel:    &Channel{Name: "mychannel", ProfileName: "FabricXChannel"},
+		exec:       mockExec,
+	}
+
+	err = enrollWithFabricCA(context.Background(), net)
+	if err == nil {
+		t.Fatal("Expected enrollment error")
+	}
+
+	if !stdErr.Is(err, errors.ErrCryptoGenFailed) {
+		t.Errorf("Expected ErrCryptoGenFailed, got %v", err)
+	}
+
+	// CA containers are torn down on failure
+	lastCall := mockExec.Calls[len(mockExec.Calls)-1]
+	if lastCall.Name != "docker-compose" || !contains(lastCall.Args, "down") {
+		t.Errorf("Expected CA containers to be stopped, last call was %s %v", lastCall.Name, lastCall.Args)
+	}
+}
+
+func TestCAServiceUsesCryptogenRoot(t *testing.T) {
+	net := &Network{
+		CryptoPath: "/tmp/crypto",
+		Orgs:       generateOrganizations(1),
+		Orderers:   generateOrderers(),
+	}
+
+	ca := net.peerOrgCA(net.Orgs[0])
+	service := generateCAService(net, ca, ca.CAName, ca.CAPort)
+
+	env := service["environment"].([]string)
+	if !contains(env, "FABRIC_CA_SERVER_CA_CERTFILE=/etc/hyperledger/fabric-ca-server-config/ca.org1.example.com-cert.pem") {
+		t.Errorf("Expected CA to serve the cryptogen root certificate, got %v", env)
+	}
+
+	if len(net.certAuthorities()) != 1 {
+		t.Errorf("Expected no orderer CA in cryptogen mode, got %d CAs", len(net.certAuthorities()))
+	}
+}
+
+func TestBootstrapInvalidCryptoProvider(t *testing.T) {
+	_, err := Bootstrap(context.Background(), &Config{CryptoProvider: "hsm"}, executor.NewMockExecutor())
+	if !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig, got %v", err)
+	}
+}
@@ -0,0 +1,511 @@
+// core/pkg/network/identity.go
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+// Identity types accepted by Fabric CA
+const (
+	IdentityTypeClient  = "client"
+	IdentityTypeAdmin   = "admin"
+	IdentityTypePeer    = "peer"
+	IdentityTypeOrderer = "orderer"
+)
+
+// DefaultIdentity is the identity used when a request names none
+const DefaultIdentity = "Admin"
+
+// Identity is an identity registered with an organization's CA
+type Identity struct {
+	Name           string
+	Type           string
+	Affiliation    string
+	Attributes     map[string]string
+	MaxEnrollments int // 0 uses the CA default, -1 means unlimited
+}
+
+var (
+	identityNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	attrNamePattern     = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	identityLinePattern = regexp.MustCompile(`^Name: (.*?), Type: (.*?), Affiliation: (.*?), Max Enrollments: (-?\d+), Attributes: \[(.*)\]$`)
+	attributePattern    = regexp.MustCompile(`\{Name:(\S+) Value:(.*?) ECert:(true|false)\}`)
+	passwordPattern     = regexp.MustCompile(`(?m)^Password: (\S+)\s*$`)
+)
+
+// GetOrg looks up a peer organization by name or MSP ID
+func (n *Network) GetOrg(name string) (*Organization, error) {
+	for _, org := range n.Orgs {
+		if org.Name == name || org.MSPID == name {
+			return org, nil
+		}
+	}
+
+	return nil, errors.WrapWithContext("GetOrg", errors.ErrInvalidConfig, map[string]interface{}{
+		"org":    name,
+		"reason": "unknown organization",
+	})
+}
+
+// Signer is an org member whose MSP signs proposals and transactions
+type Signer struct {
+	Org  *Organization
+	Name string // Identity name without the domain, e.g. User1
+}
+
+// MSPConfigPath returns the signer's MSP directory inside the CLI container
+func (s *Signer) MSPConfigPath() string {
+	return fmt.Sprintf("/etc/hyperledger/fabric/crypto/peerOrganizations/%s/users/%s@%s/msp", s.Org.Domain, s.Name, s.Org.Domain)
+}
+
+// ResolveIdentity picks the org and identity a transaction is signed with.
+// The identity may carry its org after the @, as the domain
+// (User1@org2.example.com), the org name or the domain's first label
+// (User1@org2), in which case the org can be omitted. Both default to the
+// first org's admin.
+func (n *Network) ResolveIdentity(orgName, identity string) (*Signer, error) {
+	name, domain, qualified := strings.Cut(identity, "@")
+
+	var org *Organization
+	switch {
+	case orgName != "":
+		found, err := n.GetOrg(orgName)
+		if err != nil {
+			return nil, errors.Wrap("ResolveIdentity", err)
+		}
+		org = found
+	case qualified:
+		for _, o := range n.Orgs {
+			if identityOrgMatches(o, domain) {
+				org = o
+			}
+		}
+	default:
+		org = n.Orgs[0]
+	}
+
+	if org == nil || (qualified && !identityOrgMatches(org, domain)) {
+		return nil, errors.WrapWithContext("ResolveIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"org":      orgName,
+			"identity": identity,
+			"reason":   "identity org does not match any organization",
+		})
+	}
+
+	if name == "" {
+		name = DefaultIdentity
+	}
+	if !identityNamePattern.MatchString(name) {
+		return nil, errors.WrapWithContext("ResolveIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"identity": identity,
+			"reason":   "invalid identity name",
+		})
+	}
+
+	// The admin always exists; anything else must have been enrolled
+	if name != DefaultIdentity {
+		if _, err := os.Stat(n.IdentityMSPPath(org, name)); err != nil {
+			return nil, errors.WrapWithContext("ResolveIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+				"org":      org.Name,
+				"identity": name,
+				"reason":   "identity has no enrolled MSP; enroll it first",
+			})
+		}
+	}
+
+	return &Signer{Org: org, Name: name}, nil
+}
+
+// identityOrgMatches reports whether the part of an identity after the @
+// names an org, by domain, name or the domain's first label
+func identityOrgMatches(org *Organization, qualifier string) bool {
+	label, _, _ := strings.Cut(org.Domain, ".")
+	return qualifier == org.Domain || strings.EqualFold(qualifier, org.Name) || strings.EqualFold(qualifier, label)
+}
+
+// IdentityMSPPath returns the host path of an enrolled identity's MSP
+func (n *Network) IdentityMSPPath(org *Organization, name string) string {
+	return filepath.Join(n.CryptoPath, "peerOrganizations", org.Domain, "users", fmt.Sprintf("%s@%s", name, org.Domain), "msp")
+}
+
+// RegisterIdentity registers an identity with the org's CA and returns its
+// enrollment secret. The CA generates one when secret is empty.
+func (n *Network) RegisterIdentity(ctx context.Context, orgName string, id *Identity, secret string) (string, error) {
+	org, err := n.GetOrg(orgName)
+	if err != nil {
+		return "", errors.Wrap("RegisterIdentity", err)
+	}
+
+	if err := validateIdentity(id); err != nil {
+		return "", errors.Wrap("RegisterIdentity", err)
+	}
+
+	ca := n.peerOrgCA(org)
+	args := []string{"fabric-ca-client", "register",
+		"-u", fmt.Sprintf("http://%s:%d", ca.CAName, ca.CAPort),
+		"--caname", ca.CAName,
+		"--id.name", id.Name,
+		"--id.type", id.Type,
+	}
+	if secret != "" {
+		args = append(args, "--id.secret", secret)
+	}
+	if id.Affiliation != "" {
+		args = append(args, "--id.affiliation", id.Affiliation)
+	}
+	if id.MaxEnrollments != 0 {
+		args = append(args, "--id.maxenrollments", strconv.Itoa(id.MaxEnrollments))
+	}
+	if len(id.Attributes) > 0 {
+		// Custom attributes go into the enrollment certificate so
+		// chaincode can read them with the client identity library
+		attrs := []string{}
+		for _, name := range sortedAttrNames(id.Attributes) {
+			attrs = append(attrs, fmt.Sprintf("%s=%s:ecert", name, id.Attributes[name]))
+		}
+		args = append(args, "--id.attrs", strings.Join(attrs, ","))
+	}
+
+	output, err := n.runCAClient(ctx, org, args)
+	if err != nil {
+		return "", errors.WrapWithContext("RegisterIdentity", err, map[string]interface{}{
+			"org":      org.Name,
+			"identity": id.Name,
+		})
+	}
+
+	if secret == "" {
+		match := passwordPattern.FindSubmatch(output)
+		if match == nil {
+			return "", errors.WrapWithContext("RegisterIdentity", errors.ErrIdentityFailed, map[string]interface{}{
+				"identity": id.Name,
+				"reason":   "CA did not return an enrollment secret",
+				"output":   string(output),
+			})
+		}
+		secret = string(match[1])
+	}
+
+	return secret, nil
+}
+
+// EnrollIdentity enrolls a registered identity and stores its MSP under the
+// org's users directory, replacing any earlier enrollment. attrs restricts
+// the attributes placed in the certificate; empty keeps the registered ones.
+func (n *Network) EnrollIdentity(ctx context.Context, orgName, name, secret string, attrs []string) (string, error) {
+	org, err := n.GetOrg(orgName)
+	if err != nil {
+		return "", errors.Wrap("EnrollIdentity", err)
+	}
+
+	if !identityNamePattern.MatchString(name) {
+		return "", errors.WrapWithContext("EnrollIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"identity": name,
+			"reason":   "invalid identity name",
+		})
+	}
+	if secret == "" {
+		return "", errors.WrapWithContext("EnrollIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"identity": name,
+			"reason":   "enrollment secret is required",
+		})
+	}
+
+	ca := n.peerOrgCA(org)
+	orgDir := "/crypto-config/" + ca.OrgDir
+	mspDir := fmt.Sprintf("%s/users/%s@%s/msp", orgDir, name, org.Domain)
+
+	enroll := []string{"fabric-ca-client", "enroll",
+		"-u", fmt.Sprintf("http://%s:%s@%s:%d", name, secret, ca.CAName, ca.CAPort),
+		"--caname", ca.CAName,
+		"-M", mspDir,
+	}
+	for _, attr := range attrs {
+		enroll = append(enroll, "--enrollment.attrs", attr)
+	}
+
+	// Lay the MSP out like the cryptogen ones so the CLI can use it as is
+	output, err := n.runCAClient(ctx, org,
+		[]string{"rm", "-rf", mspDir},
+		enroll,
+		[]string{"sh", "-c", fmt.Sprintf(
+			"rm -f %[1]s/cacerts/* && cp %[2]s/msp/cacerts/* %[1]s/cacerts/ && mv %[1]s/keystore/* %[1]s/keystore/priv_sk && "+
+				"mkdir -p %[1]s/tlscacerts && cp %[2]s/msp/tlscacerts/* %[1]s/tlscacerts/ && cp %[2]s/msp/config.yaml %[1]s/config.yaml",
+			mspDir, orgDir)},
+	)
+	if err != nil {
+		return "", errors.WrapWithContext("EnrollIdentity", err, map[string]interface{}{
+			"org":      org.Name,
+			"identity": name,
+			"output":   string(output),
+		})
+	}
+
+	return n.IdentityMSPPath(org, name), nil
+}
+
+// RevokeIdentity revokes every certificate of an identity, regenerates the
+// CA's CRL and publishes it in the org MSP folder and in the org's MSP on
+// every channel, so peers stop accepting the certificates
+func (n *Network) RevokeIdentity(ctx context.Context, orgName, name, reason string) error {
+	org, err := n.GetOrg(orgName)
+	if err != nil {
+		return errors.Wrap("RevokeIdentity", err)
+	}
+
+	if !identityNamePattern.MatchString(name) {
+		return errors.WrapWithContext("RevokeIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"identity": name,
+			"reason":   "invalid identity name",
+		})
+	}
+
+	ca := n.peerOrgCA(org)
+	args := []string{"fabric-ca-client", "revoke",
+		"-u", fmt.Sprintf("http://%s:%d", ca.CAName, ca.CAPort),
+		"--caname", ca.CAName,
+		"-e", name,
+		"--gencrl",
+	}
+	if reason != "" {
+		args = append(args, "-r", reason)
+	}
+
+	// The CRL is written to the registrar's home in the throwaway container;
+	// keep it in the org MSP, which is mounted from the host
+	crlDir := fmt.Sprintf("/crypto-config/%s/msp/crls", ca.OrgDir)
+	if _, err := n.runCAClient(ctx, org, args,
+		[]string{"mkdir", "-p", crlDir},
+		[]string{"cp", "/tmp/ca-admin/msp/crls/crl.pem", crlDir + "/crl.pem"},
+	); err != nil {
+		return errors.WrapWithContext("RevokeIdentity", err, map[string]interface{}{
+			"org":      org.Name,
+			"identity": name,
+		})
+	}
+
+	crl, err := os.ReadFile(n.CRLPath(org))
+	if err != nil {
+		return errors.WrapWithContext("RevokeIdentity", err, map[string]interface{}{
+			"org":      org.Name,
+			"identity": name,
+		})
+	}
+
+	for _, ch := range n.AllChannels() {
+		if !n.isChannelMember(ch, org) {
+			continue
+		}
+		if err := n.updateRevocationList(ctx, ch, org, crl); err != nil {
+			return errors.WrapWithContext("RevokeIdentity", err, map[string]interface{}{
+				"org":      org.Name,
+				"identity": name,
+				"channel":  ch.Name,
+			})
+		}
+	}
+
+	return nil
+}
+
+// CRLPath returns the host path of the CRL in an org's MSP folder
+func (n *Network) CRLPath(org *Organization) string {
+	return filepath.Join(n.CryptoPath, "peerOrganizations", org.Domain, "msp", "crls", "crl.pem")
+}
+
+func (n *Network) isChannelMember(ch *Channel, org *Organization) bool {
+	for _, member := range n.ChannelOrgs(ch) {
+		if member == org {
+			return true
+		}
+	}
+	return false
+}
+
+// updateRevocationList replaces the revocation list of an org's MSP in a
+// channel config with the CRL, signed by the org admin
+func (n *Network) updateRevocationList(ctx context.Context, ch *Channel, org *Organization, crl []byte) error {
+	orderer := fmt.Sprintf("-o %s --tls --cafile %s", n.OrdererEndpoint(), n.OrdererTLSCA())
+	script := strings.Join([]string{
+		"set -e",
+		"dir=$(mktemp -d) && cd $dir",
+		fmt.Sprintf("peer channel fetch config config_block.pb -c %s %s", ch.Name, orderer),
+		"configtxlator proto_decode --input config_block.pb --type common.Block | jq .data.data[0].payload.data.config > config.json",
+		fmt.Sprintf("jq --arg crl %s '.channel_group.groups.Application.groups[%q].values.MSP.value.config.revocation_list = [$crl]' config.json > modified.json",
+			shellQuote(base64.StdEncoding.EncodeToString(crl)), org.Name),
+		"configtxlator proto_encode --input config.json --type common.Config --output config.pb",
+		"configtxlator proto_encode --input modified.json --type common.Config --output modified.pb",
+		fmt.Sprintf("configtxlator compute_update --channel_id %s --original config.pb --updated modified.pb --output update.pb", ch.Name),
+		"configtxlator proto_decode --input update.pb --type common.ConfigUpdate --output update.json",
+		fmt.Sprintf(`jq -n --slurpfile update update.json '{"payload":{"header":{"channel_header":{"channel_id":%q,"type":2}},"data":{"config_update":$update[0]}}}' > envelope.json`, ch.Name),
+		"configtxlator proto_encode --input envelope.json --type common.Envelope --output envelope.pb",
+		fmt.Sprintf("peer channel update -f envelope.pb -c %s %s", ch.Name, orderer),
+	}, "\n")
+
+	args := []string{"exec",
+		"-e", fmt.Sprintf("CORE_PEER_LOCALMSPID=%s", org.MSPID),
+		"-e", fmt.Sprintf("CORE_PEER_ADDRESS=%s:%d", org.Peers[0].Name, org.Peers[0].Port),
+		"-e", fmt.Sprintf("CORE_PEER_MSPCONFIGPATH=/etc/hyperledger/fabric/crypto/peerOrganizations/%s/users/Admin@%s/msp", org.Domain, org.Domain),
+		"-e", "CORE_PEER_TLS_ENABLED=true",
+		"-e", fmt.Sprintf("CORE_PEER_TLS_ROOTCERT_FILE=/etc/hyperledger/fabric/crypto/peerOrganizations/%s/peers/%s/tls/ca.crt", org.Domain, org.Peers[0].Name),
+		"cli", "sh", "-c", script,
+	}
+	output, err := n.exec.ExecuteCombined(ctx, "docker", args...)
+	if err != nil {
+		return errors.WrapWithContext("updateRevocationList", err, map[string]interface{}{
+			"output": string(output),
+		})
+	}
+	return nil
+}
+
+// ListIdentities lists every identity registered with the org's CA
+func (n *Network) ListIdentities(ctx context.Context, orgName string) ([]*Identity, error) {
+	org, err := n.GetOrg(orgName)
+	if err != nil {
+		return nil, errors.Wrap("ListIdentities", err)
+	}
+
+	ca := n.peerOrgCA(org)
+	output, err := n.runCAClient(ctx, org, []string{"fabric-ca-client", "identity", "list",
+		"-u", fmt.Sprintf("http://%s:%d", ca.CAName, ca.CAPort),
+		"--caname", ca.CAName,
+	})
+	if err != nil {
+		return nil, errors.WrapWithContext("ListIdentities", err, map[string]interface{}{
+			"org": org.Name,
+		})
+	}
+
+	return parseIdentityList(string(output)), nil
+}
+
+// runCAClient enrolls the CA registrar in a throwaway fabric-ca container on
+// the network and then runs the given commands
+func (n *Network) runCAClient(ctx context.Context, org *Organization, commands ...[]string) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	ca := n.peerOrgCA(org)
+	script := []string{
+		"set -e",
+		"export FABRIC_CA_CLIENT_HOME=/tmp/ca-admin",
+		fmt.Sprintf("fabric-ca-client enroll -u http://admin:adminpw@%s:%d --caname %s >/tmp/enroll.log 2>&1 || { cat /tmp/enroll.log; exit 1; }",
+			ca.CAName, ca.CAPort, ca.CAName),
+	}
+	for _, command := range commands {
+		quoted := make([]string, len(command))
+		for i, arg := range command {
+			quoted[i] = shellQuote(arg)
+		}
+		script = append(script, strings.Join(quoted, " "))
+	}
+
+	output, err := n.exec.ExecuteCombined(ctx, "docker", "run", "--rm",
+		"--network", fmt.Sprintf("fabricx_%s", n.ID),
+		"-v", fmt.Sprintf("%s:/crypto-config", n.CryptoPath),
+		fabricCAImage,
+		"sh", "-c", strings.Join(script, "\n"),
+	)
+	if err != nil {
+		return output, errors.WrapWithContext("runCAClient", errors.ErrIdentityFailed, map[string]interface{}{
+			"ca":     ca.CAName,
+			"error":  err.Error(),
+			"output": string(output),
+		})
+	}
+
+	return output, nil
+}
+
+func validateIdentity(id *Identity) error {
+	if id == nil || !identityNamePattern.MatchString(id.Name) {
+		name := ""
+		if id != nil {
+			name = id.Name
+		}
+		return errors.WrapWithContext("validateIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"identity": name,
+			"reason":   "identity names may only contain letters, digits, '.', '_' and '-'",
+		})
+	}
+
+	if id.Type == "" {
+		id.Type = IdentityTypeClient
+	}
+	switch id.Type {
+	case IdentityTypeClient, IdentityTypeAdmin, IdentityTypePeer, IdentityTypeOrderer:
+	default:
+		return errors.WrapWithContext("validateIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+			"identity": id.Name,
+			"type":     id.Type,
+			"reason":   "type must be client, admin, peer or orderer",
+		})
+	}
+
+	for name, value := range id.Attributes {
+		if !attrNamePattern.MatchString(name) || strings.HasPrefix(name, "hf.") || strings.ContainsAny(value, ",:") {
+			return errors.WrapWithContext("validateIdentity", errors.ErrInvalidConfig, map[string]interface{}{
+				"identity":  id.Name,
+				"attribute": name,
+				"reason":    "attribute names must not use the reserved hf. prefix and values must not contain ',' or ':'",
+			})
+		}
+	}
+
+	return nil
+}
+
+// parseIdentityList parses the output of `fabric-ca-client identity list`
+func parseIdentityList(output string) []*Identity {
+	identities := []*Identity{}
+	for _, line := range strings.Split(output, "\n") {
+		match := identityLinePattern.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+
+		maxEnrollments, _ := strconv.Atoi(match[4])
+		id := &Identity{
+			Name:           match[1],
+			Type:           match[2],
+			Affiliation:    match[3],
+			MaxEnrollments: maxEnrollments,
+			Attributes:     map[string]string{},
+		}
+		for _, attr := range attributePattern.FindAllStringSubmatch(match[5], -1) {
+			id.Attributes[attr[1]] = attr[2]
+		}
+		identities = append(identities, id)
+	}
+
+	return identities
+}
+
+func sortedAttrNames(attrs map[string]string) []string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// shellQuote quotes a single argument for sh
+func shellQuote(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\n'\"\\$`*?[]{}()<>|&;#~!") {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
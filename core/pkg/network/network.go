@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,6 +19,14 @@ type Config struct {
 	NumOrgs      int
 	ChannelName  string
 	CustomConfig map[string]string
+	// Topology, when set, replaces NumOrgs and ChannelName with a full
+	// declarative layout
+	Topology *Topology
+	// CryptoProvider selects how identities are issued: "cryptogen"
+	// (default) or "ca" to enroll them through Fabric CA
+	CryptoProvider string
+	// SeparateTLSCA runs a dedicated TLS CA per org in "ca" mode
+	SeparateTLSCA bool
 }
 
 type Network struct {
@@ -27,10 +36,22 @@ type Network struct {
 	BasePath   string
 	Orgs       []*Organization
 	Orderers   []*Orderer
-	Channel    *Channel
+	OrdererOrg *OrdererOrganization
+	Channel    *Channel   // Default channel used by deploy, invoke and query
+	Channels   []*Channel // All channels, Channel included
 	CryptoPath string
 	ConfigPath string
 	exec       executor.Executor // For testing
+
+	collectionsMu sync.RWMutex
+	collections   map[string][]*Collection // Private data collections by chaincode
+
+	chaincodesMu sync.RWMutex
+	chaincodes   map[string]*Chaincode        // Chaincode deployed through this runtime
+	staged       map[string]*StagedDefinition // Definitions waiting for org approvals
+
+	peerSettingsMu          sync.Mutex
+	chaincodeExecuteTimeout time.Duration // Zero keeps the Fabric default of 30s
 }
 
 type Organization struct {
@@ -40,6 +61,14 @@ type Organization struct {
 	Peers      []*Peer
 	CAPort     int
 	AnchorPort int
+	Policies   map[string]Policy // Overrides of the default org policies
+}
+
+type OrdererOrganization struct {
+	Name      string
+	MSPID     string
+	Domain    string
+	Consensus string
 }
 
 type Peer struct {
@@ -58,6 +87,8 @@ type Orderer struct {
 type Channel struct {
 	Name        string
 	ProfileName string
+	Orgs        []string          // Member org names; empty means all orgs
+	Policies    map[string]Policy // Overrides of the default application policies
 }
 
 // Bootstrap creates a new network with real executor
@@ -70,6 +101,28 @@ func Bootstrap(ctx context.Context, config *Config, exec executor.Executor) (*Ne
 	// Generate network ID
 	netID := uuid.New().String()[:8]
 
+	// Validate topology before touching the filesystem
+	if config.Topology != nil {
+		config.Topology.ApplyDefaults()
+		if err := config.Topology.Validate(); err != nil {
+			return nil, errors.Wrap("Bootstrap.ValidateTopology", err)
+		}
+		if config.NetworkName == "" {
+			config.NetworkName = config.Topology.Name
+		}
+		config.NumOrgs = len(config.Topology.Organizations)
+		config.ChannelName = config.Topology.Channels[0].Name
+	}
+
+	switch config.CryptoProvider {
+	case "", CryptoProviderCryptogen, CryptoProviderCA:
+	default:
+		return nil, errors.WrapWithContext("Bootstrap", errors.ErrInvalidConfig, map[string]interface{}{
+			"crypto_provider": config.CryptoProvider,
+			"reason":          fmt.Sprintf("want %s or %s", CryptoProviderCryptogen, CryptoProviderCA),
+		})
+	}
+
 	// Set defaults
 	if config.NetworkName == "" {
 		config.NetworkName = "fabricx-network"
@@ -105,11 +158,17 @@ func Bootstrap(ctx context.Context, config *Config, exec executor.Executor) (*Ne
 		exec: exec,
 	}
 
-	// Generate organizations
-	net.Orgs = generateOrganizations(config.NumOrgs)
+	if config.Topology != nil {
+		net.buildFromTopology(config.Topology)
+	} else {
+		// Generate organizations
+		net.Orgs = generateOrganizations(config.NumOrgs)
 
-	// Generate orderers
-	net.Orderers = generateOrderers()
+		// Generate orderers
+		net.Orderers = generateOrderers()
+		net.OrdererOrg = defaultOrdererOrg()
+		net.Channels = []*Channel{net.Channel}
+	}
 
 	// Check context before long operations
 	if err := ctx.Err(); err != nil {
@@ -213,6 +272,79 @@ func generateOrderers() []*Orderer {
 	}
 }
 
+func defaultOrdererOrg() *OrdererOrganization {
+	return &OrdererOrganization{
+		Name:      "OrdererOrg",
+		MSPID:     "OrdererMSP",
+		Domain:    "example.com",
+		Consensus: ConsensusSolo,
+	}
+}
+
+// ordererOrg returns the orderer organization, falling back to the
+// defaults for networks assembled without Bootstrap
+func (n *Network) ordererOrg() *OrdererOrganization {
+	if n.OrdererOrg != nil {
+		return n.OrdererOrg
+	}
+	return defaultOrdererOrg()
+}
+
+// OrdererEndpoint returns the address of the first orderer
+func (n *Network) OrdererEndpoint() string {
+	return fmt.Sprintf("%s:%d", n.Orderers[0].Name, n.Orderers[0].Port)
+}
+
+// OrdererTLSCA returns the orderer TLS CA certificate path inside the CLI
+// container
+func (n *Network) OrdererTLSCA() string {
+	return fmt.Sprintf("/etc/hyperledger/fabric/crypto/ordererOrganizations/%s/orderers/%s/tls/ca.crt",
+		n.ordererOrg().Domain, n.Orderers[0].Name)
+}
+
+// PeerAddress returns the address a peer is published on the host
+func (n *Network) PeerAddress(peer *Peer) string {
+	return fmt.Sprintf("localhost:%d", peer.Port)
+}
+
+// PeerTLSCAPath returns the host path of the CA certificate a peer's TLS
+// certificate is issued by
+func (n *Network) PeerTLSCAPath(org *Organization, peer *Peer) string {
+	return filepath.Join(n.CryptoPath, "peerOrganizations", org.Domain, "peers", peer.Name, "tls", "ca.crt")
+}
+
+// AllChannels returns every channel of the network
+func (n *Network) AllChannels() []*Channel {
+	if len(n.Channels) > 0 {
+		return n.Channels
+	}
+	return []*Channel{n.Channel}
+}
+
+// ChannelOrgs returns the member organizations of a channel
+func (n *Network) ChannelOrgs(ch *Channel) []*Organization {
+	if len(ch.Orgs) == 0 {
+		return n.Orgs
+	}
+	orgs := []*Organization{}
+	for _, name := range ch.Orgs {
+		for _, org := range n.Orgs {
+			if org.Name == name {
+				orgs = append(orgs, org)
+			}
+		}
+	}
+	return orgs
+}
+
+func (n *Network) channelOrgNames(ch *Channel) []string {
+	names := []string{}
+	for _, org := range n.ChannelOrgs(ch) {
+		names = append(names, org.Name)
+	}
+	return names
+}
+
 func (n *Network) WaitForReady(ctx context.Context) error {
 	// Create a deadline context if not already set
 	if _, ok := ctx.Deadline(); !ok {
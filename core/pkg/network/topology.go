@@ -0,0 +1,543 @@
+// core/pkg/network/topology.go
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ConsensusSolo runs a single orderer without replication
+	ConsensusSolo = "solo"
+	// ConsensusEtcdRaft runs a Raft cluster across all orderer nodes
+	ConsensusEtcdRaft = "etcdraft"
+
+	// StateDBCouchDB backs a peer with a CouchDB container
+	StateDBCouchDB = "couchdb"
+	// StateDBLevelDB uses the peer's embedded LevelDB
+	StateDBLevelDB = "leveldb"
+)
+
+// Topology is a declarative description of a network. It can be written as
+// YAML or JSON, is validated before use, is accepted by Bootstrap through
+// Config.Topology and can be exported from a running network.
+type Topology struct {
+	Name          string        `yaml:"name,omitempty" json:"name,omitempty"`
+	Orderer       OrdererSpec   `yaml:"orderer" json:"orderer"`
+	Organizations []OrgSpec     `yaml:"organizations" json:"organizations"`
+	Channels      []ChannelSpec `yaml:"channels" json:"channels"`
+}
+
+// OrdererSpec describes the ordering service organization and its nodes
+type OrdererSpec struct {
+	Name      string            `yaml:"name,omitempty" json:"name,omitempty"`
+	MSPID     string            `yaml:"mspId,omitempty" json:"mspId,omitempty"`
+	Domain    string            `yaml:"domain,omitempty" json:"domain,omitempty"`
+	Consensus string            `yaml:"consensus,omitempty" json:"consensus,omitempty"`
+	Nodes     []OrdererNodeSpec `yaml:"nodes,omitempty" json:"nodes,omitempty"`
+}
+
+// OrdererNodeSpec describes a single orderer node. Name is the hostname
+// within the orderer domain, e.g. "orderer2".
+type OrdererNodeSpec struct {
+	Name string `yaml:"name" json:"name"`
+	Port int    `yaml:"port,omitempty" json:"port,omitempty"`
+}
+
+// OrgSpec describes a peer organization
+type OrgSpec struct {
+	Name     string            `yaml:"name" json:"name"`
+	MSPID    string            `yaml:"mspId,omitempty" json:"mspId,omitempty"`
+	Domain   string            `yaml:"domain,omitempty" json:"domain,omitempty"`
+	Peers    []PeerSpec        `yaml:"peers,omitempty" json:"peers,omitempty"`
+	Policies map[string]Policy `yaml:"policies,omitempty" json:"policies,omitempty"`
+}
+
+// PeerSpec describes a single peer of an organization
+type PeerSpec struct {
+	StateDB string `yaml:"stateDB,omitempty" json:"stateDB,omitempty"`
+}
+
+// ChannelSpec describes an application channel and its member organizations
+type ChannelSpec struct {
+	Name     string            `yaml:"name" json:"name"`
+	Orgs     []string          `yaml:"organizations,omitempty" json:"organizations,omitempty"`
+	Policies map[string]Policy `yaml:"policies,omitempty" json:"policies,omitempty"`
+}
+
+// Policy is a configtx policy definition
+type Policy struct {
+	Type string `yaml:"type" json:"type"`
+	Rule string `yaml:"rule" json:"rule"`
+}
+
+// TopologyError lists every problem found while validating a topology
+type TopologyError struct {
+	Problems []string
+}
+
+// Error implements the error interface
+func (e *TopologyError) Error() string {
+	return fmt.Sprintf("invalid topology: %s", strings.Join(e.Problems, "; "))
+}
+
+// Is lets callers match topology errors against ErrInvalidConfig
+func (e *TopologyError) Is(target error) bool {
+	return target == errors.ErrInvalidConfig
+}
+
+var (
+	namePattern      = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+	mspIDPattern     = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	hostnamePattern  = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+	domainPattern    = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?(\.[a-z0-9]([a-z0-9-]*[a-z0-9])?)+$`)
+	channelPattern   = regexp.MustCompile(`^[a-z][a-z0-9.-]*$`)
+	implicitPattern  = regexp.MustCompile(`^(ANY|ALL|MAJORITY) [A-Za-z]+$`)
+	principalPattern = regexp.MustCompile(`'([^']+)\.(member|admin|peer|client|orderer)'`)
+
+	orgPolicyNames     = []string{"Readers", "Writers", "Admins", "Endorsement"}
+	channelPolicyNames = []string{"Readers", "Writers", "Admins", "Endorsement", "LifecycleEndorsement"}
+)
+
+// ParseTopology decodes a YAML or JSON topology document, applies defaults
+// and validates it
+func ParseTopology(data []byte) (*Topology, error) {
+	topo := &Topology{}
+
+	// JSON is a subset of YAML, so one decoder handles both formats
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(topo); err != nil {
+		return nil, &TopologyError{Problems: []string{fmt.Sprintf("decode: %v", err)}}
+	}
+
+	topo.ApplyDefaults()
+	if err := topo.Validate(); err != nil {
+		return nil, err
+	}
+
+	return topo, nil
+}
+
+// LoadTopology reads and parses a topology file
+func LoadTopology(path string) (*Topology, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapWithContext("LoadTopology", err, map[string]interface{}{
+			"path": path,
+		})
+	}
+	return ParseTopology(data)
+}
+
+// ApplyDefaults fills in every optional field that was left empty
+func (t *Topology) ApplyDefaults() {
+	if t.Orderer.Name == "" {
+		t.Orderer.Name = "OrdererOrg"
+	}
+	if t.Orderer.MSPID == "" {
+		t.Orderer.MSPID = "OrdererMSP"
+	}
+	if t.Orderer.Domain == "" {
+		t.Orderer.Domain = "example.com"
+	}
+	if t.Orderer.Consensus == "" {
+		t.Orderer.Consensus = ConsensusSolo
+	}
+	if len(t.Orderer.Nodes) == 0 {
+		t.Orderer.Nodes = []OrdererNodeSpec{{Name: "orderer"}}
+	}
+	for i := range t.Orderer.Nodes {
+		if t.Orderer.Nodes[i].Port == 0 {
+			t.Orderer.Nodes[i].Port = 7050 + (i * 100)
+		}
+	}
+
+	for i := range t.Organizations {
+		org := &t.Organizations[i]
+		if org.MSPID == "" && org.Name != "" {
+			org.MSPID = fmt.Sprintf("%sMSP", org.Name)
+		}
+		if org.Domain == "" && org.Name != "" {
+			org.Domain = fmt.Sprintf("%s.example.com", strings.ToLower(org.Name))
+		}
+		if len(org.Peers) == 0 {
+			org.Peers = []PeerSpec{{}}
+		}
+		for j := range org.Peers {
+			if org.Peers[j].StateDB == "" {
+				org.Peers[j].StateDB = StateDBCouchDB
+			}
+		}
+	}
+
+	if len(t.Channels) == 0 {
+		t.Channels = []ChannelSpec{{Name: "mychannel"}}
+	}
+	for i := range t.Channels {
+		if len(t.Channels[i].Orgs) == 0 {
+			for _, org := range t.Organizations {
+				t.Channels[i].Orgs = append(t.Channels[i].Orgs, org.Name)
+			}
+		}
+	}
+}
+
+// Each org gets a block of 1000 host ports and each of its peers 100 ports
+// within the block
+const maxPeersPerOrg = 10
+
+func orgCAPort(org int) int {
+	return 7054 + org*1000
+}
+
+func peerPort(org, peer int) int {
+	return 7051 + org*1000 + peer*100
+}
+
+func couchDBPort(org, peer int) int {
+	return 5984 + org*1000 + peer*100
+}
+
+// Validate checks the topology and reports every problem with the path of
+// the offending field
+func (t *Topology) Validate() error {
+	var problems []string
+	addf := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	// Orderer organization
+	if !namePattern.MatchString(t.Orderer.Name) {
+		addf("orderer.name: %q must start with a letter and contain only letters and digits", t.Orderer.Name)
+	}
+	if !mspIDPattern.MatchString(t.Orderer.MSPID) {
+		addf("orderer.mspId: %q is not a valid MSP ID", t.Orderer.MSPID)
+	}
+	if !domainPattern.MatchString(t.Orderer.Domain) {
+		addf("orderer.domain: %q is not a valid lowercase domain name", t.Orderer.Domain)
+	}
+	switch t.Orderer.Consensus {
+	case ConsensusSolo:
+		if len(t.Orderer.Nodes) != 1 {
+			addf("orderer.nodes: solo consensus requires exactly 1 node, got %d", len(t.Orderer.Nodes))
+		}
+	case ConsensusEtcdRaft:
+		if len(t.Orderer.Nodes) == 0 {
+			addf("orderer.nodes: etcdraft consensus requires at least 1 node")
+		}
+	default:
+		addf("orderer.consensus: unsupported consensus type %q (want %s or %s)", t.Orderer.Consensus, ConsensusSolo, ConsensusEtcdRaft)
+	}
+
+	ports := map[int]string{}
+	claimPort := func(port int, field string) {
+		if port <= 0 || port > 65535 {
+			addf("%s: port %d is out of range", field, port)
+			return
+		}
+		if other, taken := ports[port]; taken {
+			addf("%s: port %d is already used by %s", field, port, other)
+			return
+		}
+		ports[port] = field
+	}
+
+	nodeNames := map[string]int{}
+	for i, node := range t.Orderer.Nodes {
+		field := fmt.Sprintf("orderer.nodes[%d]", i)
+		if !hostnamePattern.MatchString(node.Name) {
+			addf("%s.name: %q is not a valid hostname", field, node.Name)
+		}
+		if prev, dup := nodeNames[node.Name]; dup {
+			addf("%s.name: duplicate orderer %q (also orderer.nodes[%d])", field, node.Name, prev)
+		} else {
+			nodeNames[node.Name] = i
+		}
+		claimPort(node.Port, field+".port")
+		claimPort(ordererOpsPort(i), field+".operationsPort")
+	}
+
+	// The crypto provider is picked when the network is created, so the
+	// ports of the orderer CA and of separate TLS CAs are always reserved
+	claimPort(ordererCAPort, "orderer.ca.port")
+	claimPort(tlsCAPort(ordererCAPort), "orderer.tlsca.port")
+
+	// Peer organizations
+	if len(t.Organizations) == 0 {
+		addf("organizations: at least one organization is required")
+	}
+
+	mspIDs := map[string]bool{t.Orderer.MSPID: true}
+	orgNames := map[string]int{}
+	orgMSPIDs := map[string]int{}
+	domains := map[string]string{t.Orderer.Domain: "orderer.domain"}
+	globalPeer := 0
+	for i, org := range t.Organizations {
+		field := fmt.Sprintf("organizations[%d]", i)
+		if !namePattern.MatchString(org.Name) {
+			addf("%s.name: %q must start with a letter and contain only letters and digits", field, org.Name)
+		} else if prev, dup := orgNames[org.Name]; dup {
+			addf("%s.name: duplicate organization %q (also organizations[%d])", field, org.Name, prev)
+		} else {
+			orgNames[org.Name] = i
+		}
+
+		if !mspIDPattern.MatchString(org.MSPID) {
+			addf("%s.mspId: %q is not a valid MSP ID", field, org.MSPID)
+		} else if org.MSPID == t.Orderer.MSPID {
+			addf("%s.mspId: %q is already used by the orderer organization", field, org.MSPID)
+		} else if prev, dup := orgMSPIDs[org.MSPID]; dup {
+			addf("%s.mspId: duplicate MSP ID %q (also organizations[%d])", field, org.MSPID, prev)
+		} else {
+			orgMSPIDs[org.MSPID] = i
+		}
+		mspIDs[org.MSPID] = true
+
+		if !domainPattern.MatchString(org.Domain) {
+			addf("%s.domain: %q is not a valid lowercase domain name", field, org.Domain)
+		} else if other, dup := domains[org.Domain]; dup {
+			addf("%s.domain: %q is already used by %s", field, org.Domain, other)
+		} else {
+			domains[org.Domain] = field + ".domain"
+		}
+
+		// Host ports are laid out in a block per org, so the peers of one org
+		// must not run into the next org's ports
+		if len(org.Peers) > maxPeersPerOrg {
+			addf("%s.peers: at most %d peers per organization, got %d", field, maxPeersPerOrg, len(org.Peers))
+		}
+		claimPort(orgCAPort(i), field+".ca.port")
+		claimPort(tlsCAPort(orgCAPort(i)), field+".tlsca.port")
+		for j, peer := range org.Peers {
+			peerField := fmt.Sprintf("%s.peers[%d]", field, j)
+			if peer.StateDB != StateDBCouchDB && peer.StateDB != StateDBLevelDB {
+				addf("%s.stateDB: unsupported state database %q (want %s or %s)", peerField, peer.StateDB, StateDBCouchDB, StateDBLevelDB)
+			}
+			claimPort(peerPort(i, j), peerField+".port")
+			claimPort(peerPort(i, j)+1, peerField+".chaincodePort")
+			claimPort(peerOpsPort(globalPeer), peerField+".operationsPort")
+			globalPeer++
+			if peer.StateDB == StateDBCouchDB {
+				claimPort(couchDBPort(i, j), peerField+".couchDBPort")
+			}
+		}
+	}
+
+	// Policies can only be checked once every MSP ID is known
+	for i, org := range t.Organizations {
+		problems = append(problems, validatePolicies(fmt.Sprintf("organizations[%d].policies", i), org.Policies, orgPolicyNames, mspIDs)...)
+	}
+
+	// Channels
+	if len(t.Channels) == 0 {
+		addf("channels: at least one channel is required")
+	}
+	channelNames := map[string]int{}
+	for i, ch := range t.Channels {
+		field := fmt.Sprintf("channels[%d]", i)
+		if !channelPattern.MatchString(ch.Name) || len(ch.Name) > 249 {
+			addf("%s.name: %q is not a valid channel name (lowercase letters, digits, '.' and '-', starting with a letter)", field, ch.Name)
+		} else if prev, dup := channelNames[ch.Name]; dup {
+			addf("%s.name: duplicate channel %q (also channels[%d])", field, ch.Name, prev)
+		} else {
+			channelNames[ch.Name] = i
+		}
+
+		if len(ch.Orgs) == 0 {
+			addf("%s.organizations: a channel needs at least one member organization", field)
+		}
+		members := map[string]bool{}
+		for j, name := range ch.Orgs {
+			if _, ok := orgNames[name]; !ok {
+				addf("%s.organizations[%d]: unknown organization %q", field, j, name)
+			}
+			if members[name] {
+				addf("%s.organizations[%d]: organization %q is listed twice", field, j, name)
+			}
+			members[name] = true
+		}
+
+		problems = append(problems, validatePolicies(field+".policies", ch.Policies, channelPolicyNames, mspIDs)...)
+	}
+
+	if len(problems) > 0 {
+		return &TopologyError{Problems: problems}
+	}
+	return nil
+}
+
+func validatePolicies(field string, policies map[string]Policy, allowed []string, mspIDs map[string]bool) []string {
+	var problems []string
+	for _, name := range sortedKeys(policies) {
+		policy := policies[name]
+		path := fmt.Sprintf("%s.%s", field, name)
+
+		known := false
+		for _, a := range allowed {
+			if a == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			problems = append(problems, fmt.Sprintf("%s: unknown policy (want one of %s)", path, strings.Join(allowed, ", ")))
+			continue
+		}
+
+		switch policy.Type {
+		case "ImplicitMeta":
+			if !implicitPattern.MatchString(policy.Rule) {
+				problems = append(problems, fmt.Sprintf("%s.rule: %q is not an ImplicitMeta rule such as \"MAJORITY Endorsement\"", path, policy.Rule))
+			}
+		case "Signature":
+			principals := principalPattern.FindAllStringSubmatch(policy.Rule, -1)
+			if len(principals) == 0 {
+				problems = append(problems, fmt.Sprintf("%s.rule: %q does not name any principal such as 'Org1MSP.member'", path, policy.Rule))
+			}
+			for _, p := range principals {
+				if !mspIDs[p[1]] {
+					problems = append(problems, fmt.Sprintf("%s.rule: unknown MSP ID %q", path, p[1]))
+				}
+			}
+		default:
+			problems = append(problems, fmt.Sprintf("%s.type: unsupported policy type %q (want Signature or ImplicitMeta)", path, policy.Type))
+		}
+	}
+	return problems
+}
+
+// Marshal encodes the topology as "yaml" (the default) or "json"
+func (t *Topology) Marshal(format string) ([]byte, error) {
+	switch strings.ToLower(format) {
+	case "", "yaml", "yml":
+		return yaml.Marshal(t)
+	case "json":
+		return json.MarshalIndent(t, "", "  ")
+	default:
+		return nil, errors.WrapWithContext("Topology.Marshal", errors.ErrInvalidConfig, map[string]interface{}{
+			"format": format,
+		})
+	}
+}
+
+// Topology exports the network's current layout as a topology document
+func (n *Network) Topology() *Topology {
+	ordererOrg := n.ordererOrg()
+	topo := &Topology{
+		Name: n.Name,
+		Orderer: OrdererSpec{
+			Name:      ordererOrg.Name,
+			MSPID:     ordererOrg.MSPID,
+			Domain:    ordererOrg.Domain,
+			Consensus: ordererOrg.Consensus,
+		},
+	}
+
+	for _, orderer := range n.Orderers {
+		topo.Orderer.Nodes = append(topo.Orderer.Nodes, OrdererNodeSpec{
+			Name: strings.TrimSuffix(orderer.Name, "."+ordererOrg.Domain),
+			Port: orderer.Port,
+		})
+	}
+
+	for _, org := range n.Orgs {
+		spec := OrgSpec{
+			Name:     org.Name,
+			MSPID:    org.MSPID,
+			Domain:   org.Domain,
+			Policies: org.Policies,
+		}
+		for _, peer := range org.Peers {
+			stateDB := StateDBLevelDB
+			if peer.CouchDB {
+				stateDB = StateDBCouchDB
+			}
+			spec.Peers = append(spec.Peers, PeerSpec{StateDB: stateDB})
+		}
+		topo.Organizations = append(topo.Organizations, spec)
+	}
+
+	for _, ch := range n.AllChannels() {
+		topo.Channels = append(topo.Channels, ChannelSpec{
+			Name:     ch.Name,
+			Orgs:     n.channelOrgNames(ch),
+			Policies: ch.Policies,
+		})
+	}
+
+	return topo
+}
+
+// buildFromTopology materializes organizations, orderers and channels from
+// a validated topology
+func (n *Network) buildFromTopology(topo *Topology) {
+	n.OrdererOrg = &OrdererOrganization{
+		Name:      topo.Orderer.Name,
+		MSPID:     topo.Orderer.MSPID,
+		Domain:    topo.Orderer.Domain,
+		Consensus: topo.Orderer.Consensus,
+	}
+
+	n.Orderers = nil
+	for _, node := range topo.Orderer.Nodes {
+		n.Orderers = append(n.Orderers, &Orderer{
+			Name:   fmt.Sprintf("%s.%s", node.Name, topo.Orderer.Domain),
+			Port:   node.Port,
+			Domain: topo.Orderer.Domain,
+		})
+	}
+
+	n.Orgs = nil
+	for i, spec := range topo.Organizations {
+		org := &Organization{
+			Name:       spec.Name,
+			MSPID:      spec.MSPID,
+			Domain:     spec.Domain,
+			CAPort:     orgCAPort(i),
+			AnchorPort: peerPort(i, 0),
+			Policies:   spec.Policies,
+		}
+		for j, peer := range spec.Peers {
+			org.Peers = append(org.Peers, &Peer{
+				Name:    fmt.Sprintf("peer%d.%s", j, spec.Domain),
+				Port:    peerPort(i, j),
+				CouchDB: peer.StateDB == StateDBCouchDB,
+				DBPort:  couchDBPort(i, j),
+			})
+		}
+		n.Orgs = append(n.Orgs, org)
+	}
+
+	n.Channels = nil
+	for i, spec := range topo.Channels {
+		profile := "FabricXChannel"
+		if i > 0 {
+			profile = fmt.Sprintf("FabricXChannel-%s", spec.Name)
+		}
+		n.Channels = append(n.Channels, &Channel{
+			Name:        spec.Name,
+			ProfileName: profile,
+			Orgs:        append([]string(nil), spec.Orgs...),
+			Policies:    spec.Policies,
+		})
+	}
+	n.Channel = n.Channels[0]
+}
+
+func sortedKeys(m map[string]Policy) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,352 @@
+// core/pkg/network/topology_test.go
+package network
+
+import (
+	"context"
+	stdErr "errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"github.com/temmyjay001/core/pkg/executor"
+	"gopkg.in/yaml.v3"
+)
+
+const testTopologyYAML = `
+name: trade-network
+orderer:
+  name: Ordering
+  mspId: OrderingMSP
+  domain: ordering.trade.io
+  consensus: etcdraft
+  nodes:
+    - name: orderer0
+      port: 7050
+    - name: orderer1
+      port: 7150
+    - name: orderer2
+      port: 7250
+organizations:
+  - name: Bank
+    mspId: BankMSP
+    domain: bank.trade.io
+    peers:
+      - stateDB: couchdb
+      - stateDB: leveldb
+  - name: Shipper
+    domain: shipper.trade.io
+channels:
+  - name: trade
+    organizations: [Bank, Shipper]
+    policies:
+      Endorsement:
+        type: Signature
+        rule: "AND('BankMSP.peer','ShipperMSP.peer')"
+  - name: payments
+    organizations: [Bank]
+`
+
+func TestParseTopology(t *testing.T) {
+	topo, err := ParseTopology([]byte(testTopologyYAML))
+	if err != nil {
+		t.Fatalf("ParseTopology() error = %v", err)
+	}
+
+	if topo.Orderer.Consensus != ConsensusEtcdRaft {
+		t.Errorf("Expected etcdraft consensus, got %s", topo.Orderer.Consensus)
+	}
+
+	if len(topo.Orderer.Nodes) != 3 {
+		t.Errorf("Expected 3 orderer nodes, got %d", len(topo.Orderer.Nodes))
+	}
+
+	// Defaults fill in what the document leaves out
+	shipper := topo.Organizations[1]
+	if shipper.MSPID != "ShipperMSP" {
+		t.Errorf("Expected default MSP ID ShipperMSP, got %s", shipper.MSPID)
+	}
+	if len(shipper.Peers) != 1 || shipper.Peers[0].StateDB != StateDBCouchDB {
+		t.Errorf("Expected one default couchdb peer, got %+v", shipper.Peers)
+	}
+
+	if len(topo.Channels) != 2 || topo.Channels[1].Name != "payments" {
+		t.Errorf("Unexpected channels: %+v", topo.Channels)
+	}
+}
+
+func TestParseTopologyJSON(t *testing.T) {
+	doc := `{
+		"organizations": [{"name": "Org1"}, {"name": "Org2", "peers": [{"stateDB": "leveldb"}]}],
+		"channels": [{"name": "mychannel", "organizations": ["Org1", "Org2"]}]
+	}`
+
+	topo, err := ParseTopology([]byte(doc))
+	if err != nil {
+		t.Fatalf("ParseTopology() error = %v", err)
+	}
+
+	if topo.Orderer.Consensus != ConsensusSolo || len(topo.Orderer.Nodes) != 1 {
+		t.Errorf("Expected a single solo orderer by default, got %+v", topo.Orderer)
+	}
+
+	if topo.Organizations[0].Domain != "org1.example.com" {
+		t.Errorf("Expected default domain org1.example.com, got %s", topo.Organizations[0].Domain)
+	}
+}
+
+func TestTopologyValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		problem string
+	}{
+		{
+			name:    "unknown field",
+			doc:     "organizations:\n  - name: Org1\n    peer: 2\n",
+			problem: "field peer not found",
+		},
+		{
+			name:    "invalid state database",
+			doc:     "organizations:\n  - name: Org1\n    peers:\n      - stateDB: mysql\n",
+			problem: `organizations[0].peers[0].stateDB: unsupported state database "mysql"`,
+		},
+		{
+			name:    "unknown channel member",
+			doc:     "organizations:\n  - name: Org1\nchannels:\n  - name: mychannel\n    organizations: [Org1, Org9]\n",
+			problem: `channels[0].organizations[1]: unknown organization "Org9"`,
+		},
+		{
+			name:    "solo with several nodes",
+			doc:     "orderer:\n  nodes:\n    - name: o1\n      port: 7050\n    - name: o2\n      port: 7150\norganizations:\n  - name: Org1\n",
+			problem: "orderer.nodes: solo consensus requires exactly 1 node, got 2",
+		},
+		{
+			name:    "duplicate ports",
+			doc:     "orderer:\n  consensus: etcdraft\n  nodes:\n    - name: o1\n      port: 7050\n    - name: o2\n      port: 7050\norganizations:\n  - name: Org1\n",
+			problem: "orderer.nodes[1].port: port 7050 is already used by orderer.nodes[0].port",
+		},
+		{
+			name:    "too many peers",
+			doc:     "organizations:\n  - name: Org1\n    peers:" + strings.Repeat("\n      - stateDB: leveldb", 11) + "\n  - name: Org2\n",
+			problem: "organizations[0].peers: at most 10 peers per organization, got 11",
+		},
+		{
+			name:    "peer port taken by the next org",
+			doc:     "organizations:\n  - name: Org1\n    peers:" + strings.Repeat("\n      - stateDB: leveldb", 11) + "\n  - name: Org2\n",
+			problem: "organizations[1].peers[0].port: port 8051 is already used by organizations[0].peers[10].port",
+		},
+		{
+			name:    "orderer port taken by a peer",
+			doc:     "orderer:\n  nodes:\n    - name: o1\n      port: 7051\norganizations:\n  - name: Org1\n",
+			problem: "organizations[0].peers[0].port: port 7051 is already used by orderer.nodes[0].port",
+		},
+		{
+			name:    "orderer port taken by its operations service",
+			doc:     "orderer:\n  nodes:\n    - name: o1\n      port: 8443\norganizations:\n  - name: Org1\n",
+			problem: "orderer.nodes[0].operationsPort: port 8443 is already used by orderer.nodes[0].port",
+		},
+		{
+			name:    "orderer port taken by a TLS CA",
+			doc:     "orderer:\n  nodes:\n    - name: o1\n      port: 7055\norganizations:\n  - name: Org1\n",
+			problem: "organizations[0].tlsca.port: port 7055 is already used by orderer.nodes[0].port",
+		},
+		{
+			name:    "orderer port taken by the orderer CA",
+			doc:     "orderer:\n  nodes:\n    - name: o1\n      port: 6054\norganizations:\n  - name: Org1\n",
+			problem: "orderer.ca.port: port 6054 is already used by orderer.nodes[0].port",
+		},
+		{
+			name:    "orderer port taken by a peer operations service",
+			doc:     "orderer:\n  nodes:\n    - name: o1\n      port: 9443\norganizations:\n  - name: Org1\n",
+			problem: "organizations[0].peers[0].operationsPort: port 9443 is already used by orderer.nodes[0].port",
+		},
+		{
+			name:    "invalid channel name",
+			doc:     "organizations:\n  - name: Org1\nchannels:\n  - name: My_Channel\n    organizations: [Org1]\n",
+			problem: `channels[0].name: "My_Channel" is not a valid channel name`,
+		},
+		{
+			name:    "policy with unknown MSP",
+			doc:     "organizations:\n  - name: Org1\nchannels:\n  - name: mychannel\n    organizations: [Org1]\n    policies:\n      Endorsement:\n        type: Signature\n        rule: \"OR('Org7MSP.peer')\"\n",
+			problem: "Org7MSP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseTopology([]byte(tt.doc))
+			if err == nil {
+				t.Fatal("Expected validation error")
+			}
+
+			if !strings.Contains(err.Error(), tt.problem) {
+				t.Errorf("Expected error to mention %q, got: %v", tt.problem, err)
+			}
+		})
+	}
+}
+
+func TestTopologyPeerPorts(t *testing.T) {
+	// Ten peers per org fit in the org's block of ports
+	doc := "organizations:\n  - name: Org1\n    peers:" + strings.Repeat("\n      - stateDB: couchdb", 10) + "\n  - name: Org2\n"
+	topo, err := ParseTopology([]byte(doc))
+	if err != nil {
+		t.Fatalf("ParseTopology() error = %v", err)
+	}
+
+	n := &Network{}
+	n.buildFromTopology(topo)
+	ports := map[int]string{}
+	for _, org := range n.Orgs {
+		for _, peer := range org.Peers {
+			for _, port := range []int{peer.Port, peer.Port + 1, peer.DBPort} {
+				if other, taken := ports[port]; taken {
+					t.Errorf("Port %d of %s is also used by %s", port, peer.Name, other)
+				}
+				ports[port] = peer.Name
+			}
+		}
+	}
+	if last := n.Orgs[0].Peers[9]; last.Port != 7951 || n.Orgs[1].Peers[0].Port != 8051 {
+		t.Errorf("Unexpected peer ports %d and %d", last.Port, n.Orgs[1].Peers[0].Port)
+	}
+}
+
+func TestTopologyErrorIsInvalidConfig(t *testing.T) {
+	_, err := ParseTopology([]byte("organizations: []\n"))
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+
+	if !stdErr.Is(err, errors.ErrInvalidConfig) {
+		t.Errorf("Expected ErrInvalidConfig, got %v", err)
+	}
+}
+
+func TestBootstrapWithTopology(t *testing.T) {
+	topo, err := ParseTopology([]byte(testTopologyYAML))
+	if err != nil {
+		t.Fatalf("ParseTopology() error = %v", err)
+	}
+
+	mockExec := executor.NewMockExecutor()
+	mockExec.ExecuteCombinedFunc = func(ctx context.Context, name string, args ...string) ([]byte, error) {
+		return []byte("success"), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	net, err := Bootstrap(ctx, &Config{Topology: topo}, mockExec)
+	if err != nil {
+		t.Fatalf("Bootstrap() error = %v", err)
+	}
+	defer net.Cleanup()
+
+	if net.Name != "trade-network" {
+		t.Errorf("Expected network name from topology, got %s", net.Name)
+	}
+
+	if len(net.Orderers) != 3 || net.Orderers[0].Name != "orderer0.ordering.trade.io" {
+		t.Errorf("Unexpected orderers: %+v", net.Orderers)
+	}
+
+	if net.OrdererTLSCA() != "/etc/hyperledger/fabric/crypto/ordererOrganizations/ordering.trade.io/orderers/orderer0.ordering.trade.io/tls/ca.crt" {
+		t.Errorf("Unexpected orderer TLS CA: %s", net.OrdererTLSCA())
+	}
+
+	if net.Orgs[0].Peers[1].CouchDB {
+		t.Error("Expected second Bank peer to use LevelDB")
+	}
+
+	if len(net.Channels) != 2 || net.Channel.Name != "trade" {
+		t.Errorf("Unexpected channels: %+v", net.Channels)
+	}
+
+	// configtx.yaml carries raft consenters and one profile per channel
+	data, err := os.ReadFile(filepath.Join(net.ConfigPath, "configtx.yaml"))
+	if err != nil {
+		t.Fatalf("Failed to read configtx.yaml: %v", err)
+	}
+
+	var configtx map[string]interface{}
+	if err := yaml.Unmarshal(data, &configtx); err != nil {
+		t.Fatalf("Failed to parse configtx.yaml: %v", err)
+	}
+
+	profiles := configtx["Profiles"].(map[string]interface{})
+	for _, name := range []string{"FabricXOrdererGenesis", "FabricXChannel", "FabricXChannel-payments"} {
+		if _, ok := profiles[name]; !ok {
+			t.Errorf("Expected profile %s in configtx.yaml", name)
+		}
+	}
+
+	payments := profiles["FabricXChannel-payments"].(map[string]interface{})
+	members := payments["Application"].(map[string]interface{})["Organizations"].([]interface{})
+	if len(members) != 1 {
+		t.Errorf("Expected payments channel to have 1 member, got %d", len(members))
+	}
+
+	genesis := profiles["FabricXOrdererGenesis"].(map[string]interface{})["Orderer"].(map[string]interface{})
+	if genesis["OrdererType"] != ConsensusEtcdRaft {
+		t.Errorf("Expected etcdraft orderer type, got %v", genesis["OrdererType"])
+	}
+	consenters := genesis["EtcdRaft"].(map[string]interface{})["Consenters"].([]interface{})
+	if len(consenters) != 3 {
+		t.Errorf("Expected 3 raft consenters, got %d", len(consenters))
+	}
+
+	// Both channels are created from their own transaction
+	created := 0
+	for _, call := range mockExec.Calls {
+		if contains(call.Args, "-outputCreateChannelTx") {
+			created++
+		}
+	}
+	if created != 2 {
+		t.Errorf("Expected 2 channel transactions, got %d", created)
+	}
+}
+
+func TestTopologyExportRoundTrip(t *testing.T) {
+	topo, err := ParseTopology([]byte(testTopologyYAML))
+	if err != nil {
+		t.Fatalf("ParseTopology() error = %v", err)
+	}
+
+	net := &Network{Name: topo.Name}
+	net.buildFromTopology(topo)
+
+	for _, format := range []string{"yaml", "json"} {
+		t.Run(format, func(t *testing.T) {
+			data, err := net.Topology().Marshal(format)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			parsed, err := ParseTopology(data)
+			if err != nil {
+				t.Fatalf("ParseTopology() of exported topology error = %v", err)
+			}
+
+			if len(parsed.Organizations) != 2 || parsed.Organizations[0].MSPID != "BankMSP" {
+				t.Errorf("Unexpected organizations after round trip: %+v", parsed.Organizations)
+			}
+
+			if parsed.Channels[0].Policies["Endorsement"].Rule != "AND('BankMSP.peer','ShipperMSP.peer')" {
+				t.Errorf("Expected endorsement policy to survive round trip, got %+v", parsed.Channels[0].Policies)
+			}
+
+			if parsed.Orderer.Nodes[2].Name != "orderer2" {
+				t.Errorf("Expected orderer node names to be exported without domain, got %+v", parsed.Orderer.Nodes)
+			}
+		})
+	}
+
+	if _, err := net.Topology().Marshal("toml"); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
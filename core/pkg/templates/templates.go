@@ -0,0 +1,330 @@
+// core/pkg/templates/templates.go
+package templates
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"embed"
+	"go/format"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/temmyjay001/core/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Each template is a folder holding a template.yaml and one folder per
+// language. Files ending in .tmpl and paths containing {{ are rendered with
+// text/template; everything else is copied as is.
+//
+//go:embed */template.yaml */go */typescript
+var files embed.FS
+
+// Languages a template can be written in
+const (
+	LanguageGo         = "go"
+	LanguageTypeScript = "typescript"
+)
+
+// languages lists the language folders in the order they are preferred
+var languages = []string{LanguageGo, LanguageTypeScript}
+
+// Template describes an embedded chaincode template
+type Template struct {
+	Name        string     `yaml:"-"`
+	Title       string     `yaml:"title"`
+	Description string     `yaml:"description"`
+	Languages   []string   `yaml:"-"`
+	Defaults    Options    `yaml:"defaults"`
+	Functions   []Function `yaml:"functions"`
+	Events      []Event    `yaml:"events"`
+}
+
+// Function is a transaction function of a template's contract
+type Function struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Args        []string `yaml:"args"`
+	Query       bool     `yaml:"query"` // Reads the ledger only
+}
+
+// Event is a chaincode event a template's contract emits
+type Event struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+}
+
+// Options customize a rendered template. Empty fields take the template's
+// defaults.
+type Options struct {
+	Language     string `yaml:"-"`
+	ModulePath   string `yaml:"modulePath"`   // Go module path; unused by TypeScript
+	ContractName string `yaml:"contractName"` // Contract type or class name
+	PackageName  string `yaml:"packageName"`  // Go package of the contract, or npm package name
+}
+
+// File is a rendered file, with a slash separated path relative to the
+// chaincode folder
+type File struct {
+	Path string
+	Data []byte
+}
+
+var (
+	contractNamePattern = regexp.MustCompile(`^[A-Z][A-Za-z0-9_]*$`)
+	goPackagePattern    = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+	npmPackagePattern   = regexp.MustCompile(`^(@[a-z0-9][a-z0-9._-]*/)?[a-z0-9][a-z0-9._-]*$`)
+	modulePathPattern   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._~-]*(/[A-Za-z0-9][A-Za-z0-9._~-]*)*$`)
+)
+
+// List returns the embedded templates sorted by name
+func List() ([]*Template, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, errors.Wrap("List", err)
+	}
+
+	var list []*Template
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		t, err := Get(entry.Name())
+		if err != nil {
+			return nil, errors.Wrap("List", err)
+		}
+		list = append(list, t)
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list, nil
+}
+
+// Get returns an embedded template by name
+func Get(name string) (*Template, error) {
+	if !fs.ValidPath(name) || strings.Contains(name, "/") || name == "." {
+		return nil, notFound(name, "")
+	}
+
+	data, err := files.ReadFile(path.Join(name, "template.yaml"))
+	if err != nil {
+		return nil, notFound(name, "")
+	}
+
+	t := &Template{}
+	if err := yaml.Unmarshal(data, t); err != nil {
+		return nil, errors.WrapWithContext("Get", errors.ErrInvalidConfig, map[string]interface{}{
+			"template": name,
+			"error":    err.Error(),
+		})
+	}
+	t.Name = name
+
+	for _, lang := range languages {
+		if info, err := fs.Stat(files, path.Join(name, lang)); err == nil && info.IsDir() {
+			t.Languages = append(t.Languages, lang)
+		}
+	}
+	return t, nil
+}
+
+// Render renders a template in the language and with the names of opts. The
+// defaults of the template are filled into opts.
+func Render(name string, opts *Options) ([]File, error) {
+	t, err := Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.resolve(opts); err != nil {
+		return nil, err
+	}
+
+	root := path.Join(name, opts.Language)
+	var rendered []File
+	err = fs.WalkDir(files, root, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+
+		rel, err := renderText(strings.TrimPrefix(p, root+"/"), opts)
+		if err != nil {
+			return err
+		}
+		data, err := files.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		if strings.HasSuffix(rel, ".tmpl") {
+			rel = strings.TrimSuffix(rel, ".tmpl")
+			content, err := renderText(string(data), opts)
+			if err != nil {
+				return err
+			}
+			data = []byte(content)
+		}
+		if strings.HasSuffix(rel, ".go") {
+			// Names of different length break the alignment gofmt expects
+			if data, err = format.Source(data); err != nil {
+				return err
+			}
+		}
+
+		rendered = append(rendered, File{Path: rel, Data: data})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WrapWithContext("Render", err, map[string]interface{}{
+			"template": name,
+			"language": opts.Language,
+		})
+	}
+	return rendered, nil
+}
+
+// resolve fills the defaults into opts and validates the names
+func (t *Template) resolve(opts *Options) error {
+	switch opts.Language {
+	case "":
+		opts.Language = t.Languages[0]
+	case "golang":
+		opts.Language = LanguageGo
+	case "ts":
+		opts.Language = LanguageTypeScript
+	}
+	if !contains(t.Languages, opts.Language) {
+		return notFound(t.Name, opts.Language)
+	}
+
+	if opts.ModulePath == "" {
+		opts.ModulePath = t.Defaults.ModulePath
+	}
+	if opts.ContractName == "" {
+		opts.ContractName = t.Defaults.ContractName
+	}
+	if opts.PackageName == "" {
+		opts.PackageName = t.Defaults.PackageName
+	}
+
+	invalid := func(field, value, reason string) error {
+		return errors.WrapWithContext("Render", errors.ErrInvalidConfig, map[string]interface{}{
+			field:    value,
+			"reason": reason,
+		})
+	}
+
+	if !contractNamePattern.MatchString(opts.ContractName) {
+		return invalid("contract_name", opts.ContractName, "the contract name must be an identifier starting with an upper case letter")
+	}
+	if opts.Language == LanguageTypeScript {
+		if !npmPackagePattern.MatchString(opts.PackageName) {
+			return invalid("package_name", opts.PackageName, "the package name must be a lower case npm package name")
+		}
+		return nil
+	}
+
+	if !goPackagePattern.MatchString(opts.PackageName) || token.IsKeyword(opts.PackageName) || opts.PackageName == "main" {
+		return invalid("package_name", opts.PackageName, "the package name must be a lower case Go identifier other than main")
+	}
+	if !modulePathPattern.MatchString(opts.ModulePath) {
+		return invalid("module_path", opts.ModulePath, "the module path must be slash separated letters, digits and ._~-")
+	}
+	return nil
+}
+
+// WriteDir writes rendered files into dir, which must be empty or missing
+func WriteDir(dir string, rendered []File) error {
+	entries, err := os.ReadDir(dir)
+	if err == nil && len(entries) > 0 {
+		return errors.WrapWithContext("WriteDir", errors.ErrInvalidConfig, map[string]interface{}{
+			"dir":    dir,
+			"reason": "the target folder is not empty",
+		})
+	}
+
+	for _, f := range rendered {
+		target := filepath.Join(dir, filepath.FromSlash(f.Path))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return errors.Wrap("WriteDir", err)
+		}
+		if err := os.WriteFile(target, f.Data, 0644); err != nil {
+			return errors.Wrap("WriteDir", err)
+		}
+	}
+	return nil
+}
+
+// WriteArchive writes rendered files as a gzipped tar
+func WriteArchive(w io.Writer, rendered []File) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	now := time.Now()
+	for _, f := range rendered {
+		hdr := &tar.Header{
+			Name:     f.Path,
+			Mode:     0644,
+			Size:     int64(len(f.Data)),
+			ModTime:  now,
+			Typeflag: tar.TypeReg,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return errors.Wrap("WriteArchive", err)
+		}
+		if _, err := tw.Write(f.Data); err != nil {
+			return errors.Wrap("WriteArchive", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return errors.Wrap("WriteArchive", err)
+	}
+	if err := gz.Close(); err != nil {
+		return errors.Wrap("WriteArchive", err)
+	}
+	return nil
+}
+
+func renderText(text string, opts *Options) (string, error) {
+	if !strings.Contains(text, "{{") {
+		return text, nil
+	}
+
+	tmpl, err := template.New("").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, opts); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func notFound(name, language string) error {
+	context := map[string]interface{}{"template": name}
+	if language != "" {
+		context["language"] = language
+	}
+	return errors.WrapWithContext("Get", errors.ErrTemplateNotFound, context)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
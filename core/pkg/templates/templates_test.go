@@ -0,0 +1,263 @@
+// core/pkg/templates/templates_test.go
+package templates
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	stdErr "errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/temmyjay001/core/pkg/errors"
+)
+
+func TestList(t *testing.T) {
+	list, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	var names []string
+	for _, tmpl := range list {
+		names = append(names, tmpl.Name)
+		if tmpl.Description == "" || len(tmpl.Functions) == 0 || len(tmpl.Events) == 0 {
+			t.Errorf("Template %s lacks a description, functions or events", tmpl.Name)
+		}
+		if len(tmpl.Languages) == 0 || tmpl.Languages[0] != LanguageGo {
+			t.Errorf("Template %s languages = %v, want go first", tmpl.Name, tmpl.Languages)
+		}
+	}
+	if got := strings.Join(names, ","); got != "asset-transfer,erc20,escrow,supply-chain" {
+		t.Errorf("List() = %s", got)
+	}
+
+	erc20, err := Get("erc20")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if strings.Join(erc20.Languages, ",") != "go,typescript" {
+		t.Errorf("erc20 languages = %v, want go and typescript", erc20.Languages)
+	}
+}
+
+func TestGetUnknownTemplate(t *testing.T) {
+	for _, name := range []string{"nft", "../erc20", "erc20/go", "."} {
+		if _, err := Get(name); !errors.IsTemplateNotFound(err) {
+			t.Errorf("Get(%q) error = %v, want template not found", name, err)
+		}
+	}
+
+	if _, err := Render("escrow", &Options{Language: LanguageTypeScript}); !errors.IsTemplateNotFound(err) {
+		t.Errorf("Render() of a missing language error = %v, want template not found", err)
+	}
+}
+
+// TestRenderGo checks every template renders to Go source whose contract
+// has the functions and events its template.yaml describes
+func TestRenderGo(t *testing.T) {
+	list, err := List()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	for _, tmpl := range list {
+		t.Run(tmpl.Name, func(t *testing.T) {
+			opts := &Options{
+				ModulePath:   "github.com/acme/" + tmpl.Name,
+				ContractName: "MyContract",
+				PackageName:  "mycc",
+			}
+			rendered, err := Render(tmpl.Name, opts)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if opts.Language != LanguageGo {
+				t.Errorf("Language = %s, want go by default", opts.Language)
+			}
+
+			files := make(map[string]string)
+			for _, f := range rendered {
+				files[f.Path] = string(f.Data)
+			}
+			for path, data := range files {
+				if strings.HasSuffix(path, ".tmpl") || strings.Contains(path+data, "{{") {
+					t.Errorf("%s was not rendered", path)
+				}
+			}
+
+			if !strings.HasPrefix(files["go.mod"], "module github.com/acme/"+tmpl.Name+"\n") {
+				t.Errorf("go.mod does not declare the module path:\n%s", files["go.mod"])
+			}
+			if !strings.Contains(files["main.go"], `"github.com/acme/`+tmpl.Name+`/mycc"`) ||
+				!strings.Contains(files["main.go"], "&mycc.MyContract{}") {
+				t.Errorf("main.go does not start the contract:\n%s", files["main.go"])
+			}
+
+			fset := token.NewFileSet()
+			contract, err := parser.ParseFile(fset, "contract.go", files["mycc/contract.go"], 0)
+			if err != nil {
+				t.Fatalf("mycc/contract.go does not parse: %v", err)
+			}
+			if contract.Name.Name != "mycc" {
+				t.Errorf("Package = %s, want mycc", contract.Name.Name)
+			}
+
+			methods, literals := contractMethods(contract, "MyContract")
+			for _, fn := range tmpl.Functions {
+				if !methods[fn.Name] {
+					t.Errorf("Function %s is not a method of the contract", fn.Name)
+				}
+				delete(methods, fn.Name)
+			}
+			for name := range methods {
+				t.Errorf("Method %s is missing from template.yaml", name)
+			}
+			for _, event := range tmpl.Events {
+				if !literals[event.Name] {
+					t.Errorf("Event %s is never emitted", event.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderTypeScript(t *testing.T) {
+	rendered, err := Render("erc20", &Options{Language: "ts", ContractName: "LoyaltyPoints", PackageName: "@acme/points"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	files := make(map[string]string)
+	for _, f := range rendered {
+		files[f.Path] = string(f.Data)
+	}
+	if !strings.Contains(files["package.json"], `"name": "@acme/points"`) {
+		t.Errorf("package.json does not name the package:\n%s", files["package.json"])
+	}
+	source := files["src/index.ts"]
+	if !strings.Contains(source, "export class LoyaltyPoints extends Contract") ||
+		!strings.Contains(source, "contracts: any[] = [LoyaltyPoints]") {
+		t.Errorf("src/index.ts does not declare the contract class")
+	}
+
+	tmpl, _ := Get("erc20")
+	for _, fn := range tmpl.Functions {
+		if !strings.Contains(source, "public async "+fn.Name+"(") {
+			t.Errorf("Function %s is missing from the TypeScript contract", fn.Name)
+		}
+	}
+}
+
+func TestRenderRejectsInvalidNames(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+	}{
+		{"unexported contract", Options{ContractName: "token"}},
+		{"contract with quote", Options{ContractName: `Token"`}},
+		{"main package", Options{PackageName: "main"}},
+		{"keyword package", Options{PackageName: "func"}},
+		{"upper case package", Options{PackageName: "Token"}},
+		{"module with spaces", Options{ModulePath: "github.com/acme/my token"}},
+		{"module with dot segment", Options{ModulePath: "github.com/../token"}},
+		{"npm package with upper case", Options{Language: LanguageTypeScript, PackageName: "Token"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			if _, err := Render("erc20", &opts); !stdErr.Is(err, errors.ErrInvalidConfig) {
+				t.Errorf("Render() error = %v, want invalid configuration", err)
+			}
+		})
+	}
+}
+
+func TestWriteDir(t *testing.T) {
+	rendered, err := Render("asset-transfer", &Options{})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "assets")
+	if err := WriteDir(dir, rendered); err != nil {
+		t.Fatalf("WriteDir() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "assets", "contract.go")); err != nil {
+		t.Errorf("Contract not written: %v", err)
+	}
+
+	// Scaffolding over existing chaincode is refused
+	if err := WriteDir(dir, rendered); err == nil {
+		t.Error("WriteDir() into a non-empty folder should fail")
+	}
+}
+
+func TestWriteArchive(t *testing.T) {
+	rendered, err := Render("erc20", &Options{Language: LanguageTypeScript})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteArchive(&buf, rendered); err != nil {
+		t.Fatalf("WriteArchive() error = %v", err)
+	}
+
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("Archive is not gzipped: %v", err)
+	}
+	tr := tar.NewReader(gz)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Archive is not a tar: %v", err)
+		}
+		names = append(names, hdr.Name)
+	}
+	if got := strings.Join(names, ","); got != "package.json,src/index.ts,tsconfig.json" {
+		t.Errorf("Archive entries = %s", got)
+	}
+}
+
+// contractMethods returns the exported methods of a type and the string
+// literals of a file
+func contractMethods(file *ast.File, typeName string) (map[string]bool, map[string]bool) {
+	methods := make(map[string]bool)
+	literals := make(map[string]bool)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncDecl:
+			if node.Recv == nil || !node.Name.IsExported() {
+				return true
+			}
+			recv := node.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if ident, ok := recv.(*ast.Ident); ok && ident.Name == typeName {
+				methods[node.Name.Name] = true
+			}
+		case *ast.BasicLit:
+			if value, err := strconv.Unquote(node.Value); err == nil {
+				literals[value] = true
+			}
+		}
+		return true
+	})
+	return methods, literals
+}
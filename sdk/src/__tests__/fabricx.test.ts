@@ -19,10 +19,22 @@ describe('FabricX SDK', () => {
       initialize: jest.fn().mockResolvedValue(undefined),
       initNetwork: jest.fn(),
       deployChaincode: jest.fn(),
+      uploadChaincode: jest.fn(),
+      listTemplates: jest.fn(),
+      scaffoldChaincode: jest.fn(),
+      checkDeterminism: jest.fn(),
+      endorseTransaction: jest.fn(),
       invokeTransaction: jest.fn(),
       queryLedger: jest.fn(),
       getNetworkStatus: jest.fn(),
       stopNetwork: jest.fn(),
+      getCollections: jest.fn(),
+      listChaincodes: jest.fn(),
+      getChaincodeDefinition: jest.fn(),
+      approveChaincode: jest.fn(),
+      rejectChaincode: jest.fn(),
+      checkCommitReadiness: jest.fn(),
+      commitChaincode: jest.fn(),
       streamLogs: jest.fn(),
       close: jest.fn().mockResolvedValue(undefined),
       isConnected: jest.fn().mockReturnValue(true),
@@ -149,6 +161,99 @@ describe('FabricX SDK', () => {
       });
     });
 
+    it('should pass private data collection definitions as JSON', async () => {
+      mockClient.deployChaincode.mockResolvedValue({
+        success: true,
+        message: 'Chaincode deployed',
+        chaincode_id: 'private-abc123',
+      });
+
+      const collections = [
+        { name: 'Org1Private', policy: "OR('Org1MSP.member')", requiredPeerCount: 0, maxPeerCount: 1 },
+      ];
+      await fabricx.deployChaincode('private', { collectionsConfig: collections });
+
+      expect(mockClient.deployChaincode).toHaveBeenCalledWith(
+        expect.objectContaining({
+          collections_config: JSON.stringify(collections),
+        })
+      );
+    });
+
+    it('should report Go modules that could not be vendored', async () => {
+      mockClient.deployChaincode.mockResolvedValue({
+        success: false,
+        message: 'Failed to deploy chaincode: go module dependencies invalid',
+        chaincode_id: '',
+        reason: 'go_modules',
+      });
+
+      const result = await fabricx.deployChaincode('mycc', { vendor: true });
+
+      expect(mockClient.deployChaincode).toHaveBeenCalledWith(
+        expect.objectContaining({ vendor: true })
+      );
+      expect(result.success).toBe(false);
+      expect(result.reason).toBe('go_modules');
+    });
+
+    it('should upload a package and deploy it by upload ID', async () => {
+      mockClient.uploadChaincode.mockResolvedValue({
+        success: true,
+        message: 'Upload stored',
+        upload_id: 'upload-1',
+        size: '4',
+        sha256: 'abcd',
+        package_id: 'mycc_1.0:abcd',
+        label: 'mycc_1.0',
+        language: 'golang',
+      });
+      mockClient.deployChaincode.mockResolvedValue({
+        success: true,
+        message: 'Chaincode deployed',
+        chaincode_id: 'mycc-abc123',
+        package_id: 'mycc_1.0:abcd',
+      });
+
+      const upload = await fabricx.uploadChaincode(Buffer.from('data'), { kind: 'package' });
+      const result = await fabricx.deployChaincode('mycc', {
+        uploadId: upload.uploadId,
+        packageId: upload.packageId,
+      });
+
+      expect(mockClient.uploadChaincode).toHaveBeenCalledWith(
+        expect.objectContaining({ network_id: 'test-network-123', kind: 'package' }),
+        Buffer.from('data')
+      );
+      expect(upload.size).toBe(4);
+      expect(mockClient.deployChaincode).toHaveBeenCalledWith(
+        expect.objectContaining({
+          chaincode_path: '',
+          upload_id: 'upload-1',
+          package_id: 'mycc_1.0:abcd',
+        })
+      );
+      expect(result.packageId).toBe('mycc_1.0:abcd');
+    });
+
+    it('should deploy from a git repository', async () => {
+      mockClient.deployChaincode.mockResolvedValue({
+        success: true,
+        message: 'Chaincode deployed',
+        chaincode_id: 'mycc-abc123',
+      });
+
+      await fabricx.deployChaincode('mycc', { gitUrl: '/srv/git/mycc.git', gitRef: 'v1.2.0' });
+
+      expect(mockClient.deployChaincode).toHaveBeenCalledWith(
+        expect.objectContaining({
+          chaincode_path: '',
+          git_url: '/srv/git/mycc.git',
+          git_ref: 'v1.2.0',
+        })
+      );
+    });
+
     it('should throw error if network not initialized', async () => {
       const uninitializedFabricx = new FabricX({
         useConnectionPool: false,
@@ -162,6 +267,136 @@ describe('FabricX SDK', () => {
     });
   });
 
+  describe('templates', () => {
+    it('should list templates with their functions and events', async () => {
+      mockClient.listTemplates.mockResolvedValue({
+        success: true,
+        message: '1 templates',
+        templates: [
+          {
+            name: 'erc20',
+            title: 'ERC-20 Token',
+            description: 'Fungible token',
+            languages: ['go', 'typescript'],
+            functions: [{ name: 'BalanceOf', description: 'Balance', args: ['account'], query: true }],
+            events: [{ name: 'Transfer', description: 'Tokens moved' }],
+            module_path: 'erc20',
+            contract_name: 'ERC20Token',
+            package_name: 'token',
+          },
+        ],
+      });
+
+      const templates = await fabricx.listTemplates();
+
+      expect(templates).toHaveLength(1);
+      expect(templates[0].functions[0]).toEqual({
+        name: 'BalanceOf',
+        description: 'Balance',
+        args: ['account'],
+        query: true,
+      });
+      expect(templates[0].defaults.contractName).toBe('ERC20Token');
+    });
+
+    it('should scaffold chaincode as an archive', async () => {
+      mockClient.scaffoldChaincode.mockResolvedValue({
+        success: true,
+        message: 'Rendered erc20 with 3 files',
+        path: '',
+        archive: Buffer.from('archive'),
+        files: ['go.mod', 'main.go', 'points/contract.go'],
+        language: 'go',
+        module_path: 'github.com/acme/points',
+        contract_name: 'LoyaltyPoints',
+        package_name: 'points',
+      });
+
+      const result = await fabricx.scaffoldChaincode('erc20', {
+        modulePath: 'github.com/acme/points',
+        contractName: 'LoyaltyPoints',
+        packageName: 'points',
+      });
+
+      expect(mockClient.scaffoldChaincode).toHaveBeenCalledWith({
+        template: 'erc20',
+        language: '',
+        module_path: 'github.com/acme/points',
+        contract_name: 'LoyaltyPoints',
+        package_name: 'points',
+        target_dir: '',
+      });
+      expect(result.archive).toEqual(Buffer.from('archive'));
+      expect(result.path).toBeUndefined();
+    });
+
+    it('should surface unknown templates', async () => {
+      mockClient.scaffoldChaincode.mockResolvedValue({
+        success: false,
+        message: 'Template nft not found',
+      });
+
+      await expect(fabricx.scaffoldChaincode('nft')).rejects.toThrow('Template nft not found');
+    });
+  });
+
+  describe('checkDeterminism', () => {
+    beforeEach(async () => {
+      mockClient.initNetwork.mockResolvedValue({
+        success: true,
+        message: 'Network initialized',
+        network_id: 'test-network-123',
+        endpoints: ['localhost:7051'],
+      });
+      await fabricx.initNetwork();
+    });
+
+    it('should report findings', async () => {
+      mockClient.checkDeterminism.mockResolvedValue({
+        success: true,
+        message: '1 errors, 0 warnings',
+        diagnostics: [
+          {
+            check: 'time',
+            severity: 'error',
+            file: 'main.go',
+            line: 14,
+            column: 42,
+            function: 'Clock.Tick',
+            message: 'time.Now differs between endorsers; use the transaction timestamp from ctx.GetStub().GetTxTimestamp()',
+          },
+        ],
+        errors: 1,
+        warnings: 0,
+      });
+
+      const result = await fabricx.checkDeterminism('clock', { path: './clock' });
+
+      expect(mockClient.checkDeterminism).toHaveBeenCalledWith({
+        network_id: 'test-network-123',
+        chaincode_name: 'clock',
+        chaincode_path: './clock',
+        language: '',
+      });
+      expect(result.errors).toBe(1);
+      expect(result.diagnostics[0]).toMatchObject({ check: 'time', file: 'main.go', line: 14 });
+    });
+
+    it('should surface failures', async () => {
+      mockClient.checkDeterminism.mockResolvedValue({
+        success: false,
+        message: 'Failed to check chaincode: only golang chaincode can be checked for determinism',
+        diagnostics: [],
+        errors: 0,
+        warnings: 0,
+      });
+
+      await expect(fabricx.checkDeterminism('clock', { language: 'java' })).rejects.toThrow(
+        'only golang chaincode can be checked'
+      );
+    });
+  });
+
   describe('invoke', () => {
     beforeEach(async () => {
       mockClient.initNetwork.mockResolvedValue({
@@ -179,6 +414,8 @@ describe('FabricX SDK', () => {
         message: 'Transaction invoked',
         transaction_id: 'tx-123',
         payload: Buffer.from(JSON.stringify({ result: 'success' })),
+        block_number: '7',
+        validation_code: 'VALID',
       };
 
       mockClient.invokeTransaction.mockResolvedValue(mockResponse);
@@ -188,15 +425,88 @@ describe('FabricX SDK', () => {
       expect(result.success).toBe(true);
       expect(result.transactionId).toBe('tx-123');
       expect(result.payload).toBeDefined();
+      expect(result.blockNumber).toBe(7);
+      expect(result.validationCode).toBe('VALID');
       expect(mockClient.invokeTransaction).toHaveBeenCalledWith({
         network_id: 'test-network-123',
         chaincode_name: 'mycc',
         function_name: 'createAsset',
         args: ['asset1', 'value1'],
-        transient: false,
       });
     });
 
+    it('should report an invalidated transaction as retryable', async () => {
+      mockClient.invokeTransaction.mockResolvedValue({
+        success: false,
+        message: 'Transaction failed: transaction invalidated',
+        transaction_id: 'tx-123',
+        payload: Buffer.from('ok'),
+        block_number: '12',
+        validation_code: 'MVCC_READ_CONFLICT',
+        endorsing_peers: ['peer0.org1.example.com', 'peer0.org2.example.com'],
+        chaincode_status: 200,
+        chaincode_message: '',
+        reason: 'invalidated',
+        retryable: true,
+      });
+
+      const result = await fabricx.invoke('mycc', 'transfer', ['bob', '10']);
+
+      expect(result.success).toBe(false);
+      expect(result.reason).toBe('invalidated');
+      expect(result.retryable).toBe(true);
+      expect(result.validationCode).toBe('MVCC_READ_CONFLICT');
+      expect(result.blockNumber).toBe(12);
+      expect(result.endorsingPeers).toEqual(['peer0.org1.example.com', 'peer0.org2.example.com']);
+      expect(result.chaincodeStatus).toBe(200);
+    });
+
+    it('should report a transaction rejected at endorsement', async () => {
+      mockClient.invokeTransaction.mockResolvedValue({
+        success: false,
+        message: 'Transaction failed: transaction rejected at endorsement',
+        transaction_id: 'tx-123',
+        payload: Buffer.from(''),
+        block_number: '0',
+        validation_code: 'NOT_VALIDATED',
+        endorsing_peers: ['peer0.org1.example.com'],
+        chaincode_status: 500,
+        chaincode_message: 'asset asset1 already exists',
+        reason: 'endorsement_rejected',
+        retryable: false,
+      });
+
+      const result = await fabricx.invoke('mycc', 'createAsset', ['asset1']);
+
+      expect(result.reason).toBe('endorsement_rejected');
+      expect(result.retryable).toBeUndefined();
+      expect(result.blockNumber).toBeUndefined();
+      expect(result.chaincodeStatus).toBe(500);
+      expect(result.chaincodeMessage).toBe('asset asset1 already exists');
+    });
+
+    it('should report endorsers that returned different results', async () => {
+      mockClient.invokeTransaction.mockResolvedValue({
+        success: false,
+        message: 'Transaction failed: endorsement responses do not match',
+        transaction_id: 'tx-123',
+        payload: Buffer.from(''),
+        block_number: '0',
+        validation_code: 'NOT_VALIDATED',
+        endorsing_peers: [],
+        chaincode_status: 0,
+        chaincode_message: '',
+        reason: 'endorsement_mismatch',
+        retryable: false,
+      });
+
+      const result = await fabricx.invoke('mycc', 'createAsset', ['asset1']);
+
+      expect(result.success).toBe(false);
+      expect(result.reason).toBe('endorsement_mismatch');
+      expect(result.retryable).toBeUndefined();
+    });
+
     it('should handle transient data option', async () => {
       const mockResponse = {
         success: true,
@@ -207,14 +517,175 @@ describe('FabricX SDK', () => {
 
       mockClient.invokeTransaction.mockResolvedValue(mockResponse);
 
-      await fabricx.invoke('mycc', 'privateFunc', ['arg1'], { transient: true });
+      await fabricx.invoke('mycc', 'privateFunc', ['arg1'], {
+        transient: { price: '100', blob: new Uint8Array([1, 2]) },
+        collection: 'Org1Private',
+      });
+
+      expect(mockClient.invokeTransaction).toHaveBeenCalledWith(
+        expect.objectContaining({
+          transient: { price: Buffer.from('100'), blob: Buffer.from([1, 2]) },
+          collection: 'Org1Private',
+        })
+      );
+    });
+
+    it('should pass endorsement options', async () => {
+      mockClient.invokeTransaction.mockResolvedValue({
+        success: true,
+        message: 'Transaction invoked',
+        transaction_id: 'tx-123',
+        payload: Buffer.from(''),
+      });
+
+      await fabricx.invoke('mycc', 'transfer', ['bob', '10'], {
+        endorsingPeers: ['peer1.org1.example.com'],
+        autoEndorse: false,
+      });
 
       expect(mockClient.invokeTransaction).toHaveBeenCalledWith(
         expect.objectContaining({
-          transient: true,
+          endorsing_peers: ['peer1.org1.example.com'],
+          auto_endorse: false,
         })
       );
     });
+
+    it('should report endorsers that disagree', async () => {
+      mockClient.invokeTransaction.mockResolvedValue({
+        success: true,
+        message: 'Endorsers disagree on 1 parts of the response, the transaction was not submitted',
+        transaction_id: '',
+        payload: Buffer.from(''),
+        determinism: {
+          consistent: false,
+          endorsers: [
+            {
+              peer: 'peer0.org1.example.com',
+              org: 'Org1',
+              status: 200,
+              message: '',
+              payload: Buffer.from(''),
+              error: '',
+            },
+            {
+              peer: 'peer0.org2.example.com',
+              org: 'Org2',
+              status: 200,
+              message: '',
+              payload: Buffer.from(''),
+              error: '',
+            },
+          ],
+          divergences: [
+            {
+              kind: 'write',
+              namespace: 'mycc',
+              collection: '',
+              key: 'asset1',
+              values: {
+                'peer0.org1.example.com': '12:00:01',
+                'peer0.org2.example.com': '12:00:02',
+              },
+            },
+          ],
+        },
+      });
+
+      const result = await fabricx.invoke('mycc', 'createAsset', ['asset1'], {
+        checkDeterminism: true,
+      });
+
+      expect(mockClient.invokeTransaction).toHaveBeenCalledWith(
+        expect.objectContaining({ check_determinism: true })
+      );
+      expect(result.determinism?.consistent).toBe(false);
+      expect(result.determinism?.endorsers[0].error).toBeUndefined();
+      expect(result.determinism?.divergences[0]).toEqual({
+        kind: 'write',
+        namespace: 'mycc',
+        collection: undefined,
+        key: 'asset1',
+        values: {
+          'peer0.org1.example.com': '12:00:01',
+          'peer0.org2.example.com': '12:00:02',
+        },
+      });
+    });
+
+    it('should return each endorser\'s read-write set without submitting', async () => {
+      mockClient.endorseTransaction.mockResolvedValue({
+        success: true,
+        message: 'Endorsed by 1 peers, the transaction was not submitted',
+        transaction_id: 'tx-123',
+        endorsers: [
+          {
+            peer: 'peer0.org1.example.com',
+            org: 'Org1',
+            status: 200,
+            message: '',
+            payload: Buffer.from('ok'),
+            error: '',
+            event: { name: 'Transferred', payload: Buffer.from('') },
+            rwsets: [
+              {
+                namespace: 'mycc',
+                reads: [
+                  { key: 'asset1', version: { block_num: '5', tx_num: '2' } },
+                  { key: 'asset2', version: null },
+                ],
+                writes: [{ key: 'asset1', value: Buffer.from('bob'), is_delete: false }],
+                range_queries: [],
+                collections: [
+                  {
+                    collection: 'Org1Private',
+                    reads: [],
+                    writes: [
+                      {
+                        key_hash: Buffer.from([1]),
+                        value_hash: Buffer.from([2]),
+                        is_delete: false,
+                        is_purge: false,
+                      },
+                    ],
+                  },
+                ],
+              },
+            ],
+          },
+        ],
+      });
+
+      const result = await fabricx.endorse('mycc', 'transfer', ['asset1', 'bob'], {
+        endorsingOrgs: ['Org1'],
+      });
+
+      expect(mockClient.endorseTransaction).toHaveBeenCalledWith(
+        expect.objectContaining({ function_name: 'transfer', endorsing_orgs: ['Org1'] })
+      );
+      expect(mockClient.invokeTransaction).not.toHaveBeenCalled();
+      expect(result.transactionId).toBe('tx-123');
+
+      const [endorser] = result.endorsers;
+      expect(endorser.event).toEqual({ name: 'Transferred', payload: undefined });
+      expect(endorser.rwsets[0].reads).toEqual([
+        { key: 'asset1', version: { blockNum: 5, txNum: 2 } },
+        { key: 'asset2', version: undefined },
+      ]);
+      expect(Buffer.from(endorser.rwsets[0].writes[0].value!).toString()).toBe('bob');
+      expect(endorser.rwsets[0].collections[0].writes[0].valueHash).toEqual(new Uint8Array([2]));
+    });
+
+    it('should throw when no endorser responds', async () => {
+      mockClient.endorseTransaction.mockResolvedValue({
+        success: false,
+        message: 'Endorsement failed: transaction failed',
+        transaction_id: '',
+        endorsers: [],
+      });
+
+      await expect(fabricx.endorse('mycc', 'transfer', [])).rejects.toThrow('Endorsement failed');
+    });
   });
 
   describe('query', () => {
@@ -250,6 +721,101 @@ describe('FabricX SDK', () => {
     });
   });
 
+  describe('getChaincodeDefinition', () => {
+    it('should report which orgs approved the pending definition', async () => {
+      fabricx.setNetworkId('test-network-123');
+
+      mockClient.getChaincodeDefinition.mockResolvedValue({
+        success: true,
+        message: 'Chaincode mycc',
+        channels: [
+          {
+            channel: 'trade',
+            committed: null,
+            pending: {
+              name: 'mycc',
+              version: '1.0',
+              sequence: '1',
+              endorsement_policy: "OR('Org1MSP.peer','Org2MSP.peer')",
+              collections: [],
+              init_required: false,
+              approvals: { Org1MSP: true, Org2MSP: false },
+            },
+          },
+        ],
+        installed: [
+          {
+            org: 'Org1',
+            peer: 'peer0.org1.example.com',
+            packages: [{ package_id: 'mycc_1.0:abc', label: 'mycc_1.0', references: [] }],
+          },
+        ],
+      });
+
+      const result = await fabricx.getChaincodeDefinition('mycc');
+
+      expect(result.channels[0].channel).toBe('trade');
+      expect(result.channels[0].committed).toBeUndefined();
+      expect(result.channels[0].pending?.sequence).toBe(1);
+      expect(result.channels[0].pending?.approvals).toEqual({ Org1MSP: true, Org2MSP: false });
+      expect(result.installed[0].packages[0].packageId).toBe('mycc_1.0:abc');
+      expect(mockClient.getChaincodeDefinition).toHaveBeenCalledWith({
+        network_id: 'test-network-123',
+        chaincode_name: 'mycc',
+      });
+    });
+  });
+
+  describe('commitChaincode', () => {
+    it('should surface a rejected definition as a distinct error', async () => {
+      fabricx.setNetworkId('test-network-123');
+
+      mockClient.commitChaincode.mockResolvedValue({
+        success: false,
+        message: 'chaincode definition rejected',
+        chaincode_id: '',
+        version: '',
+        sequence: '0',
+        reason: 'rejected',
+      });
+
+      await expect(fabricx.commitChaincode('mycc')).rejects.toMatchObject({
+        code: 'DEFINITION_REJECTED',
+      });
+    });
+
+    it('should commit once the orgs approved', async () => {
+      fabricx.setNetworkId('test-network-123');
+
+      mockClient.checkCommitReadiness.mockResolvedValue({
+        success: true,
+        message: 'Definition is ready to commit',
+        version: '1.0',
+        sequence: '1',
+        approvals: { Org1MSP: true, Org2MSP: true },
+        rejections: {},
+        mismatched: [],
+        lifecycle_policy: "OutOf(2,'Org1MSP.peer','Org2MSP.peer')",
+        ready: true,
+      });
+      mockClient.commitChaincode.mockResolvedValue({
+        success: true,
+        message: 'Chaincode committed successfully',
+        chaincode_id: 'mycc-1a2b3c4d',
+        version: '1.0',
+        sequence: '1',
+        reason: '',
+      });
+
+      const readiness = await fabricx.checkCommitReadiness('mycc');
+      expect(readiness.ready).toBe(true);
+      expect(readiness.sequence).toBe(1);
+
+      const result = await fabricx.commitChaincode('mycc');
+      expect(result).toEqual({ chaincodeId: 'mycc-1a2b3c4d', version: '1.0', sequence: 1 });
+    });
+  });
+
   describe('getNetworkStatus', () => {
     it('should get network status successfully', async () => {
       fabricx.setNetworkId('test-network-123');
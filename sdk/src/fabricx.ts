@@ -1,22 +1,66 @@
 // sdk/src/fabricx.ts
-import { GrpcClient } from './grpc/client';
+import { createHash } from 'crypto';
+import * as fs from 'fs';
+import * as path from 'path';
+import {
+  GrpcClient,
+  CollectionMessage,
+  ChaincodeDefinitionMessage,
+  PeerPackagesMessage,
+  DeterminismDiagnosticMessage,
+  DeterminismReportMessage,
+  EndorserResponseMessage,
+  NamespaceReadWriteSetMessage,
+} from './grpc/client';
 import { ConnectionPool, ConnectionPoolConfig, PoolStats } from './grpc/connection-pool';
 import {
   InitNetworkOptions,
   InitNetworkResult,
   DeployChaincodeOptions,
   DeployChaincodeResult,
+  UpgradeChaincodeResult,
+  UploadChaincodeOptions,
+  UploadChaincodeResult,
+  ChaincodeTemplate,
+  ScaffoldChaincodeOptions,
+  ScaffoldChaincodeResult,
+  CheckDeterminismOptions,
+  CheckDeterminismResult,
+  DeterminismDiagnostic,
+  DeterminismReport,
+  Divergence,
+  CommitReadiness,
+  CommitChaincodeResult,
   InvokeTransactionOptions,
   InvokeTransactionResult,
+  TxValidationCode,
+  EndorseTransactionOptions,
+  EndorseTransactionResult,
+  EndorserResponse,
+  NamespaceReadWriteSet,
+  KeyVersion,
   QueryLedgerOptions,
   QueryLedgerResult,
   NetworkStatusResult,
+  ExportTopologyResult,
+  RegisterIdentityOptions,
+  IdentityResult,
+  IdentityInfo,
+  CollectionInfo,
+  ChaincodeDefinition,
+  PeerPackages,
+  ListChaincodesResult,
+  ChaincodeDefinitionResult,
   StopNetworkOptions,
   LogStreamHandler,
+  WatchChaincodeOptions,
+  WatchEvent,
+  WatchEventHandler,
   FabricXError,
 } from './types';
 import { Logger, LogLevel } from './utils/logger';
 import { RetryManager, RetryOptions } from './utils/retry';
+import { createSourceArchive, extractArchive, isLifecyclePackage } from './utils/archive';
 
 /**
  * FabricX SDK Configuration
@@ -104,6 +148,9 @@ export class FabricX {
         num_orgs: options?.numOrgs || 2,
         channel_name: options?.channelName || 'mychannel',
         config: options?.config || {},
+        topology: options?.topology,
+        crypto_provider: options?.cryptoProvider,
+        separate_tls_ca: options?.separateTlsCa,
       });
     });
 
@@ -134,14 +181,26 @@ export class FabricX {
     this.ensureNetworkId();
     this.logger.info(`Deploying chaincode: ${chaincodeName}`, options);
 
+    const source = await this.chaincodeSource(chaincodeName, options);
     const result = await this.executeWithRetry(async (client) => {
       return client.deployChaincode({
         network_id: this.networkId!,
         chaincode_name: chaincodeName,
-        chaincode_path: options?.path || `./${chaincodeName}`,
+        ...source,
         version: options?.version || '1.0',
-        language: options?.language || 'golang',
+        language: options?.language || '',
         endorsement_policy_orgs: options?.endorsementPolicyOrgs || [],
+        endorsement_policy: options?.endorsementPolicy,
+        collections_config:
+          typeof options?.collectionsConfig === 'object'
+            ? JSON.stringify(options.collectionsConfig)
+            : options?.collectionsConfig,
+        mode: options?.mode,
+        debug: options?.debug,
+        debug_port: options?.debugPort,
+        stage: options?.stage,
+        vendor: options?.vendor,
+        determinism_check: options?.determinismCheck,
       });
     });
 
@@ -155,6 +214,263 @@ export class FabricX {
       success: result.success,
       message: result.message,
       chaincodeId: result.chaincode_id,
+      debugPort: result.debug_port || undefined,
+      staged: result.staged || undefined,
+      reason: result.reason || undefined,
+      packageId: result.package_id || undefined,
+      diagnostics: this.toDiagnostics(result.diagnostics),
+    };
+  }
+
+  /**
+   * Upload a local chaincode folder, source archive or lifecycle package to
+   * the runtime, so a machine that is not the runtime host can deploy it
+   */
+  async uploadChaincode(
+    source: string | Buffer,
+    options?: UploadChaincodeOptions
+  ): Promise<UploadChaincodeResult> {
+    this.ensureNetworkId();
+    this.logger.info('Uploading chaincode', { source: typeof source === 'string' ? source : 'buffer' });
+
+    let data: Buffer;
+    let fileName = options?.fileName;
+    if (Buffer.isBuffer(source)) {
+      data = source;
+      fileName = fileName || 'chaincode.tar.gz';
+    } else if (fs.statSync(source).isDirectory()) {
+      data = createSourceArchive(source);
+      fileName = fileName || `${path.basename(path.resolve(source))}.tar.gz`;
+    } else {
+      data = fs.readFileSync(source);
+      fileName = fileName || path.basename(source);
+    }
+
+    const sha256 = createHash('sha256').update(data).digest('hex');
+    const result = await this.executeWithRetry(async (client) => {
+      return client.uploadChaincode(
+        {
+          network_id: this.networkId!,
+          kind: options?.kind || (isLifecyclePackage(data) ? 'package' : 'source'),
+          file_name: fileName,
+          sha256,
+        },
+        data
+      );
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'CHAINCODE_UPLOAD_ERROR');
+    }
+
+    this.logger.info(`Chaincode uploaded: ${result.upload_id}`);
+
+    return {
+      uploadId: result.upload_id,
+      size: Number(result.size),
+      sha256: result.sha256,
+      packageId: result.package_id || undefined,
+      label: result.label || undefined,
+      language: result.language || undefined,
+    };
+  }
+
+  /**
+   * List the chaincode templates embedded in the runtime with their
+   * functions and events
+   */
+  async listTemplates(): Promise<ChaincodeTemplate[]> {
+    const result = await this.executeWithRetry(async (client) => {
+      return client.listTemplates();
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'TEMPLATE_QUERY_ERROR');
+    }
+
+    return (result.templates || []).map((template) => ({
+      name: template.name,
+      title: template.title,
+      description: template.description,
+      languages: template.languages || [],
+      functions: (template.functions || []).map((fn) => ({
+        name: fn.name,
+        description: fn.description,
+        args: fn.args || [],
+        query: fn.query,
+      })),
+      events: template.events || [],
+      defaults: {
+        modulePath: template.module_path,
+        contractName: template.contract_name,
+        packageName: template.package_name,
+      },
+    }));
+  }
+
+  /**
+   * Start a new chaincode from a template. The chaincode is written to
+   * targetDir on this machine, to runtimeDir on the runtime host, or
+   * returned as an archive when neither is given.
+   */
+  async scaffoldChaincode(
+    template: string,
+    options?: ScaffoldChaincodeOptions
+  ): Promise<ScaffoldChaincodeResult> {
+    this.logger.info(`Scaffolding chaincode from template: ${template}`, options);
+
+    if (options?.targetDir && fs.existsSync(options.targetDir)) {
+      if (fs.readdirSync(options.targetDir).length > 0) {
+        throw new FabricXError(`${options.targetDir} is not empty`, 'SCAFFOLD_ERROR');
+      }
+    }
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.scaffoldChaincode({
+        template,
+        language: options?.language || '',
+        module_path: options?.modulePath || '',
+        contract_name: options?.contractName || '',
+        package_name: options?.packageName || '',
+        target_dir: options?.runtimeDir || '',
+      });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'SCAFFOLD_ERROR');
+    }
+
+    let chaincodePath = result.path || undefined;
+    if (options?.targetDir && !options.runtimeDir) {
+      extractArchive(result.archive, options.targetDir);
+      chaincodePath = options.targetDir;
+    }
+
+    this.logger.info(`Chaincode scaffolded from ${template}`, { path: chaincodePath });
+
+    return {
+      language: result.language,
+      modulePath: result.module_path,
+      contractName: result.contract_name,
+      packageName: result.package_name,
+      files: result.files || [],
+      path: chaincodePath,
+      archive: result.archive && result.archive.length > 0 ? result.archive : undefined,
+    };
+  }
+
+  /**
+   * Check Go chaincode for code endorsers can execute differently, such as
+   * wall clock reads or map iteration that reaches the ledger, without
+   * deploying it
+   */
+  async checkDeterminism(
+    chaincodeName: string,
+    options?: CheckDeterminismOptions
+  ): Promise<CheckDeterminismResult> {
+    this.ensureNetworkId();
+    this.logger.info(`Checking chaincode for non-determinism: ${chaincodeName}`, options);
+
+    const { package_id: _packageId, ...source } = await this.chaincodeSource(chaincodeName, options);
+    const result = await this.executeWithRetry(async (client) => {
+      return client.checkDeterminism({
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+        ...source,
+        language: options?.language || '',
+      });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'DETERMINISM_CHECK_ERROR');
+    }
+
+    return {
+      diagnostics: this.toDiagnostics(result.diagnostics) || [],
+      errors: result.errors,
+      warnings: result.warnings,
+    };
+  }
+
+  /**
+   * Where the runtime reads the chaincode of a deploy or upgrade from,
+   * uploading it first when asked to
+   */
+  private async chaincodeSource(chaincodeName: string, options?: DeployChaincodeOptions) {
+    const chaincodePath = options?.path || `./${chaincodeName}`;
+    if (options?.upload) {
+      const upload = await this.uploadChaincode(chaincodePath);
+      return { chaincode_path: '', upload_id: upload.uploadId, package_id: options.packageId };
+    }
+    if (options?.uploadId || options?.gitUrl) {
+      return {
+        chaincode_path: '',
+        upload_id: options.uploadId,
+        git_url: options.gitUrl,
+        git_ref: options.gitRef,
+        package_id: options.packageId,
+      };
+    }
+    return { chaincode_path: chaincodePath, package_id: options?.packageId };
+  }
+
+  /**
+   * Upgrade a deployed chaincode to the next sequence. The version defaults
+   * to the committed one; unchanged chaincode is not reinstalled.
+   */
+  async upgradeChaincode(
+    chaincodeName: string,
+    options?: DeployChaincodeOptions
+  ): Promise<UpgradeChaincodeResult> {
+    this.ensureNetworkId();
+    this.logger.info(`Upgrading chaincode: ${chaincodeName}`, options);
+
+    const source = await this.chaincodeSource(chaincodeName, options);
+    const result = await this.executeWithRetry(async (client) => {
+      return client.upgradeChaincode({
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+        ...source,
+        version: options?.version || '',
+        language: options?.language || '',
+        endorsement_policy_orgs: options?.endorsementPolicyOrgs || [],
+        endorsement_policy: options?.endorsementPolicy,
+        collections_config:
+          typeof options?.collectionsConfig === 'object'
+            ? JSON.stringify(options.collectionsConfig)
+            : options?.collectionsConfig,
+        mode: options?.mode,
+        debug: options?.debug,
+        debug_port: options?.debugPort,
+        stage: options?.stage,
+        vendor: options?.vendor,
+        determinism_check: options?.determinismCheck,
+      });
+    });
+
+    if (result.success) {
+      this.logger.info(
+        `Chaincode upgraded: ${result.old_version} -> ${result.new_version} (sequence ${result.new_sequence})`
+      );
+    } else {
+      this.logger.error('Chaincode upgrade failed', result.message);
+    }
+
+    return {
+      success: result.success,
+      message: result.message,
+      chaincodeId: result.chaincode_id,
+      oldVersion: result.old_version,
+      newVersion: result.new_version,
+      oldSequence: Number(result.old_sequence),
+      newSequence: Number(result.new_sequence),
+      reinstalled: result.reinstalled,
+      restarted: result.restarted,
+      debugPort: result.debug_port || undefined,
+      staged: result.staged || undefined,
+      reason: result.reason || undefined,
+      packageId: result.package_id || undefined,
+      diagnostics: this.toDiagnostics(result.diagnostics),
     };
   }
 
@@ -176,12 +492,19 @@ export class FabricX {
         chaincode_name: chaincode,
         function_name: func,
         args,
-        transient: options?.transient || false,
+        org: options?.org,
+        identity: options?.identity,
+        transient: options?.transient ? this.encodeTransient(options.transient) : undefined,
+        collection: options?.collection,
+        endorsing_orgs: options?.endorsingOrgs,
+        endorsing_peers: options?.endorsingPeers,
+        auto_endorse: options?.autoEndorse,
+        check_determinism: options?.checkDeterminism,
       });
     });
 
     if (result.success) {
-      this.logger.info(`Transaction successful: ${result.transaction_id}`);
+      this.logger.info(`Transaction successful: ${result.transaction_id || result.message}`);
     } else {
       this.logger.error('Transaction failed', result.message);
     }
@@ -191,6 +514,56 @@ export class FabricX {
       message: result.message,
       transactionId: result.transaction_id,
       payload: result.payload ? new Uint8Array(result.payload) : undefined,
+      blockNumber:
+        result.validation_code && result.validation_code !== 'NOT_VALIDATED'
+          ? Number(result.block_number)
+          : undefined,
+      validationCode: (result.validation_code as TxValidationCode) || undefined,
+      endorsingPeers: result.endorsing_peers?.length ? result.endorsing_peers : undefined,
+      chaincodeStatus: result.chaincode_status || undefined,
+      chaincodeMessage: result.chaincode_message || undefined,
+      reason: (result.reason as InvokeTransactionResult['reason']) || undefined,
+      retryable: result.retryable || undefined,
+      determinism: result.determinism ? this.toDeterminismReport(result.determinism) : undefined,
+    };
+  }
+
+  /**
+   * Endorse a transaction without submitting it and return what each
+   * endorser would commit: response, event and read-write set
+   */
+  async endorse(
+    chaincode: string,
+    func: string,
+    args: string[],
+    options?: EndorseTransactionOptions
+  ): Promise<EndorseTransactionResult> {
+    this.ensureNetworkId();
+    this.logger.info(`Endorsing transaction: ${chaincode}.${func}`, { args });
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.endorseTransaction({
+        network_id: options?.networkId || this.networkId!,
+        chaincode_name: chaincode,
+        function_name: func,
+        args,
+        org: options?.org,
+        identity: options?.identity,
+        transient: options?.transient ? this.encodeTransient(options.transient) : undefined,
+        collection: options?.collection,
+        endorsing_orgs: options?.endorsingOrgs,
+        endorsing_peers: options?.endorsingPeers,
+        auto_endorse: options?.autoEndorse,
+      });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'ENDORSE_FAILED');
+    }
+
+    return {
+      transactionId: result.transaction_id,
+      endorsers: (result.endorsers || []).map((e) => this.toEndorserResponse(e)),
     };
   }
 
@@ -212,6 +585,8 @@ export class FabricX {
         chaincode_name: chaincode,
         function_name: func,
         args,
+        org: options?.org,
+        identity: options?.identity,
       });
     });
 
@@ -260,6 +635,314 @@ export class FabricX {
     };
   }
 
+  /**
+   * Export the topology of a network as YAML or JSON
+   */
+  async exportTopology(
+    format: 'yaml' | 'json' = 'yaml',
+    networkId?: string
+  ): Promise<ExportTopologyResult> {
+    const id = networkId || this.networkId;
+    if (!id) {
+      throw new FabricXError(
+        'No network ID available. Initialize a network first or provide a network ID.',
+        'NO_NETWORK_ID'
+      );
+    }
+
+    this.logger.info(`Exporting network topology: ${id}`);
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.exportTopology({ network_id: id, format });
+    });
+
+    return {
+      success: result.success,
+      message: result.message,
+      topology: result.topology,
+      format: result.format,
+    };
+  }
+
+  /**
+   * Register an identity with an organization's CA
+   */
+  async registerIdentity(
+    org: string,
+    name: string,
+    options?: RegisterIdentityOptions
+  ): Promise<IdentityResult> {
+    this.ensureNetworkId();
+    this.logger.info(`Registering identity ${name} in ${org}`);
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.registerIdentity({
+        network_id: this.networkId!,
+        org,
+        name,
+        secret: options?.secret || '',
+        type: options?.type || 'client',
+        affiliation: options?.affiliation || '',
+        attributes: options?.attributes || {},
+        max_enrollments: options?.maxEnrollments || 0,
+      });
+    });
+
+    return {
+      success: result.success,
+      message: result.message,
+      secret: result.secret,
+    };
+  }
+
+  /**
+   * Enroll a registered identity; its MSP is stored in the network's crypto directory
+   */
+  async enrollIdentity(
+    org: string,
+    name: string,
+    secret: string,
+    attributes?: string[]
+  ): Promise<IdentityResult> {
+    this.ensureNetworkId();
+    this.logger.info(`Enrolling identity ${name} in ${org}`);
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.enrollIdentity({
+        network_id: this.networkId!,
+        org,
+        name,
+        secret,
+        attributes: attributes || [],
+      });
+    });
+
+    return {
+      success: result.success,
+      message: result.message,
+      mspPath: result.msp_path,
+    };
+  }
+
+  /**
+   * Revoke an identity
+   */
+  async revokeIdentity(org: string, name: string, reason?: string): Promise<IdentityResult> {
+    this.ensureNetworkId();
+    this.logger.info(`Revoking identity ${name} in ${org}`);
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.revokeIdentity({
+        network_id: this.networkId!,
+        org,
+        name,
+        reason: reason || '',
+      });
+    });
+
+    return {
+      success: result.success,
+      message: result.message,
+    };
+  }
+
+  /**
+   * List identities registered with an organization's CA
+   */
+  async listIdentities(org: string): Promise<IdentityInfo[]> {
+    this.ensureNetworkId();
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.listIdentities({ network_id: this.networkId!, org });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'IDENTITY_ERROR');
+    }
+
+    return (result.identities || []).map((id) => ({
+      name: id.name,
+      type: id.type,
+      affiliation: id.affiliation,
+      maxEnrollments: id.max_enrollments,
+      attributes: id.attributes || {},
+    }));
+  }
+
+  /**
+   * Get private data collections and the peers that hold them
+   */
+  async getCollections(chaincode?: string): Promise<CollectionInfo[]> {
+    this.ensureNetworkId();
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.getCollections({ network_id: this.networkId!, chaincode_name: chaincode });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'COLLECTION_ERROR');
+    }
+
+    return (result.collections || []).map((c) => this.toCollectionInfo(c));
+  }
+
+  /**
+   * List the packages installed on each peer and the chaincode definitions
+   * committed on every channel
+   */
+  async listChaincodes(): Promise<ListChaincodesResult> {
+    this.ensureNetworkId();
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.listChaincodes({ network_id: this.networkId! });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'CHAINCODE_QUERY_ERROR');
+    }
+
+    return {
+      channels: (result.channels || []).map((ch) => ({
+        channel: ch.channel,
+        committed: (ch.committed || []).map((def) => this.toChaincodeDefinition(def)),
+      })),
+      installed: this.toPeerPackages(result.installed),
+    };
+  }
+
+  /**
+   * Get the committed definition of a chaincode and the definition pending
+   * at the next sequence with each member's approval on every channel, and
+   * its installed packages
+   */
+  async getChaincodeDefinition(chaincodeName: string): Promise<ChaincodeDefinitionResult> {
+    this.ensureNetworkId();
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.getChaincodeDefinition({
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+      });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'CHAINCODE_QUERY_ERROR');
+    }
+
+    return {
+      channels: (result.channels || []).map((ch) => ({
+        channel: ch.channel,
+        committed: ch.committed ? this.toChaincodeDefinition(ch.committed) : undefined,
+        pending: ch.pending ? this.toChaincodeDefinition(ch.pending) : undefined,
+      })),
+      installed: this.toPeerPackages(result.installed),
+    };
+  }
+
+  /**
+   * Approve a chaincode definition staged with the stage option for one org
+   */
+  async approveChaincode(chaincodeName: string, org: string): Promise<void> {
+    this.ensureNetworkId();
+    this.logger.info(`Approving chaincode ${chaincodeName} for ${org}`);
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.approveChaincode({
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+        org,
+      });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'CHAINCODE_APPROVAL_ERROR');
+    }
+  }
+
+  /**
+   * Record an org rejecting a staged chaincode definition. The org simply
+   * withholds its approval; the reason shows up in the commit readiness.
+   */
+  async rejectChaincode(chaincodeName: string, org: string, reason?: string): Promise<void> {
+    this.ensureNetworkId();
+    this.logger.info(`Rejecting chaincode ${chaincodeName} for ${org}`, { reason });
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.rejectChaincode({
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+        org,
+        reason,
+      });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'CHAINCODE_APPROVAL_ERROR');
+    }
+  }
+
+  /**
+   * Check which orgs approved a staged chaincode definition and whether it
+   * satisfies the LifecycleEndorsement policy
+   */
+  async checkCommitReadiness(chaincodeName: string): Promise<CommitReadiness> {
+    this.ensureNetworkId();
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.checkCommitReadiness({
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+      });
+    });
+
+    if (!result.success) {
+      throw new FabricXError(result.message, 'CHAINCODE_QUERY_ERROR');
+    }
+
+    return {
+      version: result.version,
+      sequence: Number(result.sequence),
+      approvals: result.approvals || {},
+      rejections: result.rejections || {},
+      mismatched: result.mismatched || [],
+      lifecyclePolicy: result.lifecycle_policy,
+      ready: result.ready,
+    };
+  }
+
+  /**
+   * Commit a staged chaincode definition. Throws DEFINITION_REJECTED,
+   * DEFINITION_MISMATCH or APPROVALS_MISSING when the approvals don't allow it.
+   */
+  async commitChaincode(chaincodeName: string): Promise<CommitChaincodeResult> {
+    this.ensureNetworkId();
+    this.logger.info(`Committing chaincode: ${chaincodeName}`);
+
+    const result = await this.executeWithRetry(async (client) => {
+      return client.commitChaincode({
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+      });
+    });
+
+    if (!result.success) {
+      const codes: Record<string, string> = {
+        rejected: 'DEFINITION_REJECTED',
+        mismatch: 'DEFINITION_MISMATCH',
+        missing_approvals: 'APPROVALS_MISSING',
+      };
+      throw new FabricXError(result.message, codes[result.reason] || 'CHAINCODE_COMMIT_ERROR');
+    }
+
+    this.logger.info(`Chaincode committed: ${result.chaincode_id}`);
+
+    return {
+      chaincodeId: result.chaincode_id,
+      version: result.version,
+      sequence: Number(result.sequence),
+    };
+  }
+
   /**
    * Stream logs from network containers
    */
@@ -298,6 +981,57 @@ export class FabricX {
     );
   }
 
+  /**
+   * Watch a chaincode folder and redeploy it after every change. Returns a
+   * function that stops watching.
+   */
+  async watchChaincode(
+    chaincodeName: string,
+    handler: WatchEventHandler,
+    options?: WatchChaincodeOptions
+  ): Promise<() => void> {
+    this.ensureNetworkId();
+    this.logger.info(`Watching chaincode: ${chaincodeName}`, { path: options?.path });
+
+    const client = await this.getClient();
+
+    return client.watchChaincode(
+      {
+        network_id: this.networkId!,
+        chaincode_name: chaincodeName,
+        chaincode_path: options?.path || `./${chaincodeName}`,
+        language: options?.language || '',
+        endorsement_policy_orgs: options?.endorsementPolicyOrgs || [],
+        endorsement_policy: options?.endorsementPolicy,
+        collections_config:
+          typeof options?.collectionsConfig === 'object'
+            ? JSON.stringify(options.collectionsConfig)
+            : options?.collectionsConfig,
+        mode: options?.mode,
+        debounce_ms: options?.debounceMs,
+        skip_checks: options?.skipChecks,
+        vendor: options?.vendor,
+      },
+      (event) => {
+        handler({
+          timestamp: event.timestamp,
+          type: event.type as WatchEvent['type'],
+          message: event.message,
+          output: event.output || undefined,
+          version: event.version || undefined,
+          sequence: event.type === 'deployed' ? Number(event.sequence) : undefined,
+          restarted: event.restarted,
+        });
+      },
+      (error) => {
+        this.logger.error('Watch stream error', error.message);
+      },
+      () => {
+        this.logger.info('Watch stream ended');
+      }
+    );
+  }
+
   /**
    * Stop and optionally cleanup the network
    */
@@ -459,6 +1193,159 @@ export class FabricX {
     }
   }
 
+  /**
+   * Convert transient values to the bytes the runtime expects
+   */
+  private encodeTransient(transient: Record<string, Uint8Array | string>): Record<string, Buffer> {
+    const encoded: Record<string, Buffer> = {};
+    for (const [key, value] of Object.entries(transient)) {
+      encoded[key] = typeof value === 'string' ? Buffer.from(value, 'utf8') : Buffer.from(value);
+    }
+    return encoded;
+  }
+
+  /**
+   * Convert a collection message to its SDK shape
+   */
+  private toCollectionInfo(c: CollectionMessage): CollectionInfo {
+    return {
+      chaincodeName: c.chaincode_name,
+      name: c.name,
+      policy: c.policy,
+      memberOrgs: c.member_orgs || [],
+      peers: c.peers || [],
+      requiredPeerCount: c.required_peer_count,
+      maxPeerCount: c.max_peer_count,
+      blockToLive: Number(c.block_to_live),
+      memberOnlyRead: c.member_only_read,
+      memberOnlyWrite: c.member_only_write,
+    };
+  }
+
+  /**
+   * Convert a chaincode definition message to its SDK shape
+   */
+  private toChaincodeDefinition(def: ChaincodeDefinitionMessage): ChaincodeDefinition {
+    return {
+      name: def.name,
+      version: def.version,
+      sequence: Number(def.sequence),
+      endorsementPolicy: def.endorsement_policy,
+      collections: (def.collections || []).map((c) => this.toCollectionInfo(c)),
+      initRequired: def.init_required,
+      approvals: def.approvals || {},
+    };
+  }
+
+  /**
+   * Convert the installed packages of each peer to their SDK shape
+   */
+  private toPeerPackages(peers: PeerPackagesMessage[] | undefined): PeerPackages[] {
+    return (peers || []).map((peer) => ({
+      org: peer.org,
+      peer: peer.peer,
+      packages: (peer.packages || []).map((pkg) => ({
+        packageId: pkg.package_id,
+        label: pkg.label,
+        references: pkg.references || [],
+      })),
+    }));
+  }
+
+  /**
+   * Convert determinism check findings, undefined when there are none
+   */
+  private toDiagnostics(
+    diagnostics?: DeterminismDiagnosticMessage[]
+  ): DeterminismDiagnostic[] | undefined {
+    if (!diagnostics || diagnostics.length === 0) {
+      return undefined;
+    }
+    return diagnostics.map((d) => ({
+      check: d.check,
+      severity: d.severity as DeterminismDiagnostic['severity'],
+      file: d.file,
+      line: d.line,
+      column: d.column,
+      function: d.function,
+      message: d.message,
+    }));
+  }
+
+  /**
+   * Convert the comparison of endorser responses
+   */
+  private toDeterminismReport(report: DeterminismReportMessage): DeterminismReport {
+    return {
+      consistent: report.consistent,
+      endorsers: (report.endorsers || []).map((e) => this.toEndorserResponse(e)),
+      divergences: (report.divergences || []).map((d) => ({
+        kind: d.kind as Divergence['kind'],
+        namespace: d.namespace || undefined,
+        collection: d.collection || undefined,
+        key: d.key || undefined,
+        values: d.values || {},
+      })),
+    };
+  }
+
+  /**
+   * Convert one endorser's response and read-write set
+   */
+  private toEndorserResponse(e: EndorserResponseMessage): EndorserResponse {
+    const bytes = (b?: Buffer) => (b && b.length > 0 ? new Uint8Array(b) : undefined);
+    return {
+      peer: e.peer,
+      org: e.org,
+      status: e.status,
+      message: e.message,
+      payload: bytes(e.payload),
+      error: e.error || undefined,
+      event: e.event ? { name: e.event.name, payload: bytes(e.event.payload) } : undefined,
+      rwsets: (e.rwsets || []).map((ns) => this.toReadWriteSet(ns)),
+    };
+  }
+
+  private toReadWriteSet(ns: NamespaceReadWriteSetMessage): NamespaceReadWriteSet {
+    const version = (v: { block_num: string | number; tx_num: string | number } | null) =>
+      v ? ({ blockNum: Number(v.block_num), txNum: Number(v.tx_num) } as KeyVersion) : undefined;
+    const reads = (rs: NamespaceReadWriteSetMessage['reads']) =>
+      (rs || []).map((r) => ({ key: r.key, version: version(r.version) }));
+
+    return {
+      namespace: ns.namespace,
+      reads: reads(ns.reads),
+      writes: (ns.writes || []).map((w) => ({
+        key: w.key,
+        value: w.is_delete ? undefined : new Uint8Array(w.value),
+        isDelete: w.is_delete,
+      })),
+      rangeQueries: (ns.range_queries || []).map((q) => ({
+        startKey: q.start_key,
+        endKey: q.end_key,
+        exhausted: q.exhausted,
+        reads: reads(q.reads),
+        merkleHashes:
+          q.merkle_hashes && q.merkle_hashes.length > 0
+            ? q.merkle_hashes.map((h) => new Uint8Array(h))
+            : undefined,
+      })),
+      collections: (ns.collections || []).map((c) => ({
+        collection: c.collection,
+        reads: (c.reads || []).map((r) => ({
+          keyHash: new Uint8Array(r.key_hash),
+          version: version(r.version),
+        })),
+        writes: (c.writes || []).map((w) => ({
+          keyHash: new Uint8Array(w.key_hash),
+          valueHash: new Uint8Array(w.value_hash),
+          isDelete: w.is_delete,
+          isPurge: w.is_purge,
+        })),
+      })),
+    };
+  }
+
   /**
    * Setup connection monitoring and auto-reconnect
    */
@@ -50,6 +50,9 @@ interface InitNetworkRequest {
   num_orgs: number;
   channel_name: string;
   config: { [key: string]: string };
+  topology?: string;
+  crypto_provider?: string;
+  separate_tls_ca?: boolean;
 }
 
 interface InitNetworkResponse {
@@ -66,12 +69,124 @@ interface DeployChaincodeRequest {
   version: string;
   language: string;
   endorsement_policy_orgs: string[];
+  collections_config?: string;
+  endorsement_policy?: string;
+  mode?: string;
+  debug?: boolean;
+  debug_port?: number;
+  stage?: boolean;
+  vendor?: boolean;
+  upload_id?: string;
+  git_url?: string;
+  git_ref?: string;
+  package_id?: string;
+  determinism_check?: string;
+}
+
+export interface DeterminismDiagnosticMessage {
+  check: string;
+  severity: string;
+  file: string;
+  line: number;
+  column: number;
+  function: string;
+  message: string;
 }
 
 interface DeployChaincodeResponse {
   success: boolean;
   message: string;
   chaincode_id: string;
+  debug_port: number;
+  staged: boolean;
+  reason: string;
+  package_id: string;
+  diagnostics: DeterminismDiagnosticMessage[];
+}
+
+interface CheckDeterminismRequest {
+  network_id: string;
+  chaincode_name?: string;
+  chaincode_path?: string;
+  upload_id?: string;
+  git_url?: string;
+  git_ref?: string;
+  language?: string;
+}
+
+interface CheckDeterminismResponse {
+  success: boolean;
+  message: string;
+  diagnostics: DeterminismDiagnosticMessage[];
+  errors: number;
+  warnings: number;
+}
+
+interface UploadChaincodeRequest {
+  network_id?: string;
+  kind?: string;
+  file_name?: string;
+  sha256?: string;
+  data: Buffer;
+}
+
+interface UploadChaincodeResponse {
+  success: boolean;
+  message: string;
+  upload_id: string;
+  size: string | number;
+  sha256: string;
+  package_id: string;
+  label: string;
+  language: string;
+}
+
+export interface ChaincodeTemplateMessage {
+  name: string;
+  title: string;
+  description: string;
+  languages: string[];
+  functions: { name: string; description: string; args: string[]; query: boolean }[];
+  events: { name: string; description: string }[];
+  module_path: string;
+  contract_name: string;
+  package_name: string;
+}
+
+interface ListTemplatesResponse {
+  success: boolean;
+  message: string;
+  templates: ChaincodeTemplateMessage[];
+}
+
+interface ScaffoldChaincodeRequest {
+  template: string;
+  language?: string;
+  module_path?: string;
+  contract_name?: string;
+  package_name?: string;
+  target_dir?: string;
+}
+
+interface ScaffoldChaincodeResponse {
+  success: boolean;
+  message: string;
+  path: string;
+  archive: Buffer;
+  files: string[];
+  language: string;
+  module_path: string;
+  contract_name: string;
+  package_name: string;
+}
+
+interface UpgradeChaincodeResponse extends DeployChaincodeResponse {
+  old_version: string;
+  new_version: string;
+  old_sequence: string | number;
+  new_sequence: string | number;
+  reinstalled: boolean;
+  restarted: boolean;
 }
 
 interface InvokeTransactionRequest {
@@ -79,7 +194,65 @@ interface InvokeTransactionRequest {
   chaincode_name: string;
   function_name: string;
   args: string[];
-  transient: boolean;
+  org?: string;
+  identity?: string;
+  transient?: Record<string, Buffer>;
+  collection?: string;
+  endorsing_orgs?: string[];
+  endorsing_peers?: string[];
+  auto_endorse?: boolean;
+  check_determinism?: boolean;
+}
+
+interface KeyVersionMessage {
+  block_num: string | number;
+  tx_num: string | number;
+}
+
+interface KeyReadMessage {
+  key: string;
+  version: KeyVersionMessage | null;
+}
+
+export interface NamespaceReadWriteSetMessage {
+  namespace: string;
+  reads: KeyReadMessage[];
+  writes: { key: string; value: Buffer; is_delete: boolean }[];
+  range_queries: {
+    start_key: string;
+    end_key: string;
+    exhausted: boolean;
+    reads: KeyReadMessage[];
+    merkle_hashes: Buffer[];
+  }[];
+  collections: {
+    collection: string;
+    reads: { key_hash: Buffer; version: KeyVersionMessage | null }[];
+    writes: { key_hash: Buffer; value_hash: Buffer; is_delete: boolean; is_purge: boolean }[];
+  }[];
+}
+
+export interface EndorserResponseMessage {
+  peer: string;
+  org: string;
+  status: number;
+  message: string;
+  payload: Buffer;
+  error: string;
+  event?: { name: string; payload: Buffer } | null;
+  rwsets?: NamespaceReadWriteSetMessage[];
+}
+
+export interface DeterminismReportMessage {
+  consistent: boolean;
+  endorsers: EndorserResponseMessage[];
+  divergences: {
+    kind: string;
+    namespace: string;
+    collection: string;
+    key: string;
+    values: Record<string, string>;
+  }[];
 }
 
 interface InvokeTransactionResponse {
@@ -87,6 +260,35 @@ interface InvokeTransactionResponse {
   message: string;
   transaction_id: string;
   payload: Buffer;
+  determinism?: DeterminismReportMessage;
+  block_number: string | number;
+  validation_code: string;
+  endorsing_peers: string[];
+  chaincode_status: number;
+  chaincode_message: string;
+  reason: string;
+  retryable: boolean;
+}
+
+interface EndorseTransactionRequest {
+  network_id: string;
+  chaincode_name: string;
+  function_name: string;
+  args: string[];
+  org?: string;
+  identity?: string;
+  transient?: Record<string, Buffer>;
+  collection?: string;
+  endorsing_orgs?: string[];
+  endorsing_peers?: string[];
+  auto_endorse?: boolean;
+}
+
+interface EndorseTransactionResponse {
+  success: boolean;
+  message: string;
+  transaction_id: string;
+  endorsers: EndorserResponseMessage[];
 }
 
 interface QueryLedgerRequest {
@@ -94,6 +296,8 @@ interface QueryLedgerRequest {
   chaincode_name: string;
   function_name: string;
   args: string[];
+  org?: string;
+  identity?: string;
 }
 
 interface QueryLedgerResponse {
@@ -122,6 +326,195 @@ interface NetworkStatusResponse {
   }>;
 }
 
+interface ExportTopologyRequest {
+  network_id: string;
+  format: string;
+}
+
+interface ExportTopologyResponse {
+  success: boolean;
+  message: string;
+  topology: string;
+  format: string;
+}
+
+interface RegisterIdentityRequest {
+  network_id: string;
+  org: string;
+  name: string;
+  secret: string;
+  type: string;
+  affiliation: string;
+  attributes: { [key: string]: string };
+  max_enrollments: number;
+}
+
+interface RegisterIdentityResponse {
+  success: boolean;
+  message: string;
+  secret: string;
+}
+
+interface EnrollIdentityRequest {
+  network_id: string;
+  org: string;
+  name: string;
+  secret: string;
+  attributes: string[];
+}
+
+interface EnrollIdentityResponse {
+  success: boolean;
+  message: string;
+  msp_path: string;
+}
+
+interface RevokeIdentityRequest {
+  network_id: string;
+  org: string;
+  name: string;
+  reason: string;
+}
+
+interface RevokeIdentityResponse {
+  success: boolean;
+  message: string;
+}
+
+interface ListIdentitiesRequest {
+  network_id: string;
+  org: string;
+}
+
+interface ListIdentitiesResponse {
+  success: boolean;
+  message: string;
+  identities: Array<{
+    name: string;
+    type: string;
+    affiliation: string;
+    max_enrollments: number;
+    attributes: { [key: string]: string };
+  }>;
+}
+
+interface GetCollectionsRequest {
+  network_id: string;
+  chaincode_name?: string;
+}
+
+interface GetCollectionsResponse {
+  success: boolean;
+  message: string;
+  collections: Array<{
+    chaincode_name: string;
+    name: string;
+    policy: string;
+    member_orgs: string[];
+    peers: string[];
+    required_peer_count: number;
+    max_peer_count: number;
+    block_to_live: string | number;
+    member_only_read: boolean;
+    member_only_write: boolean;
+  }>;
+}
+
+interface ListChaincodesRequest {
+  network_id: string;
+}
+
+interface GetChaincodeDefinitionRequest {
+  network_id: string;
+  chaincode_name: string;
+}
+
+export type CollectionMessage = GetCollectionsResponse['collections'][number];
+
+export interface ChaincodeDefinitionMessage {
+  name: string;
+  version: string;
+  sequence: string | number;
+  endorsement_policy: string;
+  collections: CollectionMessage[];
+  init_required: boolean;
+  approvals: { [mspId: string]: boolean };
+}
+
+export interface PeerPackagesMessage {
+  org: string;
+  peer: string;
+  packages: Array<{
+    package_id: string;
+    label: string;
+    references: string[];
+  }>;
+}
+
+interface ListChaincodesResponse {
+  success: boolean;
+  message: string;
+  installed: PeerPackagesMessage[];
+  channels: Array<{
+    channel: string;
+    committed: ChaincodeDefinitionMessage[];
+  }>;
+}
+
+interface GetChaincodeDefinitionResponse {
+  success: boolean;
+  message: string;
+  installed: PeerPackagesMessage[];
+  channels: Array<{
+    channel: string;
+    committed?: ChaincodeDefinitionMessage | null;
+    pending?: ChaincodeDefinitionMessage | null;
+  }>;
+}
+
+interface ApproveChaincodeRequest {
+  network_id: string;
+  chaincode_name: string;
+  org: string;
+}
+
+interface RejectChaincodeRequest extends ApproveChaincodeRequest {
+  reason?: string;
+}
+
+interface CheckCommitReadinessRequest {
+  network_id: string;
+  chaincode_name: string;
+}
+
+type CommitChaincodeRequest = CheckCommitReadinessRequest;
+
+interface LifecycleVoteResponse {
+  success: boolean;
+  message: string;
+}
+
+interface CheckCommitReadinessResponse {
+  success: boolean;
+  message: string;
+  version: string;
+  sequence: string | number;
+  approvals: { [mspId: string]: boolean };
+  rejections: { [mspId: string]: string };
+  mismatched: string[];
+  lifecycle_policy: string;
+  ready: boolean;
+}
+
+interface CommitChaincodeResponse {
+  success: boolean;
+  message: string;
+  chaincode_id: string;
+  version: string;
+  sequence: string | number;
+  reason: string;
+}
+
 interface StopNetworkRequest {
   network_id: string;
   cleanup: boolean;
@@ -143,6 +536,30 @@ interface LogMessage {
   message: string;
 }
 
+interface WatchChaincodeRequest {
+  network_id: string;
+  chaincode_name: string;
+  chaincode_path: string;
+  language: string;
+  endorsement_policy_orgs: string[];
+  endorsement_policy?: string;
+  collections_config?: string;
+  mode?: string;
+  debounce_ms?: number;
+  skip_checks?: boolean;
+  vendor?: boolean;
+}
+
+interface WatchEventMessage {
+  timestamp: string;
+  type: string;
+  message: string;
+  output: string;
+  version: string;
+  sequence: string | number;
+  restarted: boolean;
+}
+
 /**
  * Production-grade gRPC Client for FabricX
  */
@@ -288,6 +705,17 @@ export class GrpcClient {
     );
   }
 
+  /**
+   * Upgrade chaincode to the next sequence
+   */
+  async upgradeChaincode(request: DeployChaincodeRequest): Promise<UpgradeChaincodeResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<DeployChaincodeRequest, UpgradeChaincodeResponse>(
+      'UpgradeChaincode',
+      request
+    );
+  }
+
   /**
    * Invoke a transaction
    */
@@ -326,6 +754,142 @@ export class GrpcClient {
     return this.makeUnaryCall<StopNetworkRequest, StopNetworkResponse>('StopNetwork', request);
   }
 
+  /**
+   * Export the topology of a running network
+   */
+  async exportTopology(request: ExportTopologyRequest): Promise<ExportTopologyResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<ExportTopologyRequest, ExportTopologyResponse>(
+      'ExportTopology',
+      request
+    );
+  }
+
+  /**
+   * Register an identity with an organization's CA
+   */
+  async registerIdentity(request: RegisterIdentityRequest): Promise<RegisterIdentityResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<RegisterIdentityRequest, RegisterIdentityResponse>(
+      'RegisterIdentity',
+      request
+    );
+  }
+
+  /**
+   * Enroll a registered identity
+   */
+  async enrollIdentity(request: EnrollIdentityRequest): Promise<EnrollIdentityResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<EnrollIdentityRequest, EnrollIdentityResponse>(
+      'EnrollIdentity',
+      request
+    );
+  }
+
+  /**
+   * Revoke an identity
+   */
+  async revokeIdentity(request: RevokeIdentityRequest): Promise<RevokeIdentityResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<RevokeIdentityRequest, RevokeIdentityResponse>(
+      'RevokeIdentity',
+      request
+    );
+  }
+
+  /**
+   * List identities registered with an organization's CA
+   */
+  async listIdentities(request: ListIdentitiesRequest): Promise<ListIdentitiesResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<ListIdentitiesRequest, ListIdentitiesResponse>(
+      'ListIdentities',
+      request
+    );
+  }
+
+  /**
+   * Get private data collections and the peers that hold them
+   */
+  async getCollections(request: GetCollectionsRequest): Promise<GetCollectionsResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<GetCollectionsRequest, GetCollectionsResponse>(
+      'GetCollections',
+      request
+    );
+  }
+
+  /**
+   * List installed packages and committed chaincode definitions
+   */
+  async listChaincodes(request: ListChaincodesRequest): Promise<ListChaincodesResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<ListChaincodesRequest, ListChaincodesResponse>(
+      'ListChaincodes',
+      request
+    );
+  }
+
+  /**
+   * Get the committed and pending definitions of a chaincode
+   */
+  async getChaincodeDefinition(
+    request: GetChaincodeDefinitionRequest
+  ): Promise<GetChaincodeDefinitionResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<GetChaincodeDefinitionRequest, GetChaincodeDefinitionResponse>(
+      'GetChaincodeDefinition',
+      request
+    );
+  }
+
+  /**
+   * Approve a staged chaincode definition for one org
+   */
+  async approveChaincode(request: ApproveChaincodeRequest): Promise<LifecycleVoteResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<ApproveChaincodeRequest, LifecycleVoteResponse>(
+      'ApproveChaincode',
+      request
+    );
+  }
+
+  /**
+   * Record an org rejecting a staged chaincode definition
+   */
+  async rejectChaincode(request: RejectChaincodeRequest): Promise<LifecycleVoteResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<RejectChaincodeRequest, LifecycleVoteResponse>(
+      'RejectChaincode',
+      request
+    );
+  }
+
+  /**
+   * Check which orgs approved a staged chaincode definition
+   */
+  async checkCommitReadiness(
+    request: CheckCommitReadinessRequest
+  ): Promise<CheckCommitReadinessResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<CheckCommitReadinessRequest, CheckCommitReadinessResponse>(
+      'CheckCommitReadiness',
+      request
+    );
+  }
+
+  /**
+   * Commit a staged chaincode definition
+   */
+  async commitChaincode(request: CommitChaincodeRequest): Promise<CommitChaincodeResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<CommitChaincodeRequest, CommitChaincodeResponse>(
+      'CommitChaincode',
+      request
+    );
+  }
+
   /**
    * Stream logs from containers
    */
@@ -364,6 +928,129 @@ export class GrpcClient {
     });
   }
 
+  /**
+   * Upload a package or source archive in chunks over a client stream
+   */
+  async uploadChaincode(
+    request: Omit<UploadChaincodeRequest, 'data'>,
+    data: Buffer,
+    chunkSize: number = 1024 * 1024
+  ): Promise<UploadChaincodeResponse> {
+    await this.ensureConnected();
+
+    const deadline = new Date();
+    deadline.setMilliseconds(deadline.getMilliseconds() + this.config.timeout);
+
+    const metadata = new grpc.Metadata();
+    metadata.set('request-id', this.generateRequestId());
+
+    return new Promise((resolve, reject) => {
+      const call = this.client.UploadChaincode(
+        metadata,
+        { deadline },
+        (error: grpc.ServiceError | null, response: UploadChaincodeResponse) => {
+          if (error) {
+            reject(this.convertGrpcError(error, 'UploadChaincode'));
+          } else {
+            resolve(response);
+          }
+        }
+      );
+
+      // The first message carries the metadata
+      let offset = 0;
+      do {
+        call.write({
+          ...(offset === 0 ? request : {}),
+          data: data.subarray(offset, offset + chunkSize),
+        });
+        offset += chunkSize;
+      } while (offset < data.length);
+      call.end();
+    });
+  }
+
+  /**
+   * List the chaincode templates embedded in the runtime
+   */
+  async listTemplates(): Promise<ListTemplatesResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<Record<string, never>, ListTemplatesResponse>('ListTemplates', {});
+  }
+
+  /**
+   * Render a chaincode template into a folder on the runtime host or an archive
+   */
+  async scaffoldChaincode(request: ScaffoldChaincodeRequest): Promise<ScaffoldChaincodeResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<ScaffoldChaincodeRequest, ScaffoldChaincodeResponse>(
+      'ScaffoldChaincode',
+      request
+    );
+  }
+
+  /**
+   * Check Go chaincode for code endorsers can execute differently
+   */
+  async checkDeterminism(request: CheckDeterminismRequest): Promise<CheckDeterminismResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<CheckDeterminismRequest, CheckDeterminismResponse>(
+      'CheckDeterminism',
+      request
+    );
+  }
+
+  /**
+   * Endorse a transaction without ordering it
+   */
+  async endorseTransaction(
+    request: EndorseTransactionRequest
+  ): Promise<EndorseTransactionResponse> {
+    await this.ensureConnected();
+    return this.makeUnaryCall<EndorseTransactionRequest, EndorseTransactionResponse>(
+      'EndorseTransaction',
+      request
+    );
+  }
+
+  /**
+   * Watch a chaincode folder and stream redeploy events
+   */
+  async watchChaincode(
+    request: WatchChaincodeRequest,
+    onData: (event: WatchEventMessage) => void,
+    onError?: (error: Error) => void,
+    onEnd?: () => void
+  ): Promise<() => void> {
+    await this.ensureConnected();
+
+    return new Promise((resolve, reject) => {
+      const call = this.client.WatchChaincode(request);
+
+      call.on('data', (event: WatchEventMessage) => {
+        onData(event);
+      });
+
+      call.on('error', (error: grpc.ServiceError) => {
+        const fabricxError = this.convertGrpcError(error, 'WatchChaincode');
+        if (onError) {
+          onError(fabricxError);
+        } else {
+          reject(fabricxError);
+        }
+      });
+
+      call.on('end', () => {
+        if (onEnd) {
+          onEnd();
+        }
+      });
+
+      // Return cancel function
+      resolve(() => call.cancel());
+    });
+  }
+
   /**
    * Close the client connection
    */
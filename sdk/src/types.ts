@@ -12,6 +12,12 @@ export interface InitNetworkOptions {
   channelName?: string;
   /** Custom configuration parameters */
   config?: Record<string, string>;
+  /** YAML or JSON topology document; overrides numOrgs and channelName */
+  topology?: string;
+  /** How identities are issued: "cryptogen" (default) or "ca" for Fabric CA */
+  cryptoProvider?: 'cryptogen' | 'ca';
+  /** Run a dedicated TLS CA per org when cryptoProvider is "ca" */
+  separateTlsCa?: boolean;
 }
 
 /**
@@ -28,6 +34,61 @@ export interface InitNetworkResult {
   endpoints: string[];
 }
 
+/**
+ * Result of exporting a network topology
+ */
+export interface ExportTopologyResult {
+  /** Whether the operation succeeded */
+  success: boolean;
+  /** Status message */
+  message: string;
+  /** Topology document */
+  topology: string;
+  /** Document format ("yaml" or "json") */
+  format: string;
+}
+
+/**
+ * Options for registering an identity with an organization's CA
+ */
+export interface RegisterIdentityOptions {
+  /** Enrollment secret (generated by the CA when omitted) */
+  secret?: string;
+  /** Identity type (default: "client") */
+  type?: 'client' | 'admin' | 'peer' | 'orderer';
+  /** Affiliation, e.g. "org1.department1" */
+  affiliation?: string;
+  /** Custom attributes added to the enrollment certificate, e.g. { role: 'arbiter' } */
+  attributes?: Record<string, string>;
+  /** Maximum number of enrollments (0 uses the CA default, -1 is unlimited) */
+  maxEnrollments?: number;
+}
+
+/**
+ * Result of an identity operation
+ */
+export interface IdentityResult {
+  /** Whether the operation succeeded */
+  success: boolean;
+  /** Status message */
+  message: string;
+  /** Enrollment secret (register only) */
+  secret?: string;
+  /** Path of the enrolled MSP directory (enroll only) */
+  mspPath?: string;
+}
+
+/**
+ * Identity registered with an organization's CA
+ */
+export interface IdentityInfo {
+  name: string;
+  type: string;
+  affiliation: string;
+  maxEnrollments: number;
+  attributes: Record<string, string>;
+}
+
 /**
  * Options for deploying chaincode
  */
@@ -36,10 +97,283 @@ export interface DeployChaincodeOptions {
   path?: string;
   /** Chaincode version (default: "1.0") */
   version?: string;
-  /** Chaincode language: "golang", "node", "java" (default: "golang") */
+  /** Chaincode language: "golang", "node", "typescript", "java" (default: detected from the project files) */
   language?: string;
   /** Organizations required for endorsement */
   endorsementPolicyOrgs?: string[];
+  /**
+   * Endorsement policy expression, e.g. "AND('Org1MSP.peer','Org2MSP.peer')",
+   * or a channel config policy such as "/Channel/Application/Endorsement".
+   * Mutually exclusive with endorsementPolicyOrgs.
+   */
+  endorsementPolicy?: string;
+  /**
+   * Private data collections: a path relative to the chaincode folder,
+   * inline JSON, or the definitions themselves
+   */
+  collectionsConfig?: string | CollectionConfig[];
+  /**
+   * "package" (default) lets the peers build the chaincode; "ccaas" runs it
+   * as a service so redeploying only restarts it
+   */
+  mode?: 'package' | 'ccaas';
+  /**
+   * Run Go chaincode under a headless Delve server (implies "ccaas") and
+   * raise the peers' chaincode timeouts so breakpoints don't abort transactions
+   */
+  debug?: boolean;
+  /** Host port for Delve; picked automatically when omitted */
+  debugPort?: number;
+  /**
+   * Stage the definition instead of approving it for every org; each org
+   * then approves with approveChaincode before commitChaincode
+   */
+  stage?: boolean;
+  /**
+   * Vendor Go modules into the package so the peers build the chaincode
+   * without internet access
+   */
+  vendor?: boolean;
+  /**
+   * Upload the local folder, source archive or lifecycle package at path
+   * instead of reading path on the runtime host
+   */
+  upload?: boolean;
+  /** Deploy an earlier upload (see uploadChaincode) instead of path */
+  uploadId?: string;
+  /** Git repository to check the chaincode out of instead of path */
+  gitUrl?: string;
+  /** Branch, tag or commit of gitUrl (default: the default branch) */
+  gitRef?: string;
+  /** Expected package ID; the deploy fails before install when the package differs */
+  packageId?: string;
+  /**
+   * Check Go chaincode for non-determinism before installing it: "warn"
+   * reports the findings, "enforce" also refuses to deploy on errors
+   */
+  determinismCheck?: 'warn' | 'enforce';
+}
+
+/**
+ * Chaincode that endorsers can execute differently, e.g. a wall clock read
+ */
+export interface DeterminismDiagnostic {
+  /** "time", "random", "io", "map-iteration", "global-state" or "goroutine" */
+  check: string;
+  severity: 'error' | 'warning';
+  /** Relative to the chaincode folder */
+  file: string;
+  line: number;
+  column: number;
+  /** Transaction or helper the code is in */
+  function: string;
+  message: string;
+}
+
+/**
+ * Options for checking chaincode for non-determinism
+ */
+export type CheckDeterminismOptions = Pick<
+  DeployChaincodeOptions,
+  'path' | 'language' | 'upload' | 'uploadId' | 'gitUrl' | 'gitRef'
+>;
+
+/**
+ * Findings of a determinism check
+ */
+export interface CheckDeterminismResult {
+  diagnostics: DeterminismDiagnostic[];
+  errors: number;
+  warnings: number;
+}
+
+/**
+ * A chaincode template embedded in the runtime
+ */
+export interface ChaincodeTemplate {
+  /** Pass to scaffoldChaincode */
+  name: string;
+  title: string;
+  description: string;
+  /** "go" and/or "typescript" */
+  languages: string[];
+  functions: TemplateFunction[];
+  events: TemplateEvent[];
+  /** Names used when scaffoldChaincode is not given any */
+  defaults: {
+    modulePath: string;
+    contractName: string;
+    packageName: string;
+  };
+}
+
+/**
+ * A transaction function of a template's contract
+ */
+export interface TemplateFunction {
+  name: string;
+  description: string;
+  args: string[];
+  /** Reads the ledger only */
+  query: boolean;
+}
+
+/**
+ * A chaincode event a template's contract emits
+ */
+export interface TemplateEvent {
+  name: string;
+  description: string;
+}
+
+/**
+ * Options for scaffolding chaincode from a template
+ */
+export interface ScaffoldChaincodeOptions {
+  /** "go" (default) or "typescript" */
+  language?: string;
+  /** Go module path */
+  modulePath?: string;
+  /** Contract type or class name */
+  contractName?: string;
+  /** Go package of the contract, or npm package name */
+  packageName?: string;
+  /** Local folder to write the chaincode to; must be empty or missing */
+  targetDir?: string;
+  /** Folder on the runtime host to write the chaincode to instead */
+  runtimeDir?: string;
+}
+
+/**
+ * Chaincode rendered from a template
+ */
+export interface ScaffoldChaincodeResult {
+  language: string;
+  modulePath: string;
+  contractName: string;
+  packageName: string;
+  files: string[];
+  /** Folder the chaincode was written to, locally or on the runtime host */
+  path?: string;
+  /** .tar.gz of the chaincode, unless it was written on the runtime host */
+  archive?: Buffer;
+}
+
+/**
+ * Options for uploading chaincode
+ */
+export interface UploadChaincodeOptions {
+  /** Detected from the content when omitted */
+  kind?: 'package' | 'source';
+  /** Name of the uploaded file, used to tell archive formats apart */
+  fileName?: string;
+}
+
+/**
+ * Chaincode stored on the runtime host by uploadChaincode
+ */
+export interface UploadChaincodeResult {
+  /** Pass as uploadId when deploying */
+  uploadId: string;
+  size: number;
+  sha256: string;
+  /** Package ID, label and language of an uploaded lifecycle package */
+  packageId?: string;
+  label?: string;
+  language?: string;
+}
+
+/**
+ * Private data collection definition (collections_config.json entry)
+ */
+export interface CollectionConfig {
+  name: string;
+  policy: string;
+  requiredPeerCount: number;
+  maxPeerCount: number;
+  blockToLive?: number;
+  memberOnlyRead?: boolean;
+  memberOnlyWrite?: boolean;
+  endorsementPolicy?: {
+    signaturePolicy?: string;
+    channelConfigPolicy?: string;
+  };
+}
+
+/**
+ * Private data collection and the peers that hold its data
+ */
+export interface CollectionInfo {
+  chaincodeName: string;
+  name: string;
+  policy: string;
+  memberOrgs: string[];
+  peers: string[];
+  requiredPeerCount: number;
+  maxPeerCount: number;
+  blockToLive: number;
+  memberOnlyRead: boolean;
+  memberOnlyWrite: boolean;
+}
+
+/**
+ * Chaincode definition read from the channel
+ */
+export interface ChaincodeDefinition {
+  name: string;
+  version: string;
+  sequence: number;
+  endorsementPolicy: string;
+  collections: CollectionInfo[];
+  initRequired: boolean;
+  /** Approval of each org, keyed by MSP ID */
+  approvals: { [mspId: string]: boolean };
+}
+
+/**
+ * Chaincode packages installed on a peer
+ */
+export interface PeerPackages {
+  org: string;
+  peer: string;
+  packages: Array<{
+    packageId: string;
+    label: string;
+    /** Definitions using the package, as channel/name:version */
+    references: string[];
+  }>;
+}
+
+/**
+ * Installed and committed chaincodes of a network
+ */
+export interface ListChaincodesResult {
+  /** Committed definitions of every channel */
+  channels: Array<{
+    channel: string;
+    committed: ChaincodeDefinition[];
+  }>;
+  installed: PeerPackages[];
+}
+
+/**
+ * Lifecycle state of one chaincode on a channel
+ */
+export interface ChannelChaincodeDefinition {
+  channel: string;
+  /** Undefined when the chaincode was never committed on the channel */
+  committed?: ChaincodeDefinition;
+  /** Definition approved for the next sequence, with each member's approval */
+  pending?: ChaincodeDefinition;
+}
+
+/**
+ * Lifecycle state of one chaincode
+ */
+export interface ChaincodeDefinitionResult {
+  /** Channels the chaincode is committed or approved on */
+  channels: ChannelChaincodeDefinition[];
+  installed: PeerPackages[];
 }
 
 /**
@@ -52,6 +386,60 @@ export interface DeployChaincodeResult {
   message: string;
   /** Unique chaincode identifier */
   chaincodeId: string;
+  /** Host port Delve listens on when deployed with debug */
+  debugPort?: number;
+  /** True when the definition waits for the orgs to approve it */
+  staged?: boolean;
+  /**
+   * "go_modules" when Go dependencies could not be vendored,
+   * "nondeterministic" when the determinism check failed
+   */
+  reason?: string;
+  /** Package ID installed on the peers */
+  packageId?: string;
+  /** Findings of the determinism check */
+  diagnostics?: DeterminismDiagnostic[];
+}
+
+/**
+ * Result of a chaincode upgrade
+ */
+export interface UpgradeChaincodeResult extends DeployChaincodeResult {
+  oldVersion: string;
+  newVersion: string;
+  oldSequence: number;
+  newSequence: number;
+  /** False when the chaincode was unchanged and the installed package was reused */
+  reinstalled: boolean;
+  /** True when only the chaincode service was restarted (ccaas mode) */
+  restarted: boolean;
+}
+
+/**
+ * Approval state of a staged chaincode definition
+ */
+export interface CommitReadiness {
+  version: string;
+  sequence: number;
+  /** MSP ID -> approved */
+  approvals: Record<string, boolean>;
+  /** MSP ID -> reason for rejecting */
+  rejections: Record<string, string>;
+  /** MSP IDs that approved a different definition at the sequence */
+  mismatched: string[];
+  /** LifecycleEndorsement policy the approvals must satisfy */
+  lifecyclePolicy: string;
+  /** True when the definition can be committed */
+  ready: boolean;
+}
+
+/**
+ * Result of committing a staged chaincode definition
+ */
+export interface CommitChaincodeResult {
+  chaincodeId: string;
+  version: string;
+  sequence: number;
 }
 
 /**
@@ -60,8 +448,147 @@ export interface DeployChaincodeResult {
 export interface InvokeTransactionOptions {
   /** Network ID (uses current network if not provided) */
   networkId?: string;
-  /** Whether to use transient data */
-  transient?: boolean;
+  /** Private data passed to the chaincode but not stored on the ledger */
+  transient?: Record<string, Uint8Array | string>;
+  /** Private data collection; only its member orgs endorse the transaction */
+  collection?: string;
+  /** Endorse on the first peer of each of these orgs (name or MSP ID) */
+  endorsingOrgs?: string[];
+  /** Endorse on these peers, e.g. "peer1.org1.example.com" */
+  endorsingPeers?: string[];
+  /** Endorse on a minimal set of peers satisfying the committed policy */
+  autoEndorse?: boolean;
+  /**
+   * Endorse on every peer separately and compare their responses instead of
+   * submitting the transaction; autoEndorse is ignored
+   */
+  checkDeterminism?: boolean;
+  /** Signing organization name or MSP ID (defaults to the first org) */
+  org?: string;
+  /** Signing identity, e.g. "User1" or "User1@org2.example.com" (defaults to Admin) */
+  identity?: string;
+}
+
+/**
+ * Options for endorsing a transaction without submitting it
+ */
+export type EndorseTransactionOptions = Omit<InvokeTransactionOptions, 'checkDeterminism'>;
+
+/**
+ * Block and transaction that last wrote a key
+ */
+export interface KeyVersion {
+  blockNum: number;
+  txNum: number;
+}
+
+/**
+ * A key the chaincode read
+ */
+export interface KeyRead {
+  key: string;
+  /** Undefined when the key was not in the world state */
+  version?: KeyVersion;
+}
+
+/**
+ * A key the chaincode wrote or deleted
+ */
+export interface KeyWrite {
+  key: string;
+  value?: Uint8Array;
+  isDelete: boolean;
+}
+
+/**
+ * A range of keys the chaincode iterated
+ */
+export interface RangeQuery {
+  startKey: string;
+  endKey: string;
+  exhausted: boolean;
+  reads: KeyRead[];
+  /** Set instead of reads for large results */
+  merkleHashes?: Uint8Array[];
+}
+
+/**
+ * What the chaincode did in a private data collection; endorsers only know
+ * private keys and values by hash
+ */
+export interface CollectionReadWriteSet {
+  collection: string;
+  reads: { keyHash: Uint8Array; version?: KeyVersion }[];
+  writes: { keyHash: Uint8Array; valueHash: Uint8Array; isDelete: boolean; isPurge: boolean }[];
+}
+
+/**
+ * What the chaincode read and wrote in one namespace, its own or one it called
+ */
+export interface NamespaceReadWriteSet {
+  namespace: string;
+  reads: KeyRead[];
+  writes: KeyWrite[];
+  rangeQueries: RangeQuery[];
+  collections: CollectionReadWriteSet[];
+}
+
+/**
+ * What one endorser answered a proposal
+ */
+export interface EndorserResponse {
+  peer: string;
+  org: string;
+  /** Chaincode response status, e.g. 200 */
+  status: number;
+  message: string;
+  payload?: Uint8Array;
+  /** Set when the peer could not be reached */
+  error?: string;
+  /** Chaincode event the transaction set */
+  event?: { name: string; payload?: Uint8Array };
+  /** Read-write set the peer would commit */
+  rwsets: NamespaceReadWriteSet[];
+}
+
+/**
+ * Result of endorsing a transaction without submitting it
+ */
+export interface EndorseTransactionResult {
+  /** Transaction ID of the proposal; nothing was ordered */
+  transactionId: string;
+  endorsers: EndorserResponse[];
+}
+
+/**
+ * A part of the proposal response endorsers disagree on
+ */
+export interface Divergence {
+  kind:
+    | 'response'
+    | 'payload'
+    | 'event'
+    | 'read'
+    | 'write'
+    | 'range-query'
+    | 'private-read'
+    | 'private-write'
+    | 'rwset';
+  namespace?: string;
+  collection?: string;
+  /** Private keys are hex SHA-256 hashes */
+  key?: string;
+  /** Peer name -> what the peer produced */
+  values: Record<string, string>;
+}
+
+/**
+ * Proposal responses of every endorser of the same proposal, compared
+ */
+export interface DeterminismReport {
+  consistent: boolean;
+  endorsers: EndorserResponse[];
+  divergences: Divergence[];
 }
 
 /**
@@ -74,16 +601,75 @@ export interface InvokeTransactionResult {
   message: string;
   /** Transaction ID */
   transactionId: string;
-  /** Transaction response payload */
+  /** Transaction response payload, exactly as the chaincode returned it */
   payload?: Uint8Array;
+  /** Block the transaction was committed in, unless it was not validated */
+  blockNumber?: number;
+  /** How the committing peer validated the transaction */
+  validationCode?: TxValidationCode;
+  /** Peers whose endorsements the transaction carries */
+  endorsingPeers?: string[];
+  /** Chaincode response status the endorsers agreed on, or of the endorser that rejected the transaction */
+  chaincodeStatus?: number;
+  /** Chaincode response message */
+  chaincodeMessage?: string;
+  /**
+   * "endorsement_rejected" when an endorser's chaincode returned an error and
+   * "endorsement_mismatch" when the endorsers returned different results,
+   * neither of which was ordered; "invalidated" when the committing peer
+   * marked it invalid
+   */
+  reason?: 'endorsement_rejected' | 'endorsement_mismatch' | 'invalidated';
+  /** Invalidated by a read conflict; invoking it again may succeed */
+  retryable?: boolean;
+  /** Set with checkDeterminism; the transaction was not submitted */
+  determinism?: DeterminismReport;
 }
 
+/**
+ * Validation code of a transaction, as Fabric's TxValidationCode.
+ * NOT_VALIDATED when no peer validated it.
+ */
+export type TxValidationCode =
+  | 'VALID'
+  | 'NIL_ENVELOPE'
+  | 'BAD_PAYLOAD'
+  | 'BAD_COMMON_HEADER'
+  | 'BAD_CREATOR_SIGNATURE'
+  | 'INVALID_ENDORSER_TRANSACTION'
+  | 'INVALID_CONFIG_TRANSACTION'
+  | 'UNSUPPORTED_TX_PAYLOAD'
+  | 'BAD_PROPOSAL_TXID'
+  | 'DUPLICATE_TXID'
+  | 'ENDORSEMENT_POLICY_FAILURE'
+  | 'MVCC_READ_CONFLICT'
+  | 'PHANTOM_READ_CONFLICT'
+  | 'UNKNOWN_TX_TYPE'
+  | 'TARGET_CHAIN_NOT_FOUND'
+  | 'MARSHAL_TX_ERROR'
+  | 'NIL_TXACTION'
+  | 'EXPIRED_CHAINCODE'
+  | 'CHAINCODE_VERSION_CONFLICT'
+  | 'BAD_HEADER_EXTENSION'
+  | 'BAD_CHANNEL_HEADER'
+  | 'BAD_RESPONSE_PAYLOAD'
+  | 'BAD_RWSET'
+  | 'ILLEGAL_WRITESET'
+  | 'INVALID_WRITESET'
+  | 'INVALID_CHAINCODE'
+  | 'NOT_VALIDATED'
+  | 'INVALID_OTHER_REASON';
+
 /**
  * Options for querying the ledger
  */
 export interface QueryLedgerOptions {
   /** Network ID (uses current network if not provided) */
   networkId?: string;
+  /** Signing organization name or MSP ID (defaults to the first org) */
+  org?: string;
+  /** Signing identity, e.g. "User1" or "User1@org2.example.com" (defaults to Admin) */
+  identity?: string;
 }
 
 /**
@@ -163,6 +749,50 @@ export interface LogMessage {
  */
 export type LogStreamHandler = (log: LogMessage) => void;
 
+/**
+ * Options for watching a chaincode folder
+ */
+export interface WatchChaincodeOptions
+  extends Omit<
+    DeployChaincodeOptions,
+    | 'debug'
+    | 'debugPort'
+    | 'stage'
+    | 'upload'
+    | 'uploadId'
+    | 'gitUrl'
+    | 'gitRef'
+    | 'packageId'
+    | 'determinismCheck'
+  > {
+  /** Quiet period in milliseconds before redeploying (default: 1000) */
+  debounceMs?: number;
+  /** Skip go vet and go build before redeploying */
+  skipChecks?: boolean;
+}
+
+/**
+ * Step of a watch session
+ */
+export interface WatchEvent {
+  timestamp: string;
+  /** started, changed, check_failed, deployed or deploy_failed */
+  type: 'started' | 'changed' | 'check_failed' | 'deployed' | 'deploy_failed';
+  message: string;
+  /** Build check output */
+  output?: string;
+  /** Committed version and sequence, set on deployed */
+  version?: string;
+  sequence?: number;
+  /** True when only the chaincode service was restarted (ccaas mode) */
+  restarted?: boolean;
+}
+
+/**
+ * Callback handler for watch events
+ */
+export type WatchEventHandler = (event: WatchEvent) => void;
+
 /**
  * Error thrown by FabricX SDK
  */
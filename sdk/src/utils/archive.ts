@@ -0,0 +1,145 @@
+// sdk/src/utils/archive.ts
+import * as fs from 'fs';
+import * as path from 'path';
+import * as zlib from 'zlib';
+
+const BLOCK = 512;
+
+/** Folders left out of source archives */
+const SKIPPED = new Set(['.git', 'node_modules']);
+
+/**
+ * Write a ustar header field as a zero padded octal number
+ */
+function octal(value: number, length: number): string {
+  return value.toString(8).padStart(length - 1, '0') + '\0';
+}
+
+function tarHeader(name: string, size: number, mode: number): Buffer {
+  const header = Buffer.alloc(BLOCK);
+  let prefix = '';
+  if (Buffer.byteLength(name) > 100) {
+    // Long names are split into a prefix and a name at a slash
+    const split = name.lastIndexOf('/', 155);
+    prefix = name.slice(0, split);
+    name = name.slice(split + 1);
+  }
+
+  header.write(name, 0, 100);
+  header.write(octal(mode & 0o777, 8), 100, 8);
+  header.write(octal(0, 8), 108, 8);
+  header.write(octal(0, 8), 116, 8);
+  header.write(octal(size, 12), 124, 12);
+  header.write(octal(0, 12), 136, 12);
+  header.write('        ', 148, 8);
+  header.write('0', 156, 1);
+  header.write('ustar\0', 257, 6);
+  header.write('00', 263, 2);
+  header.write(prefix, 345, 155);
+
+  let checksum = 0;
+  for (const byte of header) {
+    checksum += byte;
+  }
+  header.write(octal(checksum, 7) + ' ', 148, 8);
+  return header;
+}
+
+/**
+ * Archive a chaincode folder as a gzipped tar, leaving out version control
+ * and installed dependencies
+ */
+export function createSourceArchive(dir: string): Buffer {
+  const blocks: Buffer[] = [];
+
+  const walk = (current: string) => {
+    for (const entry of fs.readdirSync(current, { withFileTypes: true }).sort((a, b) =>
+      a.name.localeCompare(b.name)
+    )) {
+      const full = path.join(current, entry.name);
+      if (entry.isDirectory()) {
+        if (!SKIPPED.has(entry.name)) {
+          walk(full);
+        }
+        continue;
+      }
+      if (!entry.isFile()) {
+        continue;
+      }
+
+      const data = fs.readFileSync(full);
+      const name = path.relative(dir, full).split(path.sep).join('/');
+      blocks.push(tarHeader(name, data.length, fs.statSync(full).mode));
+      blocks.push(data);
+      const padding = (BLOCK - (data.length % BLOCK)) % BLOCK;
+      if (padding) {
+        blocks.push(Buffer.alloc(padding));
+      }
+    }
+  };
+
+  walk(dir);
+  blocks.push(Buffer.alloc(BLOCK * 2));
+  return zlib.gzipSync(Buffer.concat(blocks));
+}
+
+/**
+ * Tell a package made by peer lifecycle chaincode package apart from a
+ * source archive
+ */
+export function isLifecyclePackage(data: Buffer): boolean {
+  let tar: Buffer;
+  try {
+    tar = zlib.gunzipSync(data);
+  } catch {
+    return false;
+  }
+
+  const names = new Set<string>();
+  for (let offset = 0; offset + BLOCK <= tar.length; ) {
+    const header = tar.subarray(offset, offset + BLOCK);
+    const name = header.toString('utf8', 0, 100).replace(/\0.*$/s, '');
+    if (!name) {
+      break;
+    }
+    names.add(name);
+    const size = parseInt(header.toString('utf8', 124, 136).replace(/\0.*$/s, '').trim() || '0', 8);
+    offset += BLOCK + Math.ceil(size / BLOCK) * BLOCK;
+  }
+  return names.has('metadata.json') && names.has('code.tar.gz');
+}
+
+/**
+ * Write the regular files of a gzipped tar into dir. Entries that would land
+ * outside dir are refused.
+ */
+export function extractArchive(data: Buffer, dir: string): void {
+  const tar = zlib.gunzipSync(data);
+  const root = path.resolve(dir);
+
+  for (let offset = 0; offset + BLOCK <= tar.length; ) {
+    const header = tar.subarray(offset, offset + BLOCK);
+    let name = header.toString('utf8', 0, 100).replace(/\0.*$/s, '');
+    if (!name) {
+      break;
+    }
+    const prefix = header.toString('utf8', 345, 500).replace(/\0.*$/s, '');
+    if (prefix) {
+      name = `${prefix}/${name}`;
+    }
+    const size = parseInt(header.toString('utf8', 124, 136).replace(/\0.*$/s, '').trim() || '0', 8);
+    const type = header.toString('utf8', 156, 157);
+    const start = offset + BLOCK;
+    offset = start + Math.ceil(size / BLOCK) * BLOCK;
+
+    if (type !== '0' && type !== '\0') {
+      continue;
+    }
+    const target = path.resolve(root, name);
+    if (!target.startsWith(root + path.sep)) {
+      throw new Error(`archive entry ${name} is outside ${dir}`);
+    }
+    fs.mkdirSync(path.dirname(target), { recursive: true });
+    fs.writeFileSync(target, tar.subarray(start, start + size));
+  }
+}